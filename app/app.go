@@ -106,6 +106,9 @@ import (
 	leaderboardmodule "github.com/satya/checkers/x/leaderboard"
 	leaderboardmodulekeeper "github.com/satya/checkers/x/leaderboard/keeper"
 	leaderboardmoduletypes "github.com/satya/checkers/x/leaderboard/types"
+	puzzlesmodule "github.com/satya/checkers/x/puzzles"
+	puzzlesmodulekeeper "github.com/satya/checkers/x/puzzles/keeper"
+	puzzlesmoduletypes "github.com/satya/checkers/x/puzzles/types"
 	// this line is used by starport scaffolding # stargate/app/moduleImport
 )
 
@@ -162,6 +165,7 @@ var (
 		monitoringp.AppModuleBasic{},
 		checkersmodule.AppModuleBasic{},
 		leaderboardmodule.AppModuleBasic{},
+		puzzlesmodule.AppModuleBasic{},
 		// this line is used by starport scaffolding # stargate/app/moduleBasic
 	)
 
@@ -175,6 +179,7 @@ var (
 		govtypes.ModuleName:            {authtypes.Burner},
 		ibctransfertypes.ModuleName:    {authtypes.Minter, authtypes.Burner},
 		checkersmoduletypes.ModuleName: nil,
+		puzzlesmoduletypes.ModuleName:  nil,
 		// this line is used by starport scaffolding # stargate/app/maccPerms
 	}
 )
@@ -238,6 +243,7 @@ type App struct {
 	CheckersKeeper          checkersmodulekeeper.Keeper
 	ScopedLeaderboardKeeper capabilitykeeper.ScopedKeeper
 	LeaderboardKeeper       leaderboardmodulekeeper.Keeper
+	PuzzlesKeeper           puzzlesmodulekeeper.Keeper
 	// this line is used by starport scaffolding # stargate/app/keeperDeclaration
 
 	// mm is the module manager
@@ -301,6 +307,7 @@ func NewApp(
 		evidencetypes.StoreKey, ibctransfertypes.StoreKey, capabilitytypes.StoreKey, monitoringptypes.StoreKey,
 		checkersmoduletypes.StoreKey,
 		leaderboardmoduletypes.StoreKey,
+		puzzlesmoduletypes.StoreKey,
 		// this line is used by starport scaffolding # stargate/app/storeKey
 	)
 	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey)
@@ -425,6 +432,8 @@ func NewApp(
 	app.CheckersKeeper = *checkersmodulekeeper.NewKeeper(
 		app.BankKeeper,
 		app.LeaderboardKeeper,
+		app.TransferKeeper,
+		app.DistrKeeper,
 		appCodec,
 		keys[checkersmoduletypes.StoreKey],
 		keys[checkersmoduletypes.MemStoreKey],
@@ -445,6 +454,15 @@ func NewApp(
 	)
 	leaderboardModule := leaderboardmodule.NewAppModule(appCodec, app.LeaderboardKeeper, app.AccountKeeper, app.BankKeeper)
 
+	app.PuzzlesKeeper = *puzzlesmodulekeeper.NewKeeper(
+		app.BankKeeper,
+		appCodec,
+		keys[puzzlesmoduletypes.StoreKey],
+		keys[puzzlesmoduletypes.MemStoreKey],
+		app.GetSubspace(puzzlesmoduletypes.ModuleName),
+	)
+	puzzlesModule := puzzlesmodule.NewAppModule(appCodec, app.PuzzlesKeeper, app.AccountKeeper, app.BankKeeper)
+
 	// this line is used by starport scaffolding # stargate/app/keeperDefinition
 
 	// Create static IBC router, add transfer route, then set and seal it
@@ -489,6 +507,7 @@ func NewApp(
 		monitoringModule,
 		checkersModule,
 		leaderboardModule,
+		puzzlesModule,
 		// this line is used by starport scaffolding # stargate/app/appModule
 	)
 
@@ -518,6 +537,7 @@ func NewApp(
 		monitoringptypes.ModuleName,
 		checkersmoduletypes.ModuleName,
 		leaderboardmoduletypes.ModuleName,
+		puzzlesmoduletypes.ModuleName,
 		// this line is used by starport scaffolding # stargate/app/beginBlockers
 	)
 
@@ -543,6 +563,7 @@ func NewApp(
 		monitoringptypes.ModuleName,
 		checkersmoduletypes.ModuleName,
 		leaderboardmoduletypes.ModuleName,
+		puzzlesmoduletypes.ModuleName,
 		// this line is used by starport scaffolding # stargate/app/endBlockers
 	)
 
@@ -573,6 +594,7 @@ func NewApp(
 		monitoringptypes.ModuleName,
 		checkersmoduletypes.ModuleName,
 		leaderboardmoduletypes.ModuleName,
+		puzzlesmoduletypes.ModuleName,
 		// this line is used by starport scaffolding # stargate/app/initGenesis
 	)
 
@@ -599,6 +621,7 @@ func NewApp(
 		monitoringModule,
 		checkersModule,
 		leaderboardModule,
+		puzzlesModule,
 		// this line is used by starport scaffolding # stargate/app/appModule
 	)
 	app.sm.RegisterStoreDecoders()
@@ -790,6 +813,7 @@ func initParamsKeeper(appCodec codec.BinaryCodec, legacyAmino *codec.LegacyAmino
 	paramsKeeper.Subspace(monitoringptypes.ModuleName)
 	paramsKeeper.Subspace(checkersmoduletypes.ModuleName)
 	paramsKeeper.Subspace(leaderboardmoduletypes.ModuleName)
+	paramsKeeper.Subspace(puzzlesmoduletypes.ModuleName)
 	// this line is used by starport scaffolding # stargate/app/paramSubspace
 
 	return paramsKeeper
@@ -18,6 +18,9 @@ func main() {
 		app.New,
 		// this line is used by starport scaffolding # root/arguments
 	)
+	if debugCmd, _, err := rootCmd.Find([]string{"debug"}); err == nil {
+		debugCmd.AddCommand(perftCmd())
+	}
 	if err := svrcmd.Execute(rootCmd, app.DefaultNodeHome); err != nil {
 		os.Exit(1)
 	}
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/satya/checkers/x/checkers/rules"
+	"github.com/spf13/cobra"
+)
+
+const flagDivide = "divide"
+
+func perftCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "perft [board] [turn] [depth]",
+		Short: "Count the positions reachable from a board at each depth",
+		Long: `Count the positions reachable from a board at each depth, to verify move
+generation against reference engines. A multi-jump counts as one move.
+
+The board uses the stored game layout, such as
+"*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
+and the turn is b or r. With --divide, the count at the last depth is also
+given per move of the player to move.`,
+		Example: `checkersd debug perft "*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*" b 6`,
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			game, err := rules.Parse(args[0])
+			if err != nil {
+				return err
+			}
+			piece, found := rules.StringPieces[args[1]]
+			if !found || piece.Player == rules.NO_PLAYER {
+				return fmt.Errorf("invalid turn: %s", args[1])
+			}
+			game.Turn = piece.Player
+			depth, err := strconv.ParseUint(args[2], 10, 32)
+			if err != nil {
+				return err
+			}
+			divide, err := cmd.Flags().GetBool(flagDivide)
+			if err != nil {
+				return err
+			}
+
+			for d := uint(1); d <= uint(depth); d++ {
+				cmd.Printf("depth %d: %d\n", d, rules.Perft(game, d))
+			}
+			if divide {
+				entries, err := rules.PerftDivide(game, uint(depth))
+				if err != nil {
+					return err
+				}
+				cmd.Println()
+				for _, entry := range entries {
+					cmd.Printf("%s: %d\n", entry.Move, entry.Nodes)
+				}
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().Bool(flagDivide, false, "list the count at the last depth per move")
+
+	return cmd
+}
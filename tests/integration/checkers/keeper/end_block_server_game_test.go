@@ -35,9 +35,9 @@ func (suite *IntegrationTestSuite) TestForfeitPlayedTwicePaidEmitted() {
 	keeper.ForfeitExpiredGames(goCtx)
 
 	events := sdk.StringifyEvents(suite.ctx.EventManager().ABCIEvents())
-	suite.Require().Len(events, 7)
+	suite.Require().Len(events, 8)
 
-	forfeitEvent := events[2]
+	forfeitEvent := events[3]
 	suite.Require().EqualValues(sdk.StringEvent{
 		Type: "game-forfeited",
 		Attributes: []sdk.Attribute{
@@ -47,7 +47,7 @@ func (suite *IntegrationTestSuite) TestForfeitPlayedTwicePaidEmitted() {
 		},
 	}, forfeitEvent)
 
-	transferEvent := events[6]
+	transferEvent := events[7]
 	suite.Require().Equal(transferEvent.Type, "transfer")
 	suite.Require().EqualValues([]sdk.Attribute{
 		{Key: "recipient", Value: carol},
@@ -13,7 +13,7 @@ func (suite *IntegrationTestSuite) TestCreate1GameHasSaved() {
 		Creator: alice,
 		Red:     bob,
 		Black:   carol,
-		Wager:   45,
+		Wager:   sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
 	})
 	keeper := suite.app.CheckersKeeper
 	systemInfo, found := keeper.GetSystemInfo(suite.ctx)
@@ -22,5 +22,6 @@ func (suite *IntegrationTestSuite) TestCreate1GameHasSaved() {
 		NextId:        2,
 		FifoHeadIndex: "1",
 		FifoTailIndex: "1",
+		NextMatchId:   1,
 	}, systemInfo)
 }
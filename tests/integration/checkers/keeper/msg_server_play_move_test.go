@@ -6,18 +6,30 @@ import (
 	"github.com/satya/checkers/x/checkers/types"
 )
 
-func (suite *IntegrationTestSuite) setupSuiteWithOneGameForPlayMove() {
+func (suite *IntegrationTestSuite) setupSuiteWithOneGameForAccept() {
 	suite.setupSuiteWithBalances()
 	goCtx := sdk.WrapSDKContext(suite.ctx)
 	suite.msgServer.CreateGame(goCtx, &types.MsgCreateGame{
 		Creator: alice,
 		Black:   bob,
 		Red:     carol,
-		Wager:   45,
-		Denom:   "stake",
+		Wager:   sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
 	})
 }
 
+func (suite *IntegrationTestSuite) setupSuiteWithOneGameForPlayMove() {
+	suite.setupSuiteWithOneGameForAccept()
+	suite.acceptGame("1", bob, carol)
+}
+
+func (suite *IntegrationTestSuite) acceptGame(gameIndex string, players ...string) {
+	goCtx := sdk.WrapSDKContext(suite.ctx)
+	for _, player := range players {
+		_, err := suite.msgServer.AcceptGame(goCtx, types.NewMsgAcceptGame(player, gameIndex))
+		suite.Require().Nil(err)
+	}
+}
+
 func (suite *IntegrationTestSuite) TestPlayMoveSavedGame() {
 	suite.setupSuiteWithOneGameForPlayMove()
 	goCtx := sdk.WrapSDKContext(suite.ctx)
@@ -36,6 +48,7 @@ func (suite *IntegrationTestSuite) TestPlayMoveSavedGame() {
 		NextId:        2,
 		FifoHeadIndex: "1",
 		FifoTailIndex: "1",
+		NextMatchId:   1,
 	}, systemInfo)
 	game1, found := keeper.GetStoredGame(suite.ctx, "1")
 	suite.Require().True(found)
@@ -50,41 +63,37 @@ func (suite *IntegrationTestSuite) TestPlayMoveSavedGame() {
 		AfterIndex:  "-1",
 		Deadline:    types.FormatDeadline(suite.ctx.BlockTime().Add(types.MaxTurnDuration)),
 		Winner:      "*",
-		Wager:       45,
-		Denom:       "stake",
+		Wager:       sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+		MoveHistory: []string{"9-14"},
+		Escrowed:    sdk.NewCoins(sdk.NewInt64Coin("stake", 90)),
+		Organizer:   alice,
 	}, game1)
 }
 
-func (suite *IntegrationTestSuite) TestPlayMovePlayerPaid() {
-	suite.setupSuiteWithOneGameForPlayMove()
-	goCtx := sdk.WrapSDKContext(suite.ctx)
+func (suite *IntegrationTestSuite) TestAcceptGamePlayersPaid() {
+	suite.setupSuiteWithOneGameForAccept()
 	suite.RequireBankBalance(balAlice, alice)
 	suite.RequireBankBalance(balBob, bob)
 	suite.RequireBankBalance(balCarol, carol)
 	suite.RequireBankBalance(0, checkersModuleAddress)
-	suite.msgServer.PlayMove(goCtx, &types.MsgPlayMove{
-		Creator:   bob,
-		GameIndex: "1",
-		FromX:     1,
-		FromY:     2,
-		ToX:       2,
-		ToY:       3,
-	})
+	suite.acceptGame("1", bob)
+	suite.RequireBankBalance(balBob, bob)
+	suite.RequireBankBalance(balCarol, carol)
+	suite.RequireBankBalance(0, checkersModuleAddress)
+	suite.acceptGame("1", carol)
 	suite.RequireBankBalance(balAlice, alice)
 	suite.RequireBankBalance(balBob-45, bob)
-	suite.RequireBankBalance(balCarol, carol)
-	suite.RequireBankBalance(45, checkersModuleAddress)
+	suite.RequireBankBalance(balCarol-45, carol)
+	suite.RequireBankBalance(90, checkersModuleAddress)
 }
 
-func (suite *IntegrationTestSuite) TestPlayMovePlayerPaidEvenZero() {
+func (suite *IntegrationTestSuite) TestPlayMoveNotPaidEvenZero() {
 	suite.setupSuiteWithBalances()
 	goCtx := sdk.WrapSDKContext(suite.ctx)
 	suite.msgServer.CreateGame(goCtx, &types.MsgCreateGame{
 		Creator: bob,
 		Black:   carol,
 		Red:     alice,
-		Wager:   0,
-		Denom:   "stake",
 	})
 	suite.RequireBankBalance(balAlice, alice)
 	suite.RequireBankBalance(balBob, bob)
@@ -104,29 +113,22 @@ func (suite *IntegrationTestSuite) TestPlayMovePlayerPaidEvenZero() {
 	suite.RequireBankBalance(0, checkersModuleAddress)
 }
 
-func (suite *IntegrationTestSuite) TestPlayMoveCannotPayFails() {
-	suite.setupSuiteWithOneGameForPlayMove()
+func (suite *IntegrationTestSuite) TestAcceptGameBlackCannotPayFails() {
+	suite.setupSuiteWithOneGameForAccept()
 	goCtx := sdk.WrapSDKContext(suite.ctx)
 	suite.msgServer.CreateGame(goCtx, &types.MsgCreateGame{
 		Creator: bob,
 		Black:   carol,
 		Red:     alice,
-		Wager:   balCarol + 1,
-		Denom:   "stake",
+		Wager:   sdk.NewCoins(sdk.NewInt64Coin("stake", balCarol+1)),
 	})
 	suite.RequireBankBalance(balAlice, alice)
 	suite.RequireBankBalance(balBob, bob)
 	suite.RequireBankBalance(balCarol, carol)
 	suite.RequireBankBalance(0, checkersModuleAddress)
-	playMoveResponse, err := suite.msgServer.PlayMove(goCtx, &types.MsgPlayMove{
-		Creator:   carol,
-		GameIndex: "2",
-		FromX:     1,
-		FromY:     2,
-		ToX:       2,
-		ToY:       3,
-	})
-	suite.Require().Nil(playMoveResponse)
+	suite.acceptGame("2", carol)
+	acceptResponse, err := suite.msgServer.AcceptGame(goCtx, types.NewMsgAcceptGame(alice, "2"))
+	suite.Require().Nil(acceptResponse)
 	suite.Require().Equal("black cannot pay the wager: 10000000stake is smaller than 10000001stake: insufficient funds", err.Error())
 }
 
@@ -143,9 +145,9 @@ func (suite *IntegrationTestSuite) TestPlayMoveEmitted() {
 	})
 
 	events := sdk.StringifyEvents(suite.ctx.EventManager().ABCIEvents())
-	suite.Require().Len(events, 6)
+	suite.Require().Len(events, 7)
 
-	playEvent := events[3]
+	playEvent := events[4]
 	suite.Require().EqualValues(sdk.StringEvent{
 		Type: "move-played",
 		Attributes: []sdk.Attribute{
@@ -155,8 +157,29 @@ func (suite *IntegrationTestSuite) TestPlayMoveEmitted() {
 			{Key: "captured-y", Value: "-1"},
 			{Key: "winner", Value: "*"},
 			{Key: "board", Value: "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|********|r*r*r*r*|*r*r*r*r|r*r*r*r*"},
+			{Key: "move", Value: "9-14"},
 		},
 	}, playEvent)
+}
+
+func (suite *IntegrationTestSuite) TestAcceptGameEmitted() {
+	suite.setupSuiteWithOneGameForPlayMove()
+
+	events := sdk.StringifyEvents(suite.ctx.EventManager().ABCIEvents())
+	suite.Require().Len(events, 6)
+
+	acceptEvent := events[2]
+	suite.Require().EqualValues(sdk.StringEvent{
+		Type: "game-accepted",
+		Attributes: []sdk.Attribute{
+			{Key: "player", Value: bob},
+			{Key: "game-index", Value: "1"},
+			{Key: "escrowed", Value: ""},
+			{Key: "player", Value: carol},
+			{Key: "game-index", Value: "1"},
+			{Key: "escrowed", Value: "90stake"},
+		},
+	}, acceptEvent)
 
 	transferEvent := events[5]
 	suite.Require().Equal(transferEvent.Type, "transfer")
@@ -164,18 +187,19 @@ func (suite *IntegrationTestSuite) TestPlayMoveEmitted() {
 		{Key: "recipient", Value: checkersModuleAddress},
 		{Key: "sender", Value: bob},
 		{Key: "amount", Value: "45stake"},
+		{Key: "recipient", Value: checkersModuleAddress},
+		{Key: "sender", Value: carol},
+		{Key: "amount", Value: "45stake"},
 	}, transferEvent.Attributes)
 }
 
-func (suite *IntegrationTestSuite) TestPlayMoveEmittedEvenZero() {
+func (suite *IntegrationTestSuite) TestPlayMoveEmittedNoTransferEvenZero() {
 	suite.setupSuiteWithBalances()
 	goCtx := sdk.WrapSDKContext(suite.ctx)
 	suite.msgServer.CreateGame(goCtx, &types.MsgCreateGame{
 		Creator: bob,
 		Black:   carol,
 		Red:     alice,
-		Wager:   0,
-		Denom:   "stake",
 	})
 	suite.msgServer.PlayMove(goCtx, &types.MsgPlayMove{
 		Creator:   carol,
@@ -187,9 +211,9 @@ func (suite *IntegrationTestSuite) TestPlayMoveEmittedEvenZero() {
 	})
 
 	events := sdk.StringifyEvents(suite.ctx.EventManager().ABCIEvents())
-	suite.Require().Len(events, 6)
+	suite.Require().Len(events, 2)
 
-	playEvent := events[3]
+	playEvent := events[0]
 	suite.Require().EqualValues(sdk.StringEvent{
 		Type: "move-played",
 		Attributes: []sdk.Attribute{
@@ -199,19 +223,12 @@ func (suite *IntegrationTestSuite) TestPlayMoveEmittedEvenZero() {
 			{Key: "captured-y", Value: "-1"},
 			{Key: "winner", Value: "*"},
 			{Key: "board", Value: "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|********|r*r*r*r*|*r*r*r*r|r*r*r*r*"},
+			{Key: "move", Value: "9-14"},
 		},
 	}, playEvent)
-
-	transferEvent := events[5]
-	suite.Require().Equal(transferEvent.Type, "transfer")
-	suite.Require().EqualValues([]sdk.Attribute{
-		{Key: "recipient", Value: checkersModuleAddress},
-		{Key: "sender", Value: carol},
-		{Key: "amount", Value: ""},
-	}, transferEvent.Attributes)
 }
 
-func (suite *IntegrationTestSuite) TestPlayMove2PlayerPaid() {
+func (suite *IntegrationTestSuite) TestPlayMove2DidNotPay() {
 	suite.setupSuiteWithOneGameForPlayMove()
 	goCtx := sdk.WrapSDKContext(suite.ctx)
 	suite.msgServer.PlayMove(goCtx, &types.MsgPlayMove{
@@ -224,8 +241,8 @@ func (suite *IntegrationTestSuite) TestPlayMove2PlayerPaid() {
 	})
 	suite.RequireBankBalance(balAlice, alice)
 	suite.RequireBankBalance(balBob-45, bob)
-	suite.RequireBankBalance(balCarol, carol)
-	suite.RequireBankBalance(45, checkersModuleAddress)
+	suite.RequireBankBalance(balCarol-45, carol)
+	suite.RequireBankBalance(90, checkersModuleAddress)
 	suite.msgServer.PlayMove(goCtx, &types.MsgPlayMove{
 		Creator:   carol,
 		GameIndex: "1",
@@ -240,37 +257,22 @@ func (suite *IntegrationTestSuite) TestPlayMove2PlayerPaid() {
 	suite.RequireBankBalance(90, checkersModuleAddress)
 }
 
-func (suite *IntegrationTestSuite) TestPlayMove2CannotPayFails() {
-	suite.setupSuiteWithOneGameForPlayMove()
+func (suite *IntegrationTestSuite) TestAcceptGameRedCannotPayFails() {
+	suite.setupSuiteWithOneGameForAccept()
 	goCtx := sdk.WrapSDKContext(suite.ctx)
 	suite.msgServer.CreateGame(goCtx, &types.MsgCreateGame{
 		Creator: alice,
 		Black:   bob,
 		Red:     carol,
-		Wager:   balCarol + 1,
-		Denom:   "stake",
+		Wager:   sdk.NewCoins(sdk.NewInt64Coin("stake", balCarol+1)),
 	})
 	suite.RequireBankBalance(balAlice, alice)
 	suite.RequireBankBalance(balBob, bob)
 	suite.RequireBankBalance(balCarol, carol)
 	suite.RequireBankBalance(0, checkersModuleAddress)
-	suite.msgServer.PlayMove(goCtx, &types.MsgPlayMove{
-		Creator:   bob,
-		GameIndex: "2",
-		FromX:     1,
-		FromY:     2,
-		ToX:       2,
-		ToY:       3,
-	})
-	playMoveResponse, err := suite.msgServer.PlayMove(goCtx, &types.MsgPlayMove{
-		Creator:   carol,
-		GameIndex: "2",
-		FromX:     0,
-		FromY:     5,
-		ToX:       1,
-		ToY:       4,
-	})
-	suite.Require().Nil(playMoveResponse)
+	suite.acceptGame("2", bob)
+	acceptResponse, err := suite.msgServer.AcceptGame(goCtx, types.NewMsgAcceptGame(carol, "2"))
+	suite.Require().Nil(acceptResponse)
 	suite.Require().Equal("red cannot pay the wager: 10000000stake is smaller than 10000001stake: insufficient funds", err.Error())
 }
 
@@ -322,21 +324,23 @@ func (suite *IntegrationTestSuite) TestPlayMoveToWinnerBankPaid() {
 
 func (suite *IntegrationTestSuite) TestPlayMoveToWinnerBankPaidDifferentTokens() {
 	suite.setupSuiteWithOneGameForPlayMove()
+	suite.app.CheckersKeeper.SetParams(suite.ctx, types.NewParams([]string{"stake", "coin"}, nil, 0, types.RakeToFeeCollector, 0, types.DefaultBetCutoffMove))
 	goCtx := sdk.WrapSDKContext(suite.ctx)
 	suite.msgServer.CreateGame(goCtx, &types.MsgCreateGame{
 		Creator: alice,
 		Black:   bob,
 		Red:     carol,
-		Wager:   46,
-		Denom:   "coin",
+		Wager:   sdk.NewCoins(sdk.NewInt64Coin("coin", 46)),
 	})
+	suite.acceptGame("2", bob, carol)
 	suite.RequireBankBalance(balAlice, alice)
 	suite.RequireBankBalanceWithDenom(0, "coin", alice)
-	suite.RequireBankBalance(balBob, bob)
-	suite.RequireBankBalanceWithDenom(balBob, "coin", bob)
-	suite.RequireBankBalance(balCarol, carol)
-	suite.RequireBankBalanceWithDenom(balCarol, "coin", carol)
-	suite.RequireBankBalance(0, checkersModuleAddress)
+	suite.RequireBankBalance(balBob-45, bob)
+	suite.RequireBankBalanceWithDenom(balBob-46, "coin", bob)
+	suite.RequireBankBalance(balCarol-45, carol)
+	suite.RequireBankBalanceWithDenom(balCarol-46, "coin", carol)
+	suite.RequireBankBalance(90, checkersModuleAddress)
+	suite.RequireBankBalanceWithDenom(92, "coin", checkersModuleAddress)
 	testutil.PlayAllMoves(suite.T(), suite.msgServer, sdk.WrapSDKContext(suite.ctx), "1", bob, carol, testutil.Game1Moves)
 	testutil.PlayAllMoves(suite.T(), suite.msgServer, sdk.WrapSDKContext(suite.ctx), "2", bob, carol, testutil.Game1Moves)
 	suite.RequireBankBalance(balAlice, alice)
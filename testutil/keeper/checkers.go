@@ -58,6 +58,16 @@ func CheckersKeeper(t testing.TB) (*keeper.Keeper, sdk.Context) {
 }
 
 func CheckersKeeperWithMocks(t testing.TB, bank *testutil.MockBankEscrowKeeper, leaderboard *testutil.MockCheckersLeaderboardKeeper) (*keeper.Keeper, sdk.Context) {
+	return CheckersKeeperWithTraceMock(t, bank, leaderboard, nil)
+}
+
+func CheckersKeeperWithTraceMock(t testing.TB, bank *testutil.MockBankEscrowKeeper, leaderboard *testutil.MockCheckersLeaderboardKeeper,
+	transfer *testutil.MockDenomTraceKeeper) (*keeper.Keeper, sdk.Context) {
+	return CheckersKeeperWithAllMocks(t, bank, leaderboard, transfer, nil)
+}
+
+func CheckersKeeperWithAllMocks(t testing.TB, bank *testutil.MockBankEscrowKeeper, leaderboard *testutil.MockCheckersLeaderboardKeeper,
+	transfer *testutil.MockDenomTraceKeeper, distribution *testutil.MockDistributionKeeper) (*keeper.Keeper, sdk.Context) {
 	storeKey := sdk.NewKVStoreKey(types.StoreKey)
 	memStoreKey := storetypes.NewMemoryStoreKey(types.MemStoreKey)
 
@@ -80,6 +90,8 @@ func CheckersKeeperWithMocks(t testing.TB, bank *testutil.MockBankEscrowKeeper,
 	k := keeper.NewKeeper(
 		bank,
 		leaderboard,
+		transfer,
+		distribution,
 		cdc,
 		storeKey,
 		memStoreKey,
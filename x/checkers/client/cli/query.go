@@ -29,6 +29,24 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(CmdListStoredGame())
 	cmd.AddCommand(CmdShowStoredGame())
 	cmd.AddCommand(CmdCanPlayMove())
+	cmd.AddCommand(CmdExportPdn())
+	cmd.AddCommand(CmdSuggestMove())
+	cmd.AddCommand(CmdEvaluatePosition())
+	cmd.AddCommand(CmdListColourDraw())
+	cmd.AddCommand(CmdShowColourDraw())
+	cmd.AddCommand(CmdListPremove())
+	cmd.AddCommand(CmdShowPremove())
+	cmd.AddCommand(CmdListVacation())
+	cmd.AddCommand(CmdShowVacation())
+	cmd.AddCommand(CmdGameWager())
+	cmd.AddCommand(CmdBetsByGame())
+	cmd.AddCommand(CmdBetsByBettor())
+	cmd.AddCommand(CmdListPlayerBalance())
+	cmd.AddCommand(CmdShowPlayerBalance())
+	cmd.AddCommand(CmdGameEscrow())
+	cmd.AddCommand(CmdEscrowByPlayer())
+	cmd.AddCommand(CmdListMatch())
+	cmd.AddCommand(CmdShowMatch())
 
 	// this line is used by starport scaffolding # 1
 
@@ -0,0 +1,83 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/satya/checkers/x/checkers/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdBetsByGame() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bets-by-game [game-index]",
+		Short: "list the bets on a game",
+		Long: `List the bets on a game.
+
+Also shows the whole pool backing black and the one backing red.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryBetsByGameRequest{
+				GameIndex:  args[0],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.BetsByGame(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdBetsByBettor() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bets-by-bettor [bettor]",
+		Short: "list the pending bets of a bettor",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryBetsByBettorRequest{
+				Bettor:     args[0],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.BetsByBettor(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
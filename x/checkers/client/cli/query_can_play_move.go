@@ -1,10 +1,12 @@
 package cli
 
 import (
+	"fmt"
 	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/satya/checkers/x/checkers/rules"
 	"github.com/satya/checkers/x/checkers/types"
 	"github.com/spf13/cast"
 	"github.com/spf13/cobra"
@@ -14,27 +16,48 @@ var _ = strconv.Itoa(0)
 
 func CmdCanPlayMove() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "can-play-move [game-index] [player] [from-x] [from-y] [to-x] [to-y]",
+		Use:   "can-play-move [game-index] [player] [move | from-x from-y to-x to-y]",
 		Short: "Query canPlayMove",
-		Args:  cobra.ExactArgs(6),
+		Long: `Query canPlayMove.
+
+The move is a single step or jump, given either in standard notation such as
+"11-15" or "15x24", or as 0-based coordinates. See play-move for the layout.`,
+		Args: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 3 && len(args) != 6 {
+				return fmt.Errorf("accepts 3 or 6 arg(s), received %d", len(args))
+			}
+			return nil
+		},
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			reqGameIndex := args[0]
 			reqPlayer := args[1]
-			reqFromX, err := cast.ToUint64E(args[2])
-			if err != nil {
-				return err
-			}
-			reqFromY, err := cast.ToUint64E(args[3])
-			if err != nil {
-				return err
-			}
-			reqToX, err := cast.ToUint64E(args[4])
-			if err != nil {
-				return err
-			}
-			reqToY, err := cast.ToUint64E(args[5])
-			if err != nil {
-				return err
+			var reqFromX, reqFromY, reqToX, reqToY uint64
+			if len(args) == 3 {
+				step, err := rules.ParseStep(args[2])
+				if err != nil {
+					return err
+				}
+				reqFromX = uint64(step.Src.X)
+				reqFromY = uint64(step.Src.Y)
+				reqToX = uint64(step.Dst.X)
+				reqToY = uint64(step.Dst.Y)
+			} else {
+				reqFromX, err = cast.ToUint64E(args[2])
+				if err != nil {
+					return err
+				}
+				reqFromY, err = cast.ToUint64E(args[3])
+				if err != nil {
+					return err
+				}
+				reqToX, err = cast.ToUint64E(args[4])
+				if err != nil {
+					return err
+				}
+				reqToY, err = cast.ToUint64E(args[5])
+				if err != nil {
+					return err
+				}
 			}
 
 			clientCtx, err := client.GetClientTxContext(cmd)
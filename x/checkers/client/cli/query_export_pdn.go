@@ -0,0 +1,45 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/satya/checkers/x/checkers/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdExportPdn() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-pdn [index]",
+		Short: "exports a storedGame as Portable Draughts Notation",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			argIndex := args[0]
+
+			params := &types.QueryGetStoredGameRequest{
+				Index: argIndex,
+			}
+
+			res, err := queryClient.StoredGame(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			pdn, err := res.StoredGame.ExportPDN()
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintString(pdn)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
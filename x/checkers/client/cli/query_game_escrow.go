@@ -0,0 +1,97 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/satya/checkers/x/checkers/types"
+	"github.com/spf13/cobra"
+)
+
+var _ = strconv.Itoa(0)
+
+func CmdGameEscrow() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "game-escrow [game-index]",
+		Short: "Query gameEscrow",
+		Long: `Query gameEscrow.
+
+Shows what each player and the sponsor put in escrow for a game, and who would
+get what, fees included, if the game were won by either player, drawn,
+forfeited or expired now.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			reqGameIndex := args[0]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryGameEscrowRequest{
+
+				GameIndex: reqGameIndex,
+			}
+
+			res, err := queryClient.GameEscrow(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdEscrowByPlayer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "escrow-by-player [player]",
+		Short: "Query escrowByPlayer",
+		Long: `Query escrowByPlayer.
+
+Shows, for each running game, what the address has locked in it as a player,
+a sponsor, a bettor or through a premove deposit, then its match wagers, and
+the total of the page.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			reqPlayer := args[0]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryEscrowByPlayerRequest{
+
+				Player:     reqPlayer,
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.EscrowByPlayer(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,58 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/satya/checkers/x/checkers/types"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+var _ = strconv.Itoa(0)
+
+func CmdSuggestMove() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "suggest-move [game-index] [depth]",
+		Short: "Query suggestMove",
+		Long: `Query suggestMove.
+
+The depth is optional. It defaults to 4 and is capped at 8.`,
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			reqGameIndex := args[0]
+			var reqDepth uint64
+			if len(args) == 2 {
+				reqDepth, err = cast.ToUint64E(args[1])
+				if err != nil {
+					return err
+				}
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QuerySuggestMoveRequest{
+
+				GameIndex: reqGameIndex,
+				Depth:     reqDepth,
+			}
+
+			res, err := queryClient.SuggestMove(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
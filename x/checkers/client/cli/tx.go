@@ -18,6 +18,14 @@ var (
 const (
 	flagPacketTimeoutTimestamp = "packet-timeout-timestamp"
 	listSeparator              = ","
+	flagHouse                  = "house"
+	flagWager                  = "wager"
+	flagPrize                  = "prize"
+	flagBoard                  = "board"
+	flagTurn                   = "turn"
+	flagBallot                 = "ballot"
+	flagColourDraw             = "colour-draw"
+	flagExpectedMoveCount      = "expected-move-count"
 )
 
 // GetTxCmd returns the transaction commands for this module
@@ -32,6 +40,25 @@ func GetTxCmd() *cobra.Command {
 
 	cmd.AddCommand(CmdCreateGame())
 	cmd.AddCommand(CmdPlayMove())
+	cmd.AddCommand(CmdFundHouseBankroll())
+	cmd.AddCommand(CmdCommitColour())
+	cmd.AddCommand(CmdRevealColour())
+	cmd.AddCommand(CmdSetPremove())
+	cmd.AddCommand(CmdRequestTakeback())
+	cmd.AddCommand(CmdAcceptTakeback())
+	cmd.AddCommand(CmdStartVacation())
+	cmd.AddCommand(CmdEndVacation())
+	cmd.AddCommand(CmdProposeAdjourn())
+	cmd.AddCommand(CmdAcceptAdjourn())
+	cmd.AddCommand(CmdResumeGame())
+	cmd.AddCommand(CmdAcceptGame())
+	cmd.AddCommand(CmdPlaceBet())
+	cmd.AddCommand(CmdDeposit())
+	cmd.AddCommand(CmdWithdraw())
+	cmd.AddCommand(CmdCreateMatch())
+	cmd.AddCommand(CmdAcceptMatch())
+	cmd.AddCommand(CmdOfferRematch())
+	cmd.AddCommand(CmdAcceptRematch())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
@@ -0,0 +1,45 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/satya/checkers/x/checkers/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdCommitColour() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "commit-colour [game-index] [secret]",
+		Short: "Broadcast message commitColour",
+		Long: `Broadcast message commitColour.
+
+Only the commitment of the secret is sent, computed here from the sender
+address. Keep the secret to reveal it once both players have committed.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argGameIndex := args[0]
+			argSecret := args[1]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			creator := clientCtx.GetFromAddress().String()
+			msg := types.NewMsgCommitColour(
+				creator,
+				argGameIndex,
+				types.ColourCommitment(creator, argSecret),
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
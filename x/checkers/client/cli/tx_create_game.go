@@ -6,6 +6,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/satya/checkers/x/checkers/types"
 	"github.com/spf13/cobra"
 )
@@ -14,17 +15,74 @@ var _ = strconv.Itoa(0)
 
 func CmdCreateGame() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "create-game [black] [red] [wager] [denom]",
+		Use:   "create-game [black] [red]",
 		Short: "Broadcast message createGame",
-		Args:  cobra.ExactArgs(4),
+		Long: `Broadcast message createGame.
+
+With --wager, each player stakes the given coins, as in --wager 100token,5stake.
+Their denoms must be allowed by the params. Both stakes go into escrow once
+both players have accepted the game.
+
+With --prize, the creator sponsors a prize for the winner, as in --prize
+1000token, with or without wagers. It is escrowed from the creator at once,
+split between the players on a draw, and returned if the game is dropped.
+
+With --house b or --house r, the chain plays that colour and answers moves in
+the same transaction. The address given for the house colour is ignored and
+may be left empty, as in "".
+
+With --board and --turn, the game starts from a custom position, such as a
+handicap or an endgame drill. The board uses the stored game layout, for
+instance "*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
+and the turn is b or r. Such games are unrated.
+
+With --ballot, the game starts after a three-move ballot opening drawn from
+the block hash, as in championship play. The ballot is shown on the game.
+
+With --colour-draw, black and red are only candidates. Each commits to a
+secret with commit-colour, then reveals it with reveal-colour, and the secrets
+together decide who plays black. A player who fails to commit or reveal in
+time while the other did loses by forfeit.`,
+		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			argBlack := args[0]
 			argRed := args[1]
-			argWager, err := strconv.ParseUint(args[2], 10, 64)
+			wager, err := cmd.Flags().GetString(flagWager)
+			if err != nil {
+				return err
+			}
+			argWager, err := sdk.ParseCoinsNormalized(wager)
+			if err != nil {
+				return err
+			}
+			prize, err := cmd.Flags().GetString(flagPrize)
+			if err != nil {
+				return err
+			}
+			argPrize, err := sdk.ParseCoinsNormalized(prize)
+			if err != nil {
+				return err
+			}
+			argHouse, err := cmd.Flags().GetString(flagHouse)
+			if err != nil {
+				return err
+			}
+			argBoard, err := cmd.Flags().GetString(flagBoard)
+			if err != nil {
+				return err
+			}
+			argTurn, err := cmd.Flags().GetString(flagTurn)
+			if err != nil {
+				return err
+			}
+			argBallot, err := cmd.Flags().GetBool(flagBallot)
+			if err != nil {
+				return err
+			}
+			argColourDraw, err := cmd.Flags().GetBool(flagColourDraw)
 			if err != nil {
 				return err
 			}
-			argDenom := args[3]
 
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -36,8 +94,13 @@ func CmdCreateGame() *cobra.Command {
 				argBlack,
 				argRed,
 				argWager,
-				argDenom,
+				argHouse,
 			)
+			msg.Prize = argPrize
+			msg.Board = argBoard
+			msg.Turn = argTurn
+			msg.Ballot = argBallot
+			msg.ColourDraw = argColourDraw
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -45,6 +108,13 @@ func CmdCreateGame() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(flagWager, "", "stake of each player, as in 100token,5stake")
+	cmd.Flags().String(flagPrize, "", "prize sponsored by the creator for the winner, as in 1000token")
+	cmd.Flags().String(flagHouse, "", "colour played by the house, b or r")
+	cmd.Flags().String(flagBoard, "", "custom starting board")
+	cmd.Flags().String(flagTurn, "", "colour to move on the custom starting board, b or r")
+	cmd.Flags().Bool(flagBallot, false, "start from a three-move ballot opening")
+	cmd.Flags().Bool(flagColourDraw, false, "draw colours by commit-reveal")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -0,0 +1,61 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/satya/checkers/x/checkers/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdCreateMatch() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-match [player-a] [player-b] [number-of-games] [wager]",
+		Short: "Broadcast message createMatch",
+		Long: `Broadcast message createMatch.
+
+Creates a best-of-N match between two players, who alternate colours from game
+to game, starting with player A as black. Each player stakes the wager, as in
+100stake, once for the whole match, and the games start when both have
+accepted. A win scores a point and a draw half a point, and the match ends as
+soon as a player has more than half of the points. The winner takes both
+stakes less the platform rake. A drawn match refunds each player.`,
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argPlayerA := args[0]
+			argPlayerB := args[1]
+			argNumberOfGames, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+			argWager, err := sdk.ParseCoinsNormalized(args[3])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateMatch(
+				clientCtx.GetFromAddress().String(),
+				argPlayerA,
+				argPlayerB,
+				argNumberOfGames,
+				argWager,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,53 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/satya/checkers/x/checkers/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdPlaceBet() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "place-bet [game-index] [side] [amount]",
+		Short: "Broadcast message placeBet",
+		Long: `Broadcast message placeBet.
+
+Backs black or red, as b or r, with the given coins, as in 100stake, until the
+game reaches the move set by the params. Players cannot bet on their own game.
+When the game is won, the bets on the winner share the whole pool in
+proportion to their stake. Bets are refunded on a draw or when the game is
+dropped.`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argGameIndex := args[0]
+			argSide := args[1]
+			argAmount, err := sdk.ParseCoinsNormalized(args[2])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgPlaceBet(
+				clientCtx.GetFromAddress().String(),
+				argGameIndex,
+				argSide,
+				argAmount,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -1,11 +1,13 @@
 package cli
 
 import (
+	"fmt"
 	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/satya/checkers/x/checkers/types"
 	"github.com/spf13/cast"
 	"github.com/spf13/cobra"
@@ -15,48 +17,88 @@ var _ = strconv.Itoa(0)
 
 func CmdPlayMove() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "play-move [game-index] [from-x] [from-y] [to-x] [to-y]",
+		Use:   "play-move [game-index] [move | from-x from-y to-x to-y]",
 		Short: "Broadcast message playMove",
-		Args:  cobra.ExactArgs(5),
+		Long: `Broadcast message playMove.
+
+The move is given either in standard notation, with squares numbered 1 to 32
+as in "11-15" or the multi-jump "11x18x25", or as 0-based coordinates. Black
+starts on squares 1 to 12, which are rows y = 0 to 2. Square 1 is x = 1, y = 0.
+A multi-jump is sent as one message per jump in a single transaction.
+
+With --expected-move-count, the move is rejected if the game's move count has
+changed, for instance because an earlier move of a retrying client landed.`,
+		Args: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 2 && len(args) != 5 {
+				return fmt.Errorf("accepts 2 or 5 arg(s), received %d", len(args))
+			}
+			return nil
+		},
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			argGameIndex := args[0]
-			argFromX, err := cast.ToUint64E(args[1])
-			if err != nil {
-				return err
-			}
-			argFromY, err := cast.ToUint64E(args[2])
-			if err != nil {
-				return err
-			}
-			argToX, err := cast.ToUint64E(args[3])
+
+			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
-			argToY, err := cast.ToUint64E(args[4])
-			if err != nil {
-				return err
+
+			var msgs []*types.MsgPlayMove
+			if len(args) == 2 {
+				msgs, err = types.NewMsgPlayMovesFromNotation(
+					clientCtx.GetFromAddress().String(),
+					argGameIndex,
+					args[1],
+				)
+				if err != nil {
+					return err
+				}
+			} else {
+				argFromX, err := cast.ToUint64E(args[1])
+				if err != nil {
+					return err
+				}
+				argFromY, err := cast.ToUint64E(args[2])
+				if err != nil {
+					return err
+				}
+				argToX, err := cast.ToUint64E(args[3])
+				if err != nil {
+					return err
+				}
+				argToY, err := cast.ToUint64E(args[4])
+				if err != nil {
+					return err
+				}
+				msgs = append(msgs, types.NewMsgPlayMove(
+					clientCtx.GetFromAddress().String(),
+					argGameIndex,
+					argFromX,
+					argFromY,
+					argToX,
+					argToY,
+				))
 			}
 
-			clientCtx, err := client.GetClientTxContext(cmd)
-			if err != nil {
-				return err
+			if cmd.Flags().Changed(flagExpectedMoveCount) {
+				argExpectedMoveCount, err := cmd.Flags().GetUint64(flagExpectedMoveCount)
+				if err != nil {
+					return err
+				}
+				types.SetExpectedMoveCounts(msgs, argExpectedMoveCount)
 			}
 
-			msg := types.NewMsgPlayMove(
-				clientCtx.GetFromAddress().String(),
-				argGameIndex,
-				argFromX,
-				argFromY,
-				argToX,
-				argToY,
-			)
-			if err := msg.ValidateBasic(); err != nil {
-				return err
+			sdkMsgs := make([]sdk.Msg, 0, len(msgs))
+			for _, msg := range msgs {
+				if err := msg.ValidateBasic(); err != nil {
+					return err
+				}
+				sdkMsgs = append(sdkMsgs, msg)
 			}
-			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), sdkMsgs...)
 		},
 	}
 
+	cmd.Flags().Uint64(flagExpectedMoveCount, 0, "move count of the game the move was computed against")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
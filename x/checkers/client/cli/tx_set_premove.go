@@ -0,0 +1,64 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/satya/checkers/x/checkers/types"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+func CmdSetPremove() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-premove [game-index] [if-move] [reply] [deposit]",
+		Short: "Broadcast message setPremove",
+		Long: `Broadcast message setPremove.
+
+Plays reply, such as 11-15, as soon as the opponent plays if-move, such as
+22-18. The deposit, in the game's denom, pays for the gas of the reply and the
+rest is refunded. With only the game index, the premove is cleared.`,
+		Args: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 && len(args) != 4 {
+				return fmt.Errorf("accepts 1 or 4 arg(s), received %d", len(args))
+			}
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argGameIndex := args[0]
+			var argIfMove, argReply string
+			var argDeposit uint64
+			if len(args) == 4 {
+				argIfMove = args[1]
+				argReply = args[2]
+				argDeposit, err = cast.ToUint64E(args[3])
+				if err != nil {
+					return err
+				}
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetPremove(
+				clientCtx.GetFromAddress().String(),
+				argGameIndex,
+				argIfMove,
+				argReply,
+				argDeposit,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
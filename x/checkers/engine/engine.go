@@ -0,0 +1,177 @@
+// Package engine searches checkers positions with a deterministic alpha-beta
+// minimax. It never reads the clock, randomness or map iteration order, and it
+// stops on a fixed node budget, so the same position and limits always give
+// the same answer. This makes it safe to call from state machine code.
+package engine
+
+import (
+	"github.com/satya/checkers/x/checkers/rules"
+)
+
+const (
+	MAN_VALUE      = 100
+	KING_VALUE     = 160
+	ADVANCE_VALUE  = 4
+	CENTER_VALUE   = 6
+	BACK_ROW_VALUE = 8
+	WIN_SCORE      = 1_000_000
+)
+
+type Limits struct {
+	// MaxDepth is the deepest search, in steps. A jump that continues a
+	// multi-jump does not use up depth.
+	MaxDepth uint64
+	// NodeBudget caps the positions visited over the whole search.
+	NodeBudget uint64
+}
+
+type Result struct {
+	// Line is the best line found. It starts with the steps the player to
+	// move should play, which is more than one for a multi-jump.
+	Line []rules.Step
+	// Score is from black's point of view.
+	Score int64
+	// Depth is the deepest search completed within the node budget.
+	Depth uint64
+	// Nodes is the number of positions visited.
+	Nodes uint64
+}
+
+// BestMove returns the steps of Line that belong to the player to move.
+func (result Result) BestMove(game *rules.Game) []rules.Step {
+	next := game.Copy()
+	player := next.Turn
+	var steps []rules.Step
+	for _, step := range result.Line {
+		if next.Turn != player {
+			break
+		}
+		if _, err := next.Move(step.Src, step.Dst); err != nil {
+			break
+		}
+		steps = append(steps, step)
+	}
+	return steps
+}
+
+type searcher struct {
+	budget    uint64
+	nodes     uint64
+	exhausted bool
+}
+
+// Evaluate scores a position from black's point of view, using material,
+// advancement of men, central squares and the guard of the home row.
+func Evaluate(game *rules.Game) int64 {
+	switch game.Winner() {
+	case rules.BLACK_PLAYER:
+		return WIN_SCORE
+	case rules.RED_PLAYER:
+		return -WIN_SCORE
+	}
+	var score int64
+	for pos, piece := range game.Pieces {
+		var value int64
+		if piece.King {
+			value = KING_VALUE
+		} else {
+			value = MAN_VALUE
+			advance := pos.Y
+			homeRow := 0
+			if piece.Player == rules.RED_PLAYER {
+				advance = rules.BOARD_DIM - 1 - pos.Y
+				homeRow = rules.BOARD_DIM - 1
+			}
+			value += int64(advance * ADVANCE_VALUE)
+			if pos.Y == homeRow {
+				value += BACK_ROW_VALUE
+			}
+		}
+		if 2 <= pos.X && pos.X <= 5 && 2 <= pos.Y && pos.Y <= 5 {
+			value += CENTER_VALUE
+		}
+		if piece.Player == rules.BLACK_PLAYER {
+			score += value
+		} else {
+			score -= value
+		}
+	}
+	return score
+}
+
+// Search runs an iterative deepening alpha-beta search. A depth that runs out
+// of node budget is discarded, so the result is that of the deepest complete
+// search. Searching stops early once a forced win is found.
+func Search(game *rules.Game, limits Limits) Result {
+	s := &searcher{budget: limits.NodeBudget}
+	result := Result{Score: Evaluate(game)}
+	for depth := uint64(1); depth <= limits.MaxDepth; depth++ {
+		score, line := s.search(game, depth, 0, -WIN_SCORE-1, WIN_SCORE+1)
+		if s.exhausted {
+			break
+		}
+		result.Line = line
+		result.Score = score
+		result.Depth = depth
+		if score > WIN_SCORE/2 || score < -WIN_SCORE/2 {
+			break
+		}
+	}
+	result.Nodes = s.nodes
+	return result
+}
+
+func (s *searcher) search(game *rules.Game, depth uint64, ply int64, alpha int64, beta int64) (int64, []rules.Step) {
+	if s.nodes >= s.budget {
+		s.exhausted = true
+		return 0, nil
+	}
+	s.nodes++
+	switch game.Winner() {
+	case rules.BLACK_PLAYER:
+		return WIN_SCORE - ply, nil
+	case rules.RED_PLAYER:
+		return -WIN_SCORE + ply, nil
+	}
+	steps := game.LegalSteps()
+	if len(steps) == 0 {
+		if game.Turn == rules.BLACK_PLAYER {
+			return -WIN_SCORE + ply, nil
+		}
+		return WIN_SCORE - ply, nil
+	}
+	if depth == 0 {
+		return Evaluate(game), nil
+	}
+
+	maximizing := game.Turn == rules.BLACK_PLAYER
+	var best int64
+	var bestLine []rules.Step
+	for i, step := range steps {
+		next := game.Copy()
+		if _, err := next.Move(step.Src, step.Dst); err != nil {
+			panic(err.Error())
+		}
+		nextDepth := depth - 1
+		if step.IsJump() && next.Turn == game.Turn {
+			nextDepth = depth
+		}
+		score, line := s.search(next, nextDepth, ply+1, alpha, beta)
+		if s.exhausted {
+			return 0, nil
+		}
+		if i == 0 || (maximizing && score > best) || (!maximizing && score < best) {
+			best = score
+			bestLine = append([]rules.Step{step}, line...)
+		}
+		if maximizing && alpha < best {
+			alpha = best
+		} else if !maximizing && best < beta {
+			beta = best
+		}
+		if beta <= alpha {
+			break
+		}
+	}
+	return best, bestLine
+}
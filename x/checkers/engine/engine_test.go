@@ -0,0 +1,56 @@
+package engine_test
+
+import (
+	"testing"
+
+	"github.com/satya/checkers/x/checkers/engine"
+	"github.com/satya/checkers/x/checkers/rules"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEvaluateInitialIsBalanced(t *testing.T) {
+	require.EqualValues(t, 0, engine.Evaluate(rules.New()))
+}
+
+func TestSearchIsDeterministic(t *testing.T) {
+	limits := engine.Limits{MaxDepth: 5, NodeBudget: 50_000}
+	first := engine.Search(rules.New(), limits)
+	for i := 0; i < 5; i++ {
+		require.Equal(t, first, engine.Search(rules.New(), limits))
+	}
+	require.EqualValues(t, 5, first.Depth)
+	require.Len(t, first.BestMove(rules.New()), 1)
+}
+
+func TestSearchRespectsNodeBudget(t *testing.T) {
+	result := engine.Search(rules.New(), engine.Limits{MaxDepth: 20, NodeBudget: 1_000})
+	require.LessOrEqual(t, result.Nodes, uint64(1_000))
+	require.Less(t, result.Depth, uint64(20))
+	require.NotEmpty(t, result.Line)
+}
+
+func TestSearchZeroBudgetHasNoMove(t *testing.T) {
+	result := engine.Search(rules.New(), engine.Limits{MaxDepth: 3, NodeBudget: 0})
+	require.EqualValues(t, 0, result.Depth)
+	require.Empty(t, result.Line)
+}
+
+func TestSearchFindsMultiJumpWin(t *testing.T) {
+	game, err := rules.ParseFEN("B:W14,23:B9")
+	require.Nil(t, err)
+	result := engine.Search(game, engine.Limits{MaxDepth: 2, NodeBudget: 10_000})
+	require.Greater(t, result.Score, int64(engine.WIN_SCORE/2))
+	require.Equal(t, []rules.Step{
+		{Src: rules.Pos{X: 1, Y: 2}, Dst: rules.Pos{X: 3, Y: 4}},
+		{Src: rules.Pos{X: 3, Y: 4}, Dst: rules.Pos{X: 5, Y: 6}},
+	}, result.BestMove(game))
+}
+
+func TestSearchRedMinimizes(t *testing.T) {
+	game, err := rules.ParseFEN("W:W18:B14,K1")
+	require.Nil(t, err)
+	result := engine.Search(game, engine.Limits{MaxDepth: 1, NodeBudget: 1_000})
+	require.Equal(t, []rules.Step{
+		{Src: rules.Pos{X: 3, Y: 4}, Dst: rules.Pos{X: 1, Y: 2}},
+	}, result.BestMove(game))
+}
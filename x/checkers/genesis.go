@@ -18,8 +18,37 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	for _, elem := range genState.StoredGameList {
 		k.SetStoredGame(ctx, elem)
 	}
+	k.SetHouseBankroll(ctx, genState.HouseBankroll)
+	// Set all the colourDraw
+	for _, elem := range genState.ColourDrawList {
+		k.SetColourDraw(ctx, elem)
+	}
+	// Set all the premove
+	for _, elem := range genState.PremoveList {
+		k.SetPremove(ctx, elem)
+	}
+	// Set all the vacation
+	for _, elem := range genState.VacationList {
+		k.SetVacation(ctx, elem)
+	}
+	// Set all the bet
+	for _, elem := range genState.BetList {
+		k.SetBet(ctx, elem)
+	}
+	// Set all the playerBalance
+	for _, elem := range genState.PlayerBalanceList {
+		k.SetPlayerBalance(ctx, elem)
+	}
+	// Set all the match
+	for _, elem := range genState.MatchList {
+		k.SetMatch(ctx, elem)
+	}
 	// this line is used by starport scaffolding # genesis/module/init
 	k.SetParams(ctx, genState.Params)
+	// The bank genesis runs first, so the module account is already funded.
+	if err := k.ValidateHeldFundsBacked(ctx); err != nil {
+		panic(err)
+	}
 }
 
 // ExportGenesis returns the capability module's exported genesis.
@@ -33,6 +62,16 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		genesis.SystemInfo = systemInfo
 	}
 	genesis.StoredGameList = k.GetAllStoredGame(ctx)
+	houseBankroll, found := k.GetHouseBankroll(ctx)
+	if found {
+		genesis.HouseBankroll = houseBankroll
+	}
+	genesis.ColourDrawList = k.GetAllColourDraw(ctx)
+	genesis.PremoveList = k.GetAllPremove(ctx)
+	genesis.VacationList = k.GetAllVacation(ctx)
+	genesis.BetList = k.GetAllBet(ctx)
+	genesis.PlayerBalanceList = k.GetAllPlayerBalance(ctx)
+	genesis.MatchList = k.GetAllMatch(ctx)
 	// this line is used by starport scaffolding # genesis/module/export
 
 	return genesis
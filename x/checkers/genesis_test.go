@@ -25,6 +25,50 @@ func TestGenesis(t *testing.T) {
 				Index: "1",
 			},
 		},
+		ColourDrawList: []types.ColourDraw{
+			{
+				Index: "1",
+			},
+		},
+		PremoveList: []types.Premove{
+			{
+				Index: "0",
+			},
+		},
+		VacationList: []types.Vacation{
+			{
+				Index: "0",
+			},
+			{
+				Index: "1",
+			},
+		},
+		BetList: []types.Bet{
+			{
+				GameIndex: "0",
+				Bettor:    "0",
+			},
+			{
+				GameIndex: "1",
+				Bettor:    "0",
+			},
+		},
+		PlayerBalanceList: []types.PlayerBalance{
+			{
+				Index: "0",
+			},
+			{
+				Index: "1",
+			},
+		},
+		MatchList: []types.Match{
+			{
+				Index: "0",
+			},
+			{
+				Index: "1",
+			},
+		},
 		// this line is used by starport scaffolding # genesis/test/state
 	}
 
@@ -38,5 +82,11 @@ func TestGenesis(t *testing.T) {
 
 	require.Equal(t, genesisState.SystemInfo, got.SystemInfo)
 	require.ElementsMatch(t, genesisState.StoredGameList, got.StoredGameList)
+	require.ElementsMatch(t, genesisState.ColourDrawList, got.ColourDrawList)
+	require.ElementsMatch(t, genesisState.PremoveList, got.PremoveList)
+	require.ElementsMatch(t, genesisState.VacationList, got.VacationList)
+	require.ElementsMatch(t, genesisState.BetList, got.BetList)
+	require.ElementsMatch(t, genesisState.PlayerBalanceList, got.PlayerBalanceList)
+	require.ElementsMatch(t, genesisState.MatchList, got.MatchList)
 	// this line is used by starport scaffolding # genesis/test/assert
 }
@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/satya/checkers/x/checkers/rules"
+	"github.com/satya/checkers/x/checkers/types"
+)
+
+// getAdjournGame returns a game in progress in which creator plays.
+func (k Keeper) getAdjournGame(ctx sdk.Context, gameIndex string, creator string) (storedGame types.StoredGame, err error) {
+	storedGame, found := k.GetStoredGame(ctx, gameIndex)
+	if !found {
+		return storedGame, sdkerrors.Wrapf(types.ErrGameNotFound, "%s", gameIndex)
+	}
+	if storedGame.Winner != rules.PieceStrings[rules.NO_PLAYER] {
+		return storedGame, types.ErrGameFinished
+	}
+	if storedGame.Black != creator && storedGame.Red != creator {
+		return storedGame, sdkerrors.Wrapf(types.ErrCreatorNotPlayer, "%s", creator)
+	}
+	return storedGame, nil
+}
+
+// adjournGame takes the game off the clock until the end of the maximum
+// adjournment. A freeze for vacation no longer applies, as resuming gives a
+// fresh deadline.
+func (k Keeper) adjournGame(ctx sdk.Context, storedGame *types.StoredGame) {
+	systemInfo, found := k.GetSystemInfo(ctx)
+	if !found {
+		panic("SystemInfo not found")
+	}
+	k.RemoveFromFifo(ctx, storedGame, &systemInfo)
+	if storedGame.FrozenRemaining != "" {
+		k.dropFreeze(ctx, storedGame)
+	}
+	storedGame.AdjournProposer = ""
+	storedGame.AdjournedUntil = types.FormatDeadline(ctx.BlockTime().Add(types.MaxAdjournDuration))
+	k.SetStoredGame(ctx, *storedGame)
+	k.SetSystemInfo(ctx, systemInfo)
+}
+
+// EndExpiredAdjournments ends the games that were not resumed in time. A game
+// in which the second mover has not played is dropped and refunded, like on a
+// forfeit. Otherwise it is drawn and each player gets their wager back. Draws
+// are not registered on the leaderboard.
+func (k Keeper) EndExpiredAdjournments(goCtx context.Context) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	systemInfo, found := k.GetSystemInfo(ctx)
+	if !found {
+		panic("SystemInfo not found")
+	}
+	for _, storedGame := range k.GetAllAdjournedStoredGame(ctx) {
+		until, err := storedGame.GetAdjournedUntilAsTime()
+		if err != nil {
+			panic(err.Error())
+		}
+		if until.After(ctx.BlockTime()) {
+			continue
+		}
+		lastBoard := storedGame.Board
+		storedGame.AdjournedUntil = ""
+		if !storedGame.HasSecondMoverPlayed() {
+			k.RemoveStoredGame(ctx, storedGame.Index)
+			k.MustRefundWager(ctx, &storedGame)
+			k.MustSettleBets(ctx, &storedGame)
+			k.MustAdvanceMatch(ctx, &storedGame, &systemInfo)
+		} else {
+			storedGame.Winner = types.DrawWinner
+			k.MustRefundWager(ctx, &storedGame)
+			k.MustSettleBets(ctx, &storedGame)
+			storedGame.Board = ""
+			k.SetStoredGame(ctx, storedGame)
+			k.MustAdvanceMatch(ctx, &storedGame, &systemInfo)
+		}
+		if premove, found := k.GetPremove(ctx, storedGame.Index); found {
+			k.ClearPremove(ctx, &storedGame, premove)
+		}
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(types.AdjournExpiredEventType,
+				sdk.NewAttribute(types.AdjournExpiredEventGameIndex, storedGame.Index),
+				sdk.NewAttribute(types.AdjournExpiredEventWinner, storedGame.Winner),
+				sdk.NewAttribute(types.AdjournExpiredEventBoard, lastBoard),
+			),
+		)
+	}
+	k.SetSystemInfo(ctx, systemInfo)
+}
@@ -0,0 +1,18 @@
+package keeper
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/satya/checkers/x/checkers/rules"
+)
+
+// DrawBallot picks the opening of a new game from the block hash, which
+// neither player controls. The game index sets apart the games created in the
+// same block.
+func (k Keeper) DrawBallot(ctx sdk.Context, gameIndex string) string {
+	seed := append(append([]byte{}, ctx.HeaderHash()...), []byte(gameIndex)...)
+	hash := sha256.Sum256(seed)
+	return rules.Ballots[binary.BigEndian.Uint64(hash[:8])%uint64(len(rules.Ballots))]
+}
@@ -0,0 +1,114 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/satya/checkers/x/checkers/types"
+)
+
+// SetBet set a specific bet in the store from its index, and indexes it by
+// bettor and as an escrow of the bettor
+func (k Keeper) SetBet(ctx sdk.Context, bet types.Bet) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BetKeyPrefix))
+	b := k.cdc.MustMarshal(&bet)
+	store.Set(types.BetKey(
+		bet.GameIndex,
+		bet.Bettor,
+	), b)
+	byBettorStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BetByBettorKeyPrefix))
+	byBettorStore.Set(types.BetByBettorKey(
+		bet.Bettor,
+		bet.GameIndex,
+	), []byte(bet.GameIndex))
+	k.indexGameEscrows(ctx, bet.GameIndex, bet.Bettor)
+}
+
+// GetBet returns a bet from its index
+func (k Keeper) GetBet(
+	ctx sdk.Context,
+	gameIndex string,
+	bettor string,
+
+) (val types.Bet, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BetKeyPrefix))
+
+	b := store.Get(types.BetKey(
+		gameIndex,
+		bettor,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveBet removes a bet from the store, and from the indexes by bettor
+func (k Keeper) RemoveBet(
+	ctx sdk.Context,
+	gameIndex string,
+	bettor string,
+
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BetKeyPrefix))
+	store.Delete(types.BetKey(
+		gameIndex,
+		bettor,
+	))
+	byBettorStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BetByBettorKeyPrefix))
+	byBettorStore.Delete(types.BetByBettorKey(
+		bettor,
+		gameIndex,
+	))
+	k.indexGameEscrows(ctx, gameIndex, bettor)
+}
+
+// GetAllBet returns all bet
+func (k Keeper) GetAllBet(ctx sdk.Context) (list []types.Bet) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BetKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Bet
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetGameBets returns all the bets on a game, ordered by bettor
+func (k Keeper) GetGameBets(ctx sdk.Context, gameIndex string) (list []types.Bet) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BetKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, types.BetGameKey(gameIndex))
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Bet
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetBettorBets returns all the pending bets of a bettor, ordered by game
+func (k Keeper) GetBettorBets(ctx sdk.Context, bettor string) (list []types.Bet) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), append(types.KeyPrefix(types.BetByBettorKeyPrefix), types.BetBettorKey(bettor)...))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		bet, found := k.GetBet(ctx, string(iterator.Value()), bettor)
+		if found {
+			list = append(list, bet)
+		}
+	}
+
+	return
+}
@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/satya/checkers/x/checkers/types"
+)
+
+// MustSettleBets empties the prediction pool of a game that ended. The bets on
+// the winner share the pool, and the rounding leftover goes where the platform
+// rake goes. Bets are refunded on a draw or when the game is dropped.
+func (k *Keeper) MustSettleBets(ctx sdk.Context, storedGame *types.StoredGame) {
+	bets := k.GetGameBets(ctx, storedGame.Index)
+	if len(bets) == 0 {
+		return
+	}
+	payouts, leftover := types.GetBetPayouts(bets, storedGame.Winner)
+	for i, bet := range bets {
+		if !payouts[i].IsZero() {
+			bettor, err := sdk.AccAddressFromBech32(bet.Bettor)
+			if err != nil {
+				panic(err.Error())
+			}
+			err = k.payToPlayer(ctx, bettor, payouts[i])
+			if err != nil {
+				panic(fmt.Sprintf(types.ErrCannotSettleBets.Error(), err.Error()))
+			}
+		}
+		k.RemoveBet(ctx, bet.GameIndex, bet.Bettor)
+	}
+	err := k.payRake(ctx, k.RakeDestination(ctx), leftover)
+	if err != nil {
+		panic(fmt.Sprintf(types.ErrCannotSettleBets.Error(), err.Error()))
+	}
+	blackPool, redPool := types.GetBetPools(bets)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.BetsSettledEventType,
+			sdk.NewAttribute(types.BetsSettledEventGameIndex, storedGame.Index),
+			sdk.NewAttribute(types.BetsSettledEventWinner, storedGame.Winner),
+			sdk.NewAttribute(types.BetsSettledEventBlackPool, blackPool.String()),
+			sdk.NewAttribute(types.BetsSettledEventRedPool, redPool.String()),
+			sdk.NewAttribute(types.BetsSettledEventLeftover, leftover.String()),
+		),
+	)
+}
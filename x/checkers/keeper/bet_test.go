@@ -0,0 +1,79 @@
+package keeper_test
+
+import (
+	"strconv"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/satya/checkers/testutil/keeper"
+	"github.com/satya/checkers/testutil/nullify"
+	"github.com/satya/checkers/x/checkers/keeper"
+	"github.com/satya/checkers/x/checkers/types"
+	"github.com/stretchr/testify/require"
+)
+
+// createNBet places a bet by each of alice, bob and carol on each of n games.
+func createNBet(keeper *keeper.Keeper, ctx sdk.Context, n int) []types.Bet {
+	items := make([]types.Bet, 0, 3*n)
+	for i := 1; i <= n; i++ {
+		for _, bettor := range []string{alice, bob, carol} {
+			item := types.NewBet(strconv.Itoa(i), bettor, "b", sdk.NewCoins(sdk.NewInt64Coin("stake", int64(i))))
+			keeper.SetBet(ctx, item)
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+func TestBetGet(t *testing.T) {
+	keeper, ctx := keepertest.CheckersKeeper(t)
+	items := createNBet(keeper, ctx, 10)
+	for _, item := range items {
+		rst, found := keeper.GetBet(ctx,
+			item.GameIndex,
+			item.Bettor,
+		)
+		require.True(t, found)
+		require.Equal(t,
+			nullify.Fill(&item),
+			nullify.Fill(&rst),
+		)
+	}
+}
+
+func TestBetRemove(t *testing.T) {
+	keeper, ctx := keepertest.CheckersKeeper(t)
+	items := createNBet(keeper, ctx, 10)
+	for _, item := range items {
+		keeper.RemoveBet(ctx,
+			item.GameIndex,
+			item.Bettor,
+		)
+		_, found := keeper.GetBet(ctx,
+			item.GameIndex,
+			item.Bettor,
+		)
+		require.False(t, found)
+	}
+	response, err := keeper.BetsByBettor(sdk.WrapSDKContext(ctx), &types.QueryBetsByBettorRequest{Bettor: alice})
+	require.NoError(t, err)
+	require.Empty(t, response.Bets)
+}
+
+func TestBetGetAll(t *testing.T) {
+	keeper, ctx := keepertest.CheckersKeeper(t)
+	items := createNBet(keeper, ctx, 10)
+	require.ElementsMatch(t,
+		nullify.Fill(items),
+		nullify.Fill(keeper.GetAllBet(ctx)),
+	)
+}
+
+func TestBetGetGameBetsDoesNotMixGames(t *testing.T) {
+	keeper, ctx := keepertest.CheckersKeeper(t)
+	items := createNBet(keeper, ctx, 10)
+	require.ElementsMatch(t,
+		nullify.Fill(items[0:3]),
+		nullify.Fill(keeper.GetGameBets(ctx, "1")),
+	)
+}
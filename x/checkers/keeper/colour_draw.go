@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/satya/checkers/x/checkers/types"
+)
+
+// SetColourDraw set a specific colourDraw in the store from its index
+func (k Keeper) SetColourDraw(ctx sdk.Context, colourDraw types.ColourDraw) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ColourDrawKeyPrefix))
+	b := k.cdc.MustMarshal(&colourDraw)
+	store.Set(types.ColourDrawKey(
+		colourDraw.Index,
+	), b)
+}
+
+// GetColourDraw returns a colourDraw from its index
+func (k Keeper) GetColourDraw(
+	ctx sdk.Context,
+	index string,
+
+) (val types.ColourDraw, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ColourDrawKeyPrefix))
+
+	b := store.Get(types.ColourDrawKey(
+		index,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveColourDraw removes a colourDraw from the store
+func (k Keeper) RemoveColourDraw(
+	ctx sdk.Context,
+	index string,
+
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ColourDrawKeyPrefix))
+	store.Delete(types.ColourDrawKey(
+		index,
+	))
+}
+
+// GetAllColourDraw returns all colourDraw
+func (k Keeper) GetAllColourDraw(ctx sdk.Context) (list []types.ColourDraw) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ColourDrawKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.ColourDraw
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/satya/checkers/x/checkers/rules"
+	"github.com/satya/checkers/x/checkers/types"
+)
+
+// startDrawnGame applies a completed colour draw and starts the clock of the
+// first move.
+func (k Keeper) startDrawnGame(ctx sdk.Context, storedGame *types.StoredGame, draw types.ColourDraw) {
+	storedGame.Black, storedGame.Red = draw.First, draw.Second
+	if !draw.FirstIsBlack() {
+		storedGame.Black, storedGame.Red = draw.Second, draw.First
+	}
+	k.RemoveColourDraw(ctx, draw.Index)
+	k.resetDeadline(ctx, storedGame)
+}
+
+// resetDeadline gives a game a fresh deadline and saves it.
+func (k Keeper) resetDeadline(ctx sdk.Context, storedGame *types.StoredGame) {
+	systemInfo, found := k.GetSystemInfo(ctx)
+	if !found {
+		panic("SystemInfo not found")
+	}
+	storedGame.Deadline = types.FormatDeadline(types.GetNextDeadline(ctx))
+	k.SendToFifoTail(ctx, storedGame, &systemInfo)
+	k.FreezeIfOnVacation(ctx, storedGame, &systemInfo)
+	k.SetStoredGame(ctx, *storedGame)
+	k.SetSystemInfo(ctx, systemInfo)
+}
+
+// forfeitColourDraw ends a draw that timed out. A player who failed to commit
+// or reveal while the other did loses by forfeit, playing red. Otherwise the
+// game is dropped and the escrow, if any, refunded. The game is already out of
+// the FIFO.
+func (k Keeper) forfeitColourDraw(ctx sdk.Context, storedGame *types.StoredGame, draw types.ColourDraw) {
+	k.RemoveColourDraw(ctx, draw.Index)
+	defaulter, found := draw.GetDefaulter()
+	if !found {
+		k.RemoveStoredGame(ctx, storedGame.Index)
+		k.MustRefundWager(ctx, storedGame)
+		k.MustSettleBets(ctx, storedGame)
+		return
+	}
+	storedGame.Black, storedGame.Red = draw.First, draw.Second
+	if defaulter == draw.First {
+		storedGame.Black, storedGame.Red = draw.Second, draw.First
+	}
+	storedGame.Winner = rules.PieceStrings[rules.BLACK_PLAYER]
+	k.MustPayWinnings(ctx, storedGame)
+	k.MustSettleBets(ctx, storedGame)
+	k.MustRegisterPlayerForfeit(ctx, storedGame)
+	storedGame.Board = ""
+	k.SetStoredGame(ctx, *storedGame)
+}
@@ -6,4 +6,5 @@ const (
 	alice = testutil.Alice
 	bob   = testutil.Bob
 	carol = testutil.Carol
+	dave  = testutil.Dave
 )
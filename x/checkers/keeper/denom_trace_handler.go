@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	"github.com/satya/checkers/x/checkers/types"
+)
+
+// GetDenomTrace returns where a denom comes from. An IBC voucher is resolved
+// through the denom traces of the transfer module, while a native denom is its
+// own base denom, with an empty path.
+func (k Keeper) GetDenomTrace(ctx sdk.Context, denom string) (trace transfertypes.DenomTrace, found bool) {
+	if !strings.HasPrefix(denom, types.IbcDenomPrefix) {
+		return transfertypes.DenomTrace{BaseDenom: denom}, true
+	}
+	hash, err := transfertypes.ParseHexHash(strings.TrimPrefix(denom, types.IbcDenomPrefix))
+	if err != nil {
+		return trace, false
+	}
+	return k.transfer.GetDenomTrace(ctx, hash)
+}
+
+// ValidateWagerDenoms checks that the params allow every denom of the wager.
+// Vouchers are checked by their base denom and the channel they came in
+// through, as the same base denom from another chain is another token.
+func (k Keeper) ValidateWagerDenoms(ctx sdk.Context, wager sdk.Coins) error {
+	params := k.GetParams(ctx)
+	for _, coin := range wager {
+		trace, found := k.GetDenomTrace(ctx, coin.Denom)
+		if !found {
+			return sdkerrors.Wrapf(types.ErrUnknownVoucher, "%s", coin.Denom)
+		}
+		if !params.IsAllowedTrace(trace) {
+			return sdkerrors.Wrapf(types.ErrDenomNotAllowed, "%s", trace.GetFullDenomPath())
+		}
+	}
+	return nil
+}
+
+// GetTracedCoins pairs each coin with its denom trace. A voucher without a
+// trace is shown as is.
+func (k Keeper) GetTracedCoins(ctx sdk.Context, coins sdk.Coins) []types.TracedCoin {
+	traced := make([]types.TracedCoin, 0, len(coins))
+	for _, coin := range coins {
+		trace, found := k.GetDenomTrace(ctx, coin.Denom)
+		if !found {
+			trace = transfertypes.DenomTrace{BaseDenom: coin.Denom}
+		}
+		traced = append(traced, types.TracedCoin{
+			Denom:     coin.Denom,
+			Amount:    coin.Amount,
+			BaseDenom: trace.BaseDenom,
+			Path:      trace.Path,
+		})
+	}
+	return traced
+}
+
+// FormatTracedCoins writes coins like sdk.Coins does, but with vouchers shown
+// by their full denom path, as in 100transfer/channel-0/uatom,5stake.
+func FormatTracedCoins(traced []types.TracedCoin) string {
+	parts := make([]string, 0, len(traced))
+	for _, coin := range traced {
+		trace := transfertypes.DenomTrace{BaseDenom: coin.BaseDenom, Path: coin.Path}
+		parts = append(parts, coin.Amount.String()+trace.GetFullDenomPath())
+	}
+	return strings.Join(parts, ",")
+}
@@ -0,0 +1,103 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	"github.com/golang/mock/gomock"
+	keepertest "github.com/satya/checkers/testutil/keeper"
+	"github.com/satya/checkers/x/checkers"
+	"github.com/satya/checkers/x/checkers/keeper"
+	"github.com/satya/checkers/x/checkers/testutil"
+	"github.com/satya/checkers/x/checkers/types"
+	"github.com/stretchr/testify/require"
+)
+
+var atomTrace = transfertypes.DenomTrace{Path: "transfer/channel-0", BaseDenom: "uatom"}
+
+func setupMsgServerWithVouchers(t testing.TB) (types.MsgServer, keeper.Keeper, context.Context,
+	*gomock.Controller, *testutil.MockDenomTraceKeeper) {
+	ctrl := gomock.NewController(t)
+	traceMock := testutil.NewMockDenomTraceKeeper(ctrl)
+	k, ctx := keepertest.CheckersKeeperWithTraceMock(t, nil, nil, traceMock)
+	genesis := types.DefaultGenesis()
+	genesis.Params.AllowedVouchers = []types.AllowedVoucher{{BaseDenom: "uatom", Channel: "channel-0"}}
+	checkers.InitGenesis(ctx, *k, *genesis)
+	return keeper.NewMsgServerImpl(*k), *k, sdk.WrapSDKContext(ctx), ctrl, traceMock
+}
+
+func createGameWithWager(msgServer types.MsgServer, context context.Context, wager sdk.Coins) error {
+	_, err := msgServer.CreateGame(context, &types.MsgCreateGame{
+		Creator: alice,
+		Black:   bob,
+		Red:     carol,
+		Wager:   wager,
+	})
+	return err
+}
+
+func TestCreateGameWithAllowedVoucher(t *testing.T) {
+	msgServer, keeper, context, ctrl, traceMock := setupMsgServerWithVouchers(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	traceMock.EXPECT().GetDenomTrace(ctx, atomTrace.Hash()).Return(atomTrace, true).AnyTimes()
+	wager := sdk.NewCoins(sdk.NewInt64Coin(atomTrace.IBCDenom(), 100), sdk.NewInt64Coin("stake", 5))
+
+	require.Nil(t, createGameWithWager(msgServer, context, wager))
+	game, found := keeper.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	require.EqualValues(t, wager, game.Wager)
+	event := findEvent(t, ctx, types.GameCreatedEventType)
+	require.EqualValues(t, sdk.Attribute{Key: "wager-traced", Value: "100transfer/channel-0/uatom,5stake"}, event.Attributes[5])
+
+	response, err := keeper.GameWager(context, &types.QueryGameWagerRequest{GameIndex: "1"})
+	require.Nil(t, err)
+	require.EqualValues(t, []types.TracedCoin{
+		{Denom: atomTrace.IBCDenom(), Amount: sdk.NewInt(100), BaseDenom: "uatom", Path: "transfer/channel-0"},
+		{Denom: "stake", Amount: sdk.NewInt(5), BaseDenom: "stake"},
+	}, response.Wager)
+	require.Empty(t, response.Escrowed)
+}
+
+func TestCreateGameVoucherFromOtherChannel(t *testing.T) {
+	msgServer, keeper, context, ctrl, traceMock := setupMsgServerWithVouchers(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	otherTrace := transfertypes.DenomTrace{Path: "transfer/channel-1", BaseDenom: "uatom"}
+	traceMock.EXPECT().GetDenomTrace(ctx, otherTrace.Hash()).Return(otherTrace, true)
+
+	err := createGameWithWager(msgServer, context, sdk.NewCoins(sdk.NewInt64Coin(otherTrace.IBCDenom(), 100)))
+	require.EqualError(t, err, "transfer/channel-1/uatom: denom is not allowed for wagers: %s")
+	_, found := keeper.GetStoredGame(ctx, "1")
+	require.False(t, found)
+}
+
+func TestCreateGameVoucherThroughSeveralHops(t *testing.T) {
+	msgServer, _, context, ctrl, traceMock := setupMsgServerWithVouchers(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	hopTrace := transfertypes.DenomTrace{Path: "transfer/channel-0/transfer/channel-7", BaseDenom: "uatom"}
+	traceMock.EXPECT().GetDenomTrace(ctx, hopTrace.Hash()).Return(hopTrace, true)
+
+	err := createGameWithWager(msgServer, context, sdk.NewCoins(sdk.NewInt64Coin(hopTrace.IBCDenom(), 100)))
+	require.ErrorIs(t, err, types.ErrDenomNotAllowed)
+}
+
+func TestCreateGameUnknownVoucher(t *testing.T) {
+	msgServer, _, context, ctrl, traceMock := setupMsgServerWithVouchers(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	traceMock.EXPECT().GetDenomTrace(ctx, atomTrace.Hash()).Return(transfertypes.DenomTrace{}, false)
+
+	err := createGameWithWager(msgServer, context, sdk.NewCoins(sdk.NewInt64Coin(atomTrace.IBCDenom(), 100)))
+	require.EqualError(t, err, atomTrace.IBCDenom()+": voucher has no denom trace: %s")
+}
+
+func TestGameWagerNotFound(t *testing.T) {
+	_, keeper, context, ctrl, _ := setupMsgServerWithVouchers(t)
+	defer ctrl.Finish()
+	_, err := keeper.GameWager(context, &types.QueryGameWagerRequest{GameIndex: "1"})
+	require.ErrorIs(t, err, types.ErrGameNotFound)
+}
@@ -39,20 +39,27 @@ func (k Keeper) ForfeitExpiredGames(goCtx context.Context) {
 		if deadline.Before(ctx.BlockTime()) {
 			k.RemoveFromFifo(ctx, &storedGame, &systemInfo)
 			lastboard := storedGame.Board
-			if storedGame.MoveCount <= 1 {
+			if draw, found := k.GetColourDraw(ctx, gameIndex); found {
+				k.forfeitColourDraw(ctx, &storedGame, draw)
+			} else if !storedGame.HasSecondMoverPlayed() {
 				k.RemoveStoredGame(ctx, gameIndex)
-				if storedGame.MoveCount == 1 {
-					k.MustRefundWager(ctx, &storedGame)
-				}
+				k.MustRefundWager(ctx, &storedGame)
+				k.MustSettleBets(ctx, &storedGame)
+				k.MustAdvanceMatch(ctx, &storedGame, &systemInfo)
 			} else {
 				storedGame.Winner, found = opponents[storedGame.Turn]
 				if !found {
 					panic(fmt.Sprintf(types.ErrCannotFindWinnerByColor.Error(), storedGame.Turn))
 				}
 				k.MustPayWinnings(ctx, &storedGame)
+				k.MustSettleBets(ctx, &storedGame)
 				k.MustRegisterPlayerForfeit(ctx, &storedGame)
 				storedGame.Board = ""
 				k.SetStoredGame(ctx, storedGame)
+				k.MustAdvanceMatch(ctx, &storedGame, &systemInfo)
+			}
+			if premove, found := k.GetPremove(ctx, gameIndex); found {
+				k.ClearPremove(ctx, &storedGame, premove)
 			}
 			ctx.EventManager().EmitEvent(
 				sdk.NewEvent(types.GameForfeitedEventType,
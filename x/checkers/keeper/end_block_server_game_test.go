@@ -28,10 +28,11 @@ func TestForfeitUnplayed(t *testing.T) {
 		NextId:        2,
 		FifoHeadIndex: "-1",
 		FifoTailIndex: "-1",
+		NextMatchId:   1,
 	}, systemInfo)
 	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
-	require.Len(t, events, 2)
-	event := events[0]
+	require.Len(t, events, 3)
+	event := events[1]
 	require.EqualValues(t, sdk.StringEvent{
 		Type: "game-forfeited",
 		Attributes: []sdk.Attribute{
@@ -69,10 +70,11 @@ func TestForfeitPlayedOnce(t *testing.T) {
 		NextId:        2,
 		FifoHeadIndex: "-1",
 		FifoTailIndex: "-1",
+		NextMatchId:   1,
 	}, systemInfo)
 	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
-	require.Len(t, events, 3)
-	event := events[0]
+	require.Len(t, events, 4)
+	event := events[1]
 	require.EqualValues(t, sdk.StringEvent{
 		Type: "game-forfeited",
 		Attributes: []sdk.Attribute{
@@ -87,9 +89,7 @@ func TestForfeitPlayedTwice(t *testing.T) {
 	msgServer, keeper, context, ctrl, escrow, board := setupMsgServerWithOneGameForPlayMoveWithMock(t)
 	ctx := sdk.UnwrapSDKContext(context)
 	defer ctrl.Finish()
-	payBob := escrow.ExpectPay(context, bob, 45).Times(1)
-	payCarol := escrow.ExpectPay(context, carol, 45).Times(1).After(payBob)
-	escrow.ExpectRefund(context, carol, 90).Times(1).After(payCarol)
+	escrow.ExpectRefund(context, carol, 90).Times(1)
 	board.Expectwin(context, carol).Times(1)
 	board.ExpectForfeit(context, bob).Times(1)
 	msgServer.PlayMove(context, &types.MsgPlayMove{
@@ -128,8 +128,9 @@ func TestForfeitPlayedTwice(t *testing.T) {
 		AfterIndex:  "-1",
 		Deadline:    oldDeadline,
 		Winner:      "r",
-		Wager:       45,
-		Denom:       "stake",
+		Wager:       sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+		MoveHistory: []string{"9-14", "21-17"},
+		Organizer:   alice,
 	}, game1)
 
 	systemInfo, found := keeper.GetSystemInfo(ctx)
@@ -138,10 +139,11 @@ func TestForfeitPlayedTwice(t *testing.T) {
 		NextId:        2,
 		FifoHeadIndex: "-1",
 		FifoTailIndex: "-1",
+		NextMatchId:   1,
 	}, systemInfo)
 	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
-	require.Len(t, events, 3)
-	event := events[0]
+	require.Len(t, events, 5)
+	event := events[1]
 	require.EqualValues(t, sdk.StringEvent{
 		Type: "game-forfeited",
 		Attributes: []sdk.Attribute{
@@ -150,6 +152,18 @@ func TestForfeitPlayedTwice(t *testing.T) {
 			{Key: "board", Value: "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|*r******|**r*r*r*|*r*r*r*r|r*r*r*r*"},
 		},
 	}, event)
+	require.EqualValues(t, sdk.StringEvent{
+		Type: "winnings-paid",
+		Attributes: []sdk.Attribute{
+			{Key: "game-index", Value: "1"},
+			{Key: "winner", Value: carol},
+			{Key: "winnings", Value: "90stake"},
+			{Key: "prize", Value: ""},
+			{Key: "rake", Value: ""},
+			{Key: "organizer", Value: alice},
+			{Key: "organizer-fee", Value: ""},
+		},
+	}, events[4])
 }
 
 func TestForfeit2OldestPlayedOnceIn1Call(t *testing.T) {
@@ -168,9 +182,9 @@ func TestForfeit2OldestPlayedOnceIn1Call(t *testing.T) {
 		Creator: bob,
 		Black:   carol,
 		Red:     alice,
-		Wager:   46,
-		Denom:   "coin",
+		Wager:   sdk.NewCoins(sdk.NewInt64Coin("stake", 46)),
 	})
+	acceptGame(t, msgServer, context, "2", carol, alice)
 	msgServer.PlayMove(context, &types.MsgPlayMove{
 		Creator:   carol,
 		GameIndex: "2",
@@ -183,8 +197,7 @@ func TestForfeit2OldestPlayedOnceIn1Call(t *testing.T) {
 		Creator: carol,
 		Black:   alice,
 		Red:     bob,
-		Wager:   47,
-		Denom:   "gold",
+		Wager:   sdk.NewCoins(sdk.NewInt64Coin("stake", 47)),
 	})
 	game1, found := keeper.GetStoredGame(ctx, "1")
 	require.True(t, found)
@@ -207,10 +220,11 @@ func TestForfeit2OldestPlayedOnceIn1Call(t *testing.T) {
 		NextId:        4,
 		FifoHeadIndex: "3",
 		FifoTailIndex: "3",
+		NextMatchId:   1,
 	}, systemInfo)
 	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
-	require.Len(t, events, 3)
-	event := events[0]
+	require.Len(t, events, 4)
+	event := events[1]
 	require.EqualValues(t,
 		sdk.StringEvent{
 			Type: "game-forfeited",
@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/satya/checkers/x/checkers/types"
+)
+
+// GetPlayerGameEscrow returns what address has locked in a game, as a player,
+// the sponsor, a bettor or through a premove deposit.
+func (k Keeper) GetPlayerGameEscrow(ctx sdk.Context, gameIndex string, address string) types.PlayerGameEscrow {
+	escrow := types.PlayerGameEscrow{
+		GameIndex:      gameIndex,
+		Wager:          sdk.NewCoins(),
+		Prize:          sdk.NewCoins(),
+		Bet:            sdk.NewCoins(),
+		PremoveDeposit: sdk.NewCoins(),
+	}
+	if storedGame, found := k.GetStoredGame(ctx, gameIndex); found {
+		if (storedGame.Black == address || storedGame.Red == address) && !storedGame.GetContribution().IsZero() {
+			escrow.Wager = storedGame.GetContribution()
+			if storedGame.Black == storedGame.Red {
+				escrow.Wager = escrow.Wager.Add(escrow.Wager...)
+			}
+		}
+		if storedGame.Sponsor == address && !storedGame.Prize.IsZero() {
+			escrow.Prize = storedGame.Prize
+		}
+	}
+	if bet, found := k.GetBet(ctx, gameIndex, address); found {
+		escrow.Bet = bet.Amount
+	}
+	if premove, found := k.GetPremove(ctx, gameIndex); found && premove.Player == address && premove.Deposit > 0 {
+		escrow.PremoveDeposit = types.PremoveCoins(premove.Deposit)
+	}
+	return escrow
+}
+
+// GetPlayerMatchEscrow returns what address has locked in a match as a player.
+func (k Keeper) GetPlayerMatchEscrow(ctx sdk.Context, matchIndex string, address string) (escrow types.PlayerMatchEscrow, found bool) {
+	match, found := k.GetMatch(ctx, matchIndex)
+	if !found || !match.IsPlayer(address) || match.Escrowed.IsZero() {
+		return escrow, false
+	}
+	return types.PlayerMatchEscrow{
+		MatchIndex: match.Index,
+		Wager:      match.Wager,
+	}, true
+}
+
+// indexGameEscrows keeps the index of escrows by player up to date for each
+// address that takes part in the game. It is called after any change to the
+// game, a bet or a premove, with the addresses from before and after.
+func (k Keeper) indexGameEscrows(ctx sdk.Context, gameIndex string, addresses ...string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.EscrowByPlayerKeyPrefix))
+	for _, address := range addresses {
+		if address == "" {
+			continue
+		}
+		key := types.EscrowByPlayerKey(address, types.EscrowInGame, gameIndex)
+		if k.GetPlayerGameEscrow(ctx, gameIndex, address).GetTotal().IsZero() {
+			store.Delete(key)
+		} else {
+			store.Set(key, []byte(gameIndex))
+		}
+	}
+}
+
+// indexMatchEscrows is the same as indexGameEscrows for a match.
+func (k Keeper) indexMatchEscrows(ctx sdk.Context, matchIndex string, addresses ...string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.EscrowByPlayerKeyPrefix))
+	for _, address := range addresses {
+		if address == "" {
+			continue
+		}
+		key := types.EscrowByPlayerKey(address, types.EscrowInMatch, matchIndex)
+		if _, found := k.GetPlayerMatchEscrow(ctx, matchIndex, address); found {
+			store.Set(key, []byte(matchIndex))
+		} else {
+			store.Delete(key)
+		}
+	}
+}
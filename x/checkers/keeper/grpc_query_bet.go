@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/satya/checkers/x/checkers/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) BetsByGame(c context.Context, req *types.QueryBetsByGameRequest) (*types.QueryBetsByGameResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var bets []types.Bet
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	gameBetStore := prefix.NewStore(store, append(types.KeyPrefix(types.BetKeyPrefix), types.BetGameKey(req.GameIndex)...))
+
+	pageRes, err := query.Paginate(gameBetStore, req.Pagination, func(key []byte, value []byte) error {
+		var bet types.Bet
+		if err := k.cdc.Unmarshal(value, &bet); err != nil {
+			return err
+		}
+
+		bets = append(bets, bet)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	blackPool, redPool := types.GetBetPools(k.GetGameBets(ctx, req.GameIndex))
+	return &types.QueryBetsByGameResponse{
+		Bets:       bets,
+		BlackPool:  blackPool,
+		RedPool:    redPool,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (k Keeper) BetsByBettor(c context.Context, req *types.QueryBetsByBettorRequest) (*types.QueryBetsByBettorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var bets []types.Bet
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	bettorStore := prefix.NewStore(store, append(types.KeyPrefix(types.BetByBettorKeyPrefix), types.BetBettorKey(req.Bettor)...))
+
+	pageRes, err := query.Paginate(bettorStore, req.Pagination, func(key []byte, value []byte) error {
+		bet, found := k.GetBet(ctx, string(value), req.Bettor)
+		if !found {
+			return status.Errorf(codes.Internal, "bet of %s on game %s not found", req.Bettor, value)
+		}
+
+		bets = append(bets, bet)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryBetsByBettorResponse{Bets: bets, Pagination: pageRes}, nil
+}
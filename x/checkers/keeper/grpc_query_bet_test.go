@@ -0,0 +1,59 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "github.com/satya/checkers/testutil/keeper"
+	"github.com/satya/checkers/testutil/nullify"
+	"github.com/satya/checkers/x/checkers/types"
+)
+
+func TestBetsByGameQuery(t *testing.T) {
+	keeper, ctx := keepertest.CheckersKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	items := createNBet(keeper, ctx, 3)
+	daveBet := types.NewBet("2", dave, "r", sdk.NewCoins(sdk.NewInt64Coin("stake", 7)))
+	keeper.SetBet(ctx, daveBet)
+
+	response, err := keeper.BetsByGame(wctx, &types.QueryBetsByGameRequest{
+		GameIndex:  "2",
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, response.Bets, 2)
+	require.Subset(t,
+		nullify.Fill([]types.Bet{items[3], items[4], items[5], daveBet}),
+		nullify.Fill(response.Bets),
+	)
+	require.Equal(t, uint64(4), response.Pagination.Total)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 6)), response.BlackPool)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 7)), response.RedPool)
+}
+
+func TestBetsByBettorQuery(t *testing.T) {
+	keeper, ctx := keepertest.CheckersKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	items := createNBet(keeper, ctx, 3)
+
+	response, err := keeper.BetsByBettor(wctx, &types.QueryBetsByBettorRequest{Bettor: bob})
+	require.NoError(t, err)
+	require.Equal(t,
+		nullify.Fill([]types.Bet{items[1], items[4], items[7]}),
+		nullify.Fill(response.Bets),
+	)
+}
+
+func TestBetQueriesInvalidRequest(t *testing.T) {
+	keeper, ctx := keepertest.CheckersKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	_, err := keeper.BetsByGame(wctx, nil)
+	require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+	_, err = keeper.BetsByBettor(wctx, nil)
+	require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+}
@@ -31,6 +31,27 @@ func (k Keeper) CanPlayMove(goCtx context.Context, req *types.QueryCanPlayMoveRe
 		}, nil
 	}
 
+	if _, found := k.GetColourDraw(ctx, req.GameIndex); found {
+		return &types.QueryCanPlayMoveResponse{
+			Possible: false,
+			Reason:   types.ErrColourDrawPending.Error(),
+		}, nil
+	}
+
+	if !storedGame.IsFunded() {
+		return &types.QueryCanPlayMoveResponse{
+			Possible: false,
+			Reason:   types.ErrGameNotAccepted.Error(),
+		}, nil
+	}
+
+	if storedGame.IsAdjourned() {
+		return &types.QueryCanPlayMoveResponse{
+			Possible: false,
+			Reason:   types.ErrGameAdjourned.Error(),
+		}, nil
+	}
+
 	isBlack := rules.PieceStrings[rules.BLACK_PLAYER] == req.Player
 	isRed := rules.PieceStrings[rules.RED_PLAYER] == req.Player
 
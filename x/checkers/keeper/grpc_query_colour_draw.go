@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/satya/checkers/x/checkers/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) ColourDrawAll(c context.Context, req *types.QueryAllColourDrawRequest) (*types.QueryAllColourDrawResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var colourDraws []types.ColourDraw
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	colourDrawStore := prefix.NewStore(store, types.KeyPrefix(types.ColourDrawKeyPrefix))
+
+	pageRes, err := query.Paginate(colourDrawStore, req.Pagination, func(key []byte, value []byte) error {
+		var colourDraw types.ColourDraw
+		if err := k.cdc.Unmarshal(value, &colourDraw); err != nil {
+			return err
+		}
+
+		colourDraws = append(colourDraws, colourDraw)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllColourDrawResponse{ColourDraw: colourDraws, Pagination: pageRes}, nil
+}
+
+func (k Keeper) ColourDraw(c context.Context, req *types.QueryGetColourDrawRequest) (*types.QueryGetColourDrawResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	val, found := k.GetColourDraw(
+		ctx,
+		req.Index,
+	)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetColourDrawResponse{ColourDraw: val}, nil
+}
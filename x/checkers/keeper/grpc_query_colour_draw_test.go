@@ -0,0 +1,126 @@
+package keeper_test
+
+import (
+	"strconv"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "github.com/satya/checkers/testutil/keeper"
+	"github.com/satya/checkers/testutil/nullify"
+	"github.com/satya/checkers/x/checkers/types"
+)
+
+// Prevent strconv unused error
+var _ = strconv.IntSize
+
+func TestColourDrawQuerySingle(t *testing.T) {
+	keeper, ctx := keepertest.CheckersKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	msgs := createNColourDraw(keeper, ctx, 2)
+	for _, tc := range []struct {
+		desc     string
+		request  *types.QueryGetColourDrawRequest
+		response *types.QueryGetColourDrawResponse
+		err      error
+	}{
+		{
+			desc: "First",
+			request: &types.QueryGetColourDrawRequest{
+				Index: msgs[0].Index,
+			},
+			response: &types.QueryGetColourDrawResponse{ColourDraw: msgs[0]},
+		},
+		{
+			desc: "Second",
+			request: &types.QueryGetColourDrawRequest{
+				Index: msgs[1].Index,
+			},
+			response: &types.QueryGetColourDrawResponse{ColourDraw: msgs[1]},
+		},
+		{
+			desc: "KeyNotFound",
+			request: &types.QueryGetColourDrawRequest{
+				Index: strconv.Itoa(100000),
+			},
+			err: status.Error(codes.NotFound, "not found"),
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := keeper.ColourDraw(wctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t,
+					nullify.Fill(tc.response),
+					nullify.Fill(response),
+				)
+			}
+		})
+	}
+}
+
+func TestColourDrawQueryPaginated(t *testing.T) {
+	keeper, ctx := keepertest.CheckersKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	msgs := createNColourDraw(keeper, ctx, 5)
+
+	request := func(next []byte, offset, limit uint64, total bool) *types.QueryAllColourDrawRequest {
+		return &types.QueryAllColourDrawRequest{
+			Pagination: &query.PageRequest{
+				Key:        next,
+				Offset:     offset,
+				Limit:      limit,
+				CountTotal: total,
+			},
+		}
+	}
+	t.Run("ByOffset", func(t *testing.T) {
+		step := 2
+		for i := 0; i < len(msgs); i += step {
+			resp, err := keeper.ColourDrawAll(wctx, request(nil, uint64(i), uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.ColourDraw), step)
+			require.Subset(t,
+				nullify.Fill(msgs),
+				nullify.Fill(resp.ColourDraw),
+			)
+		}
+	})
+	t.Run("ByKey", func(t *testing.T) {
+		step := 2
+		var next []byte
+		for i := 0; i < len(msgs); i += step {
+			resp, err := keeper.ColourDrawAll(wctx, request(next, 0, uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.ColourDraw), step)
+			require.Subset(t,
+				nullify.Fill(msgs),
+				nullify.Fill(resp.ColourDraw),
+			)
+			next = resp.Pagination.NextKey
+		}
+	})
+	t.Run("Total", func(t *testing.T) {
+		resp, err := keeper.ColourDrawAll(wctx, request(nil, 0, 0, true))
+		require.NoError(t, err)
+		require.Equal(t, len(msgs), int(resp.Pagination.Total))
+		require.ElementsMatch(t,
+			nullify.Fill(msgs),
+			nullify.Fill(resp.ColourDraw),
+		)
+	})
+	t.Run("InvalidRequest", func(t *testing.T) {
+		_, err := keeper.ColourDrawAll(wctx, nil)
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+	})
+}
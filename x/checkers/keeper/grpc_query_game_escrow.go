@@ -0,0 +1,87 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/satya/checkers/x/checkers/rules"
+	"github.com/satya/checkers/x/checkers/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) GameEscrow(goCtx context.Context, req *types.QueryGameEscrowRequest) (*types.QueryGameEscrowResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	storedGame, found := k.GetStoredGame(ctx, req.GameIndex)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrGameNotFound, "%s", req.GameIndex)
+	}
+	params := k.GetParams(ctx)
+	forfeitWinner := rules.PieceStrings[rules.BLACK_PLAYER]
+	if storedGame.Turn == rules.PieceStrings[rules.BLACK_PLAYER] {
+		forfeitWinner = rules.PieceStrings[rules.RED_PLAYER]
+	}
+
+	return &types.QueryGameEscrowResponse{
+		BlackContribution: storedGame.GetContribution(),
+		RedContribution:   storedGame.GetContribution(),
+		Prize:             sdk.NewCoins(storedGame.Prize...),
+		BlackWins:         storedGame.GetWinOutcome(rules.PieceStrings[rules.BLACK_PLAYER], params),
+		RedWins:           storedGame.GetWinOutcome(rules.PieceStrings[rules.RED_PLAYER], params),
+		Draw:              storedGame.GetRefundOutcome(true),
+		Forfeit:           storedGame.GetWinOutcome(forfeitWinner, params),
+		Expiry:            storedGame.GetRefundOutcome(false),
+		ExpiresOnDeadline: !storedGame.HasSecondMoverPlayed(),
+	}, nil
+}
+
+func (k Keeper) EscrowByPlayer(goCtx context.Context, req *types.QueryEscrowByPlayerRequest) (*types.QueryEscrowByPlayerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var games []types.PlayerGameEscrow
+	var matches []types.PlayerMatchEscrow
+	total := sdk.NewCoins()
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	store := ctx.KVStore(k.storeKey)
+	playerStore := prefix.NewStore(store, append(types.KeyPrefix(types.EscrowByPlayerKeyPrefix), types.EscrowPlayerKey(req.Player)...))
+
+	pageRes, err := query.Paginate(playerStore, req.Pagination, func(key []byte, value []byte) error {
+		index := string(value)
+		if strings.HasPrefix(string(key), types.EscrowInMatch+"/") {
+			match, found := k.GetPlayerMatchEscrow(ctx, index, req.Player)
+			if !found {
+				return status.Errorf(codes.Internal, "escrow of %s in match %s not found", req.Player, index)
+			}
+			matches = append(matches, match)
+			total = total.Add(match.Wager...)
+			return nil
+		}
+		game := k.GetPlayerGameEscrow(ctx, index, req.Player)
+		games = append(games, game)
+		total = total.Add(game.GetTotal()...)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryEscrowByPlayerResponse{
+		Games:      games,
+		Matches:    matches,
+		Total:      total,
+		Pagination: pageRes,
+	}, nil
+}
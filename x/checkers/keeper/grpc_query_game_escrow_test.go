@@ -0,0 +1,238 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	keepertest "github.com/satya/checkers/testutil/keeper"
+	"github.com/satya/checkers/x/checkers/types"
+	"github.com/stretchr/testify/require"
+)
+
+func stakeCoins(amount int64) sdk.Coins {
+	return sdk.NewCoins(sdk.NewInt64Coin("stake", amount))
+}
+
+func TestGameEscrowNotFound(t *testing.T) {
+	keeper, ctx := keepertest.CheckersKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	response, err := keeper.GameEscrow(wctx, &types.QueryGameEscrowRequest{GameIndex: "1"})
+	require.Nil(t, response)
+	require.EqualError(t, err, "1: game by id not found")
+}
+
+func TestGameEscrowRunningGame(t *testing.T) {
+	keeper, ctx := keepertest.CheckersKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	setFees(*keeper, ctx, 250, types.RakeToFeeCollector, 100)
+	keeper.SetStoredGame(ctx, types.StoredGame{
+		Index:     "1",
+		Black:     alice,
+		Red:       bob,
+		Turn:      "r",
+		MoveCount: 3,
+		Organizer: carol,
+		Sponsor:   carol,
+		Wager:     stakeCoins(500),
+		Escrowed:  stakeCoins(1_000),
+		Prize:     stakeCoins(7),
+	})
+	response, err := keeper.GameEscrow(wctx, &types.QueryGameEscrowRequest{GameIndex: "1"})
+	require.Nil(t, err)
+	none := sdk.NewCoins()
+	require.EqualValues(t, types.QueryGameEscrowResponse{
+		BlackContribution: stakeCoins(500),
+		RedContribution:   stakeCoins(500),
+		Prize:             stakeCoins(7),
+		BlackWins: types.EscrowOutcome{
+			Black:        stakeCoins(972),
+			Red:          none,
+			Sponsor:      none,
+			Rake:         stakeCoins(25),
+			OrganizerFee: stakeCoins(10),
+		},
+		RedWins: types.EscrowOutcome{
+			Black:        none,
+			Red:          stakeCoins(972),
+			Sponsor:      none,
+			Rake:         stakeCoins(25),
+			OrganizerFee: stakeCoins(10),
+		},
+		Draw: types.EscrowOutcome{
+			Black:        stakeCoins(503),
+			Red:          stakeCoins(503),
+			Sponsor:      stakeCoins(1),
+			Rake:         none,
+			OrganizerFee: none,
+		},
+		Forfeit: types.EscrowOutcome{
+			Black:        stakeCoins(972),
+			Red:          none,
+			Sponsor:      none,
+			Rake:         stakeCoins(25),
+			OrganizerFee: stakeCoins(10),
+		},
+		Expiry: types.EscrowOutcome{
+			Black:        stakeCoins(500),
+			Red:          stakeCoins(500),
+			Sponsor:      stakeCoins(7),
+			Rake:         none,
+			OrganizerFee: none,
+		},
+		ExpiresOnDeadline: false,
+	}, *response)
+}
+
+func TestGameEscrowNotAcceptedYet(t *testing.T) {
+	keeper, ctx := keepertest.CheckersKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	keeper.SetStoredGame(ctx, types.StoredGame{
+		Index: "1",
+		Black: alice,
+		Red:   bob,
+		Turn:  "b",
+		Wager: stakeCoins(500),
+	})
+	response, err := keeper.GameEscrow(wctx, &types.QueryGameEscrowRequest{GameIndex: "1"})
+	require.Nil(t, err)
+	require.True(t, response.BlackContribution.IsZero())
+	require.True(t, response.RedContribution.IsZero())
+	require.True(t, response.BlackWins.Black.IsZero())
+	require.True(t, response.Expiry.Black.IsZero())
+	require.True(t, response.ExpiresOnDeadline)
+}
+
+func TestEscrowByPlayer(t *testing.T) {
+	keeper, ctx := keepertest.CheckersKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	keeper.SetStoredGame(ctx, types.StoredGame{
+		Index:    "1",
+		Black:    alice,
+		Red:      bob,
+		Wager:    stakeCoins(45),
+		Escrowed: stakeCoins(90),
+	})
+	keeper.SetStoredGame(ctx, types.StoredGame{
+		Index:   "2",
+		Black:   bob,
+		Red:     carol,
+		Sponsor: alice,
+		Wager:   stakeCoins(30),
+		Prize:   stakeCoins(7),
+	})
+	keeper.SetStoredGame(ctx, types.StoredGame{
+		Index:  "3",
+		Black:  alice,
+		Red:    carol,
+		Winner: "b",
+		Wager:  stakeCoins(20),
+	})
+	keeper.SetBet(ctx, types.NewBet("2", alice, "r", stakeCoins(11)))
+	keeper.SetBet(ctx, types.NewBet("1", carol, "b", stakeCoins(13)))
+	keeper.SetPremove(ctx, types.Premove{Index: "1", Player: alice, Deposit: 3})
+	response, err := keeper.EscrowByPlayer(wctx, &types.QueryEscrowByPlayerRequest{Player: alice})
+	require.Nil(t, err)
+	none := sdk.NewCoins()
+	require.EqualValues(t, types.QueryEscrowByPlayerResponse{
+		Games: []types.PlayerGameEscrow{
+			{
+				GameIndex:      "1",
+				Wager:          stakeCoins(45),
+				Prize:          none,
+				Bet:            none,
+				PremoveDeposit: stakeCoins(3),
+			},
+			{
+				GameIndex:      "2",
+				Wager:          none,
+				Prize:          stakeCoins(7),
+				Bet:            stakeCoins(11),
+				PremoveDeposit: none,
+			},
+		},
+		Total:      stakeCoins(66),
+		Pagination: &query.PageResponse{Total: 2},
+	}, *response)
+}
+
+func TestEscrowByPlayerPaginated(t *testing.T) {
+	keeper, ctx := keepertest.CheckersKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	keeper.SetStoredGame(ctx, types.StoredGame{
+		Index:    "1",
+		Black:    alice,
+		Red:      bob,
+		Wager:    stakeCoins(45),
+		Escrowed: stakeCoins(90),
+	})
+	keeper.SetBet(ctx, types.NewBet("2", alice, "r", stakeCoins(11)))
+	keeper.SetMatch(ctx, types.Match{
+		Index:    "1",
+		PlayerA:  carol,
+		PlayerB:  alice,
+		Wager:    stakeCoins(20),
+		Escrowed: stakeCoins(40),
+	})
+
+	response, err := keeper.EscrowByPlayer(wctx, &types.QueryEscrowByPlayerRequest{
+		Player:     alice,
+		Pagination: &query.PageRequest{Limit: 2},
+	})
+	require.Nil(t, err)
+	require.Len(t, response.Games, 2)
+	require.Empty(t, response.Matches)
+	require.EqualValues(t, stakeCoins(56), response.Total)
+	require.NotNil(t, response.Pagination.NextKey)
+
+	response, err = keeper.EscrowByPlayer(wctx, &types.QueryEscrowByPlayerRequest{
+		Player:     alice,
+		Pagination: &query.PageRequest{Key: response.Pagination.NextKey},
+	})
+	require.Nil(t, err)
+	require.Empty(t, response.Games)
+	require.EqualValues(t, []types.PlayerMatchEscrow{{MatchIndex: "1", Wager: stakeCoins(20)}}, response.Matches)
+	require.EqualValues(t, stakeCoins(20), response.Total)
+	require.Nil(t, response.Pagination.NextKey)
+}
+
+func TestEscrowByPlayerDropsSettledGames(t *testing.T) {
+	keeper, ctx := keepertest.CheckersKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	storedGame := types.StoredGame{
+		Index:    "1",
+		Black:    alice,
+		Red:      bob,
+		Wager:    stakeCoins(45),
+		Escrowed: stakeCoins(90),
+	}
+	keeper.SetStoredGame(ctx, storedGame)
+	keeper.SetPremove(ctx, types.Premove{Index: "1", Player: alice, Deposit: 3})
+
+	// The game is paid out, but the premove deposit is still locked.
+	storedGame.Winner = "b"
+	storedGame.Escrowed = sdk.NewCoins()
+	keeper.SetStoredGame(ctx, storedGame)
+	response, err := keeper.EscrowByPlayer(wctx, &types.QueryEscrowByPlayerRequest{Player: alice})
+	require.Nil(t, err)
+	require.Len(t, response.Games, 1)
+	require.EqualValues(t, types.PremoveCoins(3), response.Total)
+	response, err = keeper.EscrowByPlayer(wctx, &types.QueryEscrowByPlayerRequest{Player: bob})
+	require.Nil(t, err)
+	require.Empty(t, response.Games)
+
+	keeper.RemovePremove(ctx, "1")
+	response, err = keeper.EscrowByPlayer(wctx, &types.QueryEscrowByPlayerRequest{Player: alice})
+	require.Nil(t, err)
+	require.Empty(t, response.Games)
+	require.True(t, response.Total.IsZero())
+}
+
+func TestEscrowByPlayerNothingLocked(t *testing.T) {
+	keeper, ctx := keepertest.CheckersKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	response, err := keeper.EscrowByPlayer(wctx, &types.QueryEscrowByPlayerRequest{Player: alice})
+	require.Nil(t, err)
+	require.Empty(t, response.Games)
+	require.True(t, response.Total.IsZero())
+}
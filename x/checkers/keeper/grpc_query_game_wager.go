@@ -0,0 +1,29 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/satya/checkers/x/checkers/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) GameWager(goCtx context.Context, req *types.QueryGameWagerRequest) (*types.QueryGameWagerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	storedGame, found := k.GetStoredGame(ctx, req.GameIndex)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrGameNotFound, "%s", req.GameIndex)
+	}
+
+	return &types.QueryGameWagerResponse{
+		Wager:    k.GetTracedCoins(ctx, storedGame.Wager),
+		Escrowed: k.GetTracedCoins(ctx, storedGame.Escrowed),
+	}, nil
+}
@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/satya/checkers/x/checkers/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) MatchAll(c context.Context, req *types.QueryAllMatchRequest) (*types.QueryAllMatchResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var matches []types.Match
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	matchStore := prefix.NewStore(store, types.KeyPrefix(types.MatchKeyPrefix))
+
+	pageRes, err := query.Paginate(matchStore, req.Pagination, func(key []byte, value []byte) error {
+		var match types.Match
+		if err := k.cdc.Unmarshal(value, &match); err != nil {
+			return err
+		}
+
+		matches = append(matches, match)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllMatchResponse{Match: matches, Pagination: pageRes}, nil
+}
+
+func (k Keeper) Match(c context.Context, req *types.QueryGetMatchRequest) (*types.QueryGetMatchResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	val, found := k.GetMatch(
+		ctx,
+		req.Index,
+	)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetMatchResponse{Match: val}, nil
+}
@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/satya/checkers/x/checkers/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) PlayerBalanceAll(c context.Context, req *types.QueryAllPlayerBalanceRequest) (*types.QueryAllPlayerBalanceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var playerBalances []types.PlayerBalance
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	playerBalanceStore := prefix.NewStore(store, types.KeyPrefix(types.PlayerBalanceKeyPrefix))
+
+	pageRes, err := query.Paginate(playerBalanceStore, req.Pagination, func(key []byte, value []byte) error {
+		var playerBalance types.PlayerBalance
+		if err := k.cdc.Unmarshal(value, &playerBalance); err != nil {
+			return err
+		}
+
+		playerBalances = append(playerBalances, playerBalance)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllPlayerBalanceResponse{PlayerBalance: playerBalances, Pagination: pageRes}, nil
+}
+
+func (k Keeper) PlayerBalance(c context.Context, req *types.QueryGetPlayerBalanceRequest) (*types.QueryGetPlayerBalanceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	val, found := k.GetPlayerBalance(
+		ctx,
+		req.Index,
+	)
+	if !found {
+		val = types.PlayerBalance{Index: req.Index, Funds: sdk.NewCoins()}
+	}
+
+	return &types.QueryGetPlayerBalanceResponse{PlayerBalance: val}, nil
+}
@@ -0,0 +1,128 @@
+package keeper_test
+
+import (
+	"strconv"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "github.com/satya/checkers/testutil/keeper"
+	"github.com/satya/checkers/testutil/nullify"
+	"github.com/satya/checkers/x/checkers/types"
+)
+
+// Prevent strconv unused error
+var _ = strconv.IntSize
+
+func TestPlayerBalanceQuerySingle(t *testing.T) {
+	keeper, ctx := keepertest.CheckersKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	msgs := createNPlayerBalance(keeper, ctx, 2)
+	for _, tc := range []struct {
+		desc     string
+		request  *types.QueryGetPlayerBalanceRequest
+		response *types.QueryGetPlayerBalanceResponse
+		err      error
+	}{
+		{
+			desc: "First",
+			request: &types.QueryGetPlayerBalanceRequest{
+				Index: msgs[0].Index,
+			},
+			response: &types.QueryGetPlayerBalanceResponse{PlayerBalance: msgs[0]},
+		},
+		{
+			desc: "Second",
+			request: &types.QueryGetPlayerBalanceRequest{
+				Index: msgs[1].Index,
+			},
+			response: &types.QueryGetPlayerBalanceResponse{PlayerBalance: msgs[1]},
+		},
+		{
+			desc: "KeyNotFound",
+			request: &types.QueryGetPlayerBalanceRequest{
+				Index: strconv.Itoa(100000),
+			},
+			response: &types.QueryGetPlayerBalanceResponse{
+				PlayerBalance: types.PlayerBalance{Index: strconv.Itoa(100000), Funds: sdk.NewCoins()},
+			},
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := keeper.PlayerBalance(wctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t,
+					nullify.Fill(tc.response),
+					nullify.Fill(response),
+				)
+			}
+		})
+	}
+}
+
+func TestPlayerBalanceQueryPaginated(t *testing.T) {
+	keeper, ctx := keepertest.CheckersKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	msgs := createNPlayerBalance(keeper, ctx, 5)
+
+	request := func(next []byte, offset, limit uint64, total bool) *types.QueryAllPlayerBalanceRequest {
+		return &types.QueryAllPlayerBalanceRequest{
+			Pagination: &query.PageRequest{
+				Key:        next,
+				Offset:     offset,
+				Limit:      limit,
+				CountTotal: total,
+			},
+		}
+	}
+	t.Run("ByOffset", func(t *testing.T) {
+		step := 2
+		for i := 0; i < len(msgs); i += step {
+			resp, err := keeper.PlayerBalanceAll(wctx, request(nil, uint64(i), uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.PlayerBalance), step)
+			require.Subset(t,
+				nullify.Fill(msgs),
+				nullify.Fill(resp.PlayerBalance),
+			)
+		}
+	})
+	t.Run("ByKey", func(t *testing.T) {
+		step := 2
+		var next []byte
+		for i := 0; i < len(msgs); i += step {
+			resp, err := keeper.PlayerBalanceAll(wctx, request(next, 0, uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.PlayerBalance), step)
+			require.Subset(t,
+				nullify.Fill(msgs),
+				nullify.Fill(resp.PlayerBalance),
+			)
+			next = resp.Pagination.NextKey
+		}
+	})
+	t.Run("Total", func(t *testing.T) {
+		resp, err := keeper.PlayerBalanceAll(wctx, request(nil, 0, 0, true))
+		require.NoError(t, err)
+		require.Equal(t, len(msgs), int(resp.Pagination.Total))
+		require.ElementsMatch(t,
+			nullify.Fill(msgs),
+			nullify.Fill(resp.PlayerBalance),
+		)
+	})
+	t.Run("InvalidRequest", func(t *testing.T) {
+		_, err := keeper.PlayerBalanceAll(wctx, nil)
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+	})
+}
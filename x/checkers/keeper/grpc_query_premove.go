@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/satya/checkers/x/checkers/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) PremoveAll(c context.Context, req *types.QueryAllPremoveRequest) (*types.QueryAllPremoveResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var premoves []types.Premove
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	premoveStore := prefix.NewStore(store, types.KeyPrefix(types.PremoveKeyPrefix))
+
+	pageRes, err := query.Paginate(premoveStore, req.Pagination, func(key []byte, value []byte) error {
+		var premove types.Premove
+		if err := k.cdc.Unmarshal(value, &premove); err != nil {
+			return err
+		}
+
+		premoves = append(premoves, premove)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllPremoveResponse{Premove: premoves, Pagination: pageRes}, nil
+}
+
+func (k Keeper) Premove(c context.Context, req *types.QueryGetPremoveRequest) (*types.QueryGetPremoveResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	val, found := k.GetPremove(
+		ctx,
+		req.Index,
+	)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetPremoveResponse{Premove: val}, nil
+}
@@ -0,0 +1,113 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/satya/checkers/x/checkers/engine"
+	"github.com/satya/checkers/x/checkers/rules"
+	"github.com/satya/checkers/x/checkers/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) SuggestMove(goCtx context.Context, req *types.QuerySuggestMoveRequest) (*types.QuerySuggestMoveResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	game, result, err := k.searchGame(ctx, req.GameIndex, req.Depth)
+	if err != nil {
+		return nil, err
+	}
+	bestMove := result.BestMove(game)
+	if len(bestMove) == 0 {
+		return nil, sdkerrors.Wrapf(types.ErrGameFinished, "%s", req.GameIndex)
+	}
+	move, err := formatSteps(bestMove)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QuerySuggestMoveResponse{
+		Move:  move,
+		Score: result.Score,
+		Depth: result.Depth,
+		Nodes: result.Nodes,
+	}, nil
+}
+
+func (k Keeper) EvaluatePosition(goCtx context.Context, req *types.QueryEvaluatePositionRequest) (*types.QueryEvaluatePositionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	game, result, err := k.searchGame(ctx, req.GameIndex, req.Depth)
+	if err != nil {
+		return nil, err
+	}
+	line := make([]string, 0, len(result.Line))
+	for len(result.Line) > 0 {
+		steps := result.BestMove(game)
+		if len(steps) == 0 {
+			break
+		}
+		move, err := formatSteps(steps)
+		if err != nil {
+			return nil, err
+		}
+		line = append(line, move)
+		for _, step := range steps {
+			game.Move(step.Src, step.Dst)
+		}
+		result.Line = result.Line[len(steps):]
+	}
+
+	return &types.QueryEvaluatePositionResponse{
+		Score: result.Score,
+		Depth: result.Depth,
+		Nodes: result.Nodes,
+		Line:  line,
+	}, nil
+}
+
+// searchGame runs a bounded engine search on the current position of a game.
+// Finished games are not searched.
+func (k Keeper) searchGame(ctx sdk.Context, gameIndex string, depth uint64) (*rules.Game, engine.Result, error) {
+	if depth == 0 {
+		depth = types.DefaultSearchDepth
+	}
+	if types.MaxSearchDepth < depth {
+		return nil, engine.Result{}, sdkerrors.Wrapf(types.ErrSearchTooDeep, "maximum %d", types.MaxSearchDepth)
+	}
+	storedGame, found := k.GetStoredGame(ctx, gameIndex)
+	if !found {
+		return nil, engine.Result{}, sdkerrors.Wrapf(types.ErrGameNotFound, "%s", gameIndex)
+	}
+	if storedGame.Winner != rules.PieceStrings[rules.NO_PLAYER] {
+		return nil, engine.Result{}, sdkerrors.Wrapf(types.ErrGameFinished, "%s", gameIndex)
+	}
+	game, err := storedGame.ParseGame()
+	if err != nil {
+		return nil, engine.Result{}, err
+	}
+	result := engine.Search(game, engine.Limits{
+		MaxDepth:   depth,
+		NodeBudget: types.SearchNodeBudget,
+	})
+	return game, result, nil
+}
+
+// formatSteps writes the steps of one player's move as a single path.
+func formatSteps(steps []rules.Step) (string, error) {
+	path := []rules.Pos{steps[0].Src}
+	for _, step := range steps {
+		path = append(path, step.Dst)
+	}
+	return rules.FormatPath(path)
+}
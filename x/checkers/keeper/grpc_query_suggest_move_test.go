@@ -0,0 +1,85 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/satya/checkers/testutil/keeper"
+	"github.com/satya/checkers/x/checkers/types"
+	"github.com/stretchr/testify/require"
+)
+
+const multiJumpBoard = "********|********|*b******|**r*****|********|****r***|********|********"
+
+func TestSuggestMoveFindsMultiJump(t *testing.T) {
+	keeper, ctx := keepertest.CheckersKeeper(t)
+	goCtx := sdk.WrapSDKContext(ctx)
+	keeper.SetStoredGame(ctx, types.StoredGame{
+		Index:  "1",
+		Board:  multiJumpBoard,
+		Turn:   "b",
+		Winner: "*",
+	})
+	response, err := keeper.SuggestMove(goCtx, &types.QuerySuggestMoveRequest{
+		GameIndex: "1",
+	})
+	require.Nil(t, err)
+	require.Equal(t, "9x18x27", response.Move)
+	require.EqualValues(t, 999_998, response.Score)
+	require.EqualValues(t, 1, response.Depth)
+}
+
+func TestSuggestMoveOpeningIsDeterministic(t *testing.T) {
+	keeper, ctx := keepertest.CheckersKeeper(t)
+	goCtx := sdk.WrapSDKContext(ctx)
+	keeper.SetStoredGame(ctx, types.StoredGame{
+		Index:  "1",
+		Board:  "*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
+		Turn:   "b",
+		Winner: "*",
+	})
+	request := &types.QuerySuggestMoveRequest{GameIndex: "1", Depth: 3}
+	first, err := keeper.SuggestMove(goCtx, request)
+	require.Nil(t, err)
+	second, err := keeper.SuggestMove(goCtx, request)
+	require.Nil(t, err)
+	require.Equal(t, first, second)
+	require.EqualValues(t, 3, first.Depth)
+	require.LessOrEqual(t, first.Nodes, types.SearchNodeBudget)
+}
+
+func TestSuggestMoveErrors(t *testing.T) {
+	keeper, ctx := keepertest.CheckersKeeper(t)
+	goCtx := sdk.WrapSDKContext(ctx)
+	keeper.SetStoredGame(ctx, types.StoredGame{
+		Index:  "1",
+		Board:  multiJumpBoard,
+		Turn:   "b",
+		Winner: "b",
+	})
+	_, err := keeper.SuggestMove(goCtx, nil)
+	require.EqualError(t, err, "rpc error: code = InvalidArgument desc = invalid request")
+	_, err = keeper.SuggestMove(goCtx, &types.QuerySuggestMoveRequest{GameIndex: "2"})
+	require.EqualError(t, err, "2: game by id not found")
+	_, err = keeper.SuggestMove(goCtx, &types.QuerySuggestMoveRequest{GameIndex: "1"})
+	require.EqualError(t, err, "1: game is already finished")
+	_, err = keeper.SuggestMove(goCtx, &types.QuerySuggestMoveRequest{GameIndex: "1", Depth: 9})
+	require.EqualError(t, err, "maximum 8: search depth is too deep")
+}
+
+func TestEvaluatePositionGivesLine(t *testing.T) {
+	keeper, ctx := keepertest.CheckersKeeper(t)
+	goCtx := sdk.WrapSDKContext(ctx)
+	keeper.SetStoredGame(ctx, types.StoredGame{
+		Index:  "1",
+		Board:  "********|********|*b******|**r*****|********|********|********|********",
+		Turn:   "r",
+		Winner: "*",
+	})
+	response, err := keeper.EvaluatePosition(goCtx, &types.QueryEvaluatePositionRequest{
+		GameIndex: "1",
+	})
+	require.Nil(t, err)
+	require.EqualValues(t, -999_999, response.Score)
+	require.Equal(t, []string{"14x5"}, response.Line)
+}
@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/satya/checkers/x/checkers/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) VacationAll(c context.Context, req *types.QueryAllVacationRequest) (*types.QueryAllVacationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var vacations []types.Vacation
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	vacationStore := prefix.NewStore(store, types.KeyPrefix(types.VacationKeyPrefix))
+
+	pageRes, err := query.Paginate(vacationStore, req.Pagination, func(key []byte, value []byte) error {
+		var vacation types.Vacation
+		if err := k.cdc.Unmarshal(value, &vacation); err != nil {
+			return err
+		}
+
+		vacations = append(vacations, vacation)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllVacationResponse{Vacation: vacations, Pagination: pageRes}, nil
+}
+
+func (k Keeper) Vacation(c context.Context, req *types.QueryGetVacationRequest) (*types.QueryGetVacationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	val, found := k.GetVacation(
+		ctx,
+		req.Index,
+	)
+	if !found {
+		val = types.NewVacation(req.Index)
+	}
+	remaining := val
+	remaining.ForYear(uint64(ctx.BlockTime().Year()))
+
+	return &types.QueryGetVacationResponse{
+		Vacation:         val,
+		RemainingSeconds: uint64(remaining.GetRemaining(ctx.BlockTime()) / time.Second),
+	}, nil
+}
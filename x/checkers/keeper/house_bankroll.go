@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/satya/checkers/x/checkers/types"
+)
+
+// SetHouseBankroll set houseBankroll in the store
+func (k Keeper) SetHouseBankroll(ctx sdk.Context, houseBankroll types.HouseBankroll) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.HouseBankrollKey))
+	b := k.cdc.MustMarshal(&houseBankroll)
+	store.Set([]byte{0}, b)
+}
+
+// GetHouseBankroll returns houseBankroll
+func (k Keeper) GetHouseBankroll(ctx sdk.Context) (val types.HouseBankroll, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.HouseBankrollKey))
+
+	b := store.Get([]byte{0})
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// DebitHouseBankroll takes a house wager out of the bankroll. The coins stay in
+// the module account, where they are now held in escrow.
+func (k Keeper) DebitHouseBankroll(ctx sdk.Context, amount sdk.Coins) error {
+	houseBankroll, _ := k.GetHouseBankroll(ctx)
+	if !houseBankroll.Funds.IsAllGTE(amount) {
+		return types.ErrHouseCannotPay
+	}
+	houseBankroll.Funds = houseBankroll.Funds.Sub(amount)
+	k.SetHouseBankroll(ctx, houseBankroll)
+	return nil
+}
+
+// CreditHouseBankroll returns escrowed coins won or refunded to the house.
+func (k Keeper) CreditHouseBankroll(ctx sdk.Context, amount sdk.Coins) {
+	houseBankroll, _ := k.GetHouseBankroll(ctx)
+	houseBankroll.Funds = houseBankroll.Funds.Add(amount...)
+	k.SetHouseBankroll(ctx, houseBankroll)
+}
@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/satya/checkers/x/checkers/engine"
+	"github.com/satya/checkers/x/checkers/rules"
+	"github.com/satya/checkers/x/checkers/types"
+)
+
+// PlayHouseMoves plays the engine's moves for the house for as long as it has
+// the turn. It is called right after the opponent's move, so the house never
+// leaves a game waiting.
+func (k Keeper) PlayHouseMoves(ctx sdk.Context, storedGame *types.StoredGame, game *rules.Game) error {
+	house := types.GetHouseAddress().String()
+	for storedGame.Winner == rules.PieceStrings[rules.NO_PLAYER] && storedGame.IsHouseTurn() {
+		ctx.GasMeter().ConsumeGas(types.HouseMoveGas, "House move")
+		result := engine.Search(game, engine.Limits{
+			MaxDepth:   types.HouseSearchDepth,
+			NodeBudget: types.HouseNodeBudget,
+		})
+		steps := result.BestMove(game)
+		if len(steps) == 0 {
+			panic("house has the turn but no move")
+		}
+		for _, step := range steps {
+			_, err := k.PlayStep(ctx, storedGame, game, house, step)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,197 @@
+package keeper_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+	keepertest "github.com/satya/checkers/testutil/keeper"
+	"github.com/satya/checkers/x/checkers"
+	"github.com/satya/checkers/x/checkers/keeper"
+	"github.com/satya/checkers/x/checkers/testutil"
+	"github.com/satya/checkers/x/checkers/types"
+	"github.com/stretchr/testify/require"
+)
+
+func setupMsgServerWithHouse(t *testing.T, bankroll int64) (types.MsgServer, keeper.Keeper, context.Context,
+	*gomock.Controller, *testutil.MockBankEscrowKeeper, *testutil.MockCheckersLeaderboardKeeper) {
+	ctrl := gomock.NewController(t)
+	bankMock := testutil.NewMockBankEscrowKeeper(ctrl)
+	leaderboardMock := testutil.NewMockCheckersLeaderboardKeeper(ctrl)
+	k, ctx := keepertest.CheckersKeeperWithMocks(t, bankMock, leaderboardMock)
+	genesis := types.DefaultGenesis()
+	genesis.HouseBankroll.Funds = sdk.NewCoins(sdk.NewInt64Coin("stake", bankroll))
+	bankMock.EXPECT().GetAllBalances(ctx, types.GetHouseAddress()).Return(genesis.HouseBankroll.Funds)
+	checkers.InitGenesis(ctx, *k, *genesis)
+	return keeper.NewMsgServerImpl(*k), *k, sdk.WrapSDKContext(ctx), ctrl, bankMock, leaderboardMock
+}
+
+func TestHouseAsBlackOpensOnAccept(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow, _ := setupMsgServerWithHouse(t, 1000)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	_, err := msgServer.CreateGame(context, &types.MsgCreateGame{
+		Creator: alice,
+		Red:     bob,
+		Wager:   sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+		House:   "b",
+	})
+	require.Nil(t, err)
+	game, found := keeper.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	require.Equal(t, types.GetHouseAddress().String(), game.AcceptedBy)
+	require.EqualValues(t, 0, game.MoveCount)
+	escrow.ExpectPay(context, bob, 45).Times(1)
+	acceptGame(t, msgServer, context, "1", bob)
+	game, _ = keeper.GetStoredGame(ctx, "1")
+	require.EqualValues(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 90)), game.Escrowed)
+	require.Equal(t, types.GetHouseAddress().String(), game.Black)
+	require.Equal(t, bob, game.Red)
+	require.Equal(t, "r", game.Turn)
+	require.EqualValues(t, 1, game.MoveCount)
+	require.Len(t, game.MoveHistory, 1)
+	bankroll, found := keeper.GetHouseBankroll(ctx)
+	require.True(t, found)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 955)), bankroll.Funds)
+}
+
+func TestHouseAsRedAnswersInSameTx(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow, _ := setupMsgServerWithHouse(t, 1000)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	_, err := msgServer.CreateGame(context, &types.MsgCreateGame{
+		Creator: alice,
+		Black:   bob,
+		Wager:   sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+		House:   "r",
+	})
+	require.Nil(t, err)
+	escrow.ExpectPay(context, bob, 45).Times(1)
+	acceptGame(t, msgServer, context, "1", bob)
+	response, err := msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   bob,
+		GameIndex: "1",
+		FromX:     1,
+		FromY:     2,
+		ToX:       2,
+		ToY:       3,
+	})
+	require.Nil(t, err)
+	require.Equal(t, "*", response.Winner)
+	game, _ := keeper.GetStoredGame(ctx, "1")
+	require.Equal(t, "b", game.Turn)
+	require.EqualValues(t, 2, game.MoveCount)
+	require.Equal(t, "9-14", game.MoveHistory[0])
+	require.Len(t, game.MoveHistory, 2)
+	bankroll, _ := keeper.GetHouseBankroll(ctx)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 955)), bankroll.Funds)
+	movePlayed := findEvent(t, ctx, types.MovePlayedEventType)
+	require.EqualValues(t, sdk.Attribute{Key: "creator", Value: types.GetHouseAddress().String()}, movePlayed.Attributes[7])
+}
+
+func TestHouseWinsForfeitIntoBankroll(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow, leaderboard := setupMsgServerWithHouse(t, 1000)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	msgServer.CreateGame(context, &types.MsgCreateGame{
+		Creator: alice,
+		Black:   bob,
+		Wager:   sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+		House:   "r",
+	})
+	escrow.ExpectPay(context, bob, 45).Times(1)
+	acceptGame(t, msgServer, context, "1", bob)
+	msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   bob,
+		GameIndex: "1",
+		FromX:     1,
+		FromY:     2,
+		ToX:       2,
+		ToY:       3,
+	})
+	leaderboard.ExpectAny(context)
+	game, _ := keeper.GetStoredGame(ctx, "1")
+	game.Deadline = types.FormatDeadline(ctx.BlockTime().Add(time.Duration(-1)))
+	keeper.SetStoredGame(ctx, game)
+	keeper.ForfeitExpiredGames(context)
+
+	game, _ = keeper.GetStoredGame(ctx, "1")
+	require.Equal(t, "r", game.Winner)
+	bankroll, _ := keeper.GetHouseBankroll(ctx)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 1045)), bankroll.Funds)
+}
+
+func TestHouseCannotCoverWager(t *testing.T) {
+	msgServer, _, context, ctrl, _, _ := setupMsgServerWithHouse(t, 10)
+	defer ctrl.Finish()
+	_, err := msgServer.CreateGame(context, &types.MsgCreateGame{
+		Creator: alice,
+		Black:   bob,
+		Wager:   sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+		House:   "r",
+	})
+	require.EqualError(t, err, "house bankroll cannot pay the wager")
+}
+
+func TestFundHouseBankroll(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow, _ := setupMsgServerWithHouse(t, 1000)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	escrow.ExpectPay(context, alice, 250).Times(1)
+	response, err := msgServer.FundHouseBankroll(context,
+		types.NewMsgFundHouseBankroll(alice, sdk.NewCoins(sdk.NewInt64Coin("stake", 250))))
+	require.Nil(t, err)
+	require.EqualValues(t, types.MsgFundHouseBankrollResponse{
+		Bankroll: sdk.NewCoins(sdk.NewInt64Coin("stake", 1250)),
+	}, *response)
+	bankroll, _ := keeper.GetHouseBankroll(ctx)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 1250)), bankroll.Funds)
+	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
+	require.Len(t, events, 1)
+	event := events[0]
+	require.Equal(t, "house-bankroll-funded", event.Type)
+	require.EqualValues(t, []sdk.Attribute{
+		{Key: "funder", Value: alice},
+		{Key: "amount", Value: "250stake"},
+		{Key: "bankroll", Value: "1250stake"},
+	}, event.Attributes)
+}
+
+func TestFundHouseBankrollCannotPay(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow, _ := setupMsgServerWithHouse(t, 1000)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	escrow.ExpectPay(context, alice, 250).Return(errors.New("oops"))
+	response, err := msgServer.FundHouseBankroll(context,
+		types.NewMsgFundHouseBankroll(alice, sdk.NewCoins(sdk.NewInt64Coin("stake", 250))))
+	require.Nil(t, response)
+	require.EqualError(t, err, "funder cannot pay the house bankroll: oops")
+	bankroll, _ := keeper.GetHouseBankroll(ctx)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)), bankroll.Funds)
+}
+
+func TestFundHouseBankrollDenomNotAllowed(t *testing.T) {
+	msgServer, _, context, ctrl, _, _ := setupMsgServerWithHouse(t, 1000)
+	defer ctrl.Finish()
+	response, err := msgServer.FundHouseBankroll(context,
+		types.NewMsgFundHouseBankroll(alice, sdk.NewCoins(sdk.NewInt64Coin("gold", 250))))
+	require.Nil(t, response)
+	require.ErrorIs(t, err, types.ErrDenomNotAllowed)
+}
+
+func TestInitGenesisPanicsOnUnbackedBankroll(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	bankMock := testutil.NewMockBankEscrowKeeper(ctrl)
+	k, ctx := keepertest.CheckersKeeperWithMocks(t, bankMock, nil)
+	genesis := types.DefaultGenesis()
+	genesis.HouseBankroll.Funds = sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))
+	bankMock.EXPECT().GetAllBalances(ctx, types.GetHouseAddress()).
+		Return(sdk.NewCoins(sdk.NewInt64Coin("stake", 999)))
+	require.PanicsWithError(t,
+		"held 1000stake, balance 999stake: module account balance does not cover the held funds",
+		func() { checkers.InitGenesis(ctx, *k, *genesis) })
+}
@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/satya/checkers/x/checkers/types"
+)
+
+// RegisterInvariants registers all checkers invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "module-account-balance", ModuleAccountBalanceInvariant(k))
+}
+
+// ModuleAccountBalanceInvariant checks that the module account holds exactly
+// what the module accounts for: the internal balances of the players, the
+// house bankroll, what is in escrow for the games, the bets and the premove
+// deposits.
+func ModuleAccountBalanceInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		held := k.GetHeldFunds(ctx)
+		balance := k.bank.GetAllBalances(ctx, types.GetHouseAddress())
+		broken := !balance.IsAllGTE(held) || !held.IsAllGTE(balance)
+		return sdk.FormatInvariant(types.ModuleName, "module account balance",
+			fmt.Sprintf("\tsum of held funds: %s\n\tmodule account balance: %s\n", held, balance)), broken
+	}
+}
+
+// ValidateHeldFundsBacked checks that the module account holds at least what
+// the module accounts for, as when the genesis is loaded.
+func (k Keeper) ValidateHeldFundsBacked(ctx sdk.Context) error {
+	held := k.GetHeldFunds(ctx)
+	if held.IsZero() {
+		return nil
+	}
+	balance := k.bank.GetAllBalances(ctx, types.GetHouseAddress())
+	if !balance.IsAllGTE(held) {
+		return sdkerrors.Wrapf(types.ErrHeldFundsNotBacked, "held %s, balance %s", held, balance)
+	}
+	return nil
+}
+
+// GetHeldFunds sums all the coins that the module account holds on behalf of
+// players, the house, sponsors and bettors.
+func (k Keeper) GetHeldFunds(ctx sdk.Context) sdk.Coins {
+	held := sdk.NewCoins()
+	for _, playerBalance := range k.GetAllPlayerBalance(ctx) {
+		held = held.Add(playerBalance.Funds...)
+	}
+	houseBankroll, _ := k.GetHouseBankroll(ctx)
+	held = held.Add(houseBankroll.Funds...)
+	for _, storedGame := range k.GetAllStoredGame(ctx) {
+		held = held.Add(storedGame.Escrowed...).Add(storedGame.Prize...)
+	}
+	for _, match := range k.GetAllMatch(ctx) {
+		held = held.Add(match.Escrowed...)
+	}
+	for _, bet := range k.GetAllBet(ctx) {
+		held = held.Add(bet.Amount...)
+	}
+	for _, premove := range k.GetAllPremove(ctx) {
+		held = held.Add(types.PremoveCoins(premove.Deposit)...)
+	}
+	return held
+}
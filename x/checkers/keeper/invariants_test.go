@@ -0,0 +1,57 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/satya/checkers/x/checkers/keeper"
+	"github.com/satya/checkers/x/checkers/types"
+	"github.com/stretchr/testify/require"
+)
+
+func setHeldFunds(k keeper.Keeper, ctx sdk.Context) {
+	k.CreditPlayerBalance(ctx, alice, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))
+	k.CreditHouseBankroll(ctx, sdk.NewCoins(sdk.NewInt64Coin("token", 20)))
+	k.SetStoredGame(ctx, types.StoredGame{
+		Index:    "1",
+		Escrowed: sdk.NewCoins(sdk.NewInt64Coin("stake", 30)),
+		Prize:    sdk.NewCoins(sdk.NewInt64Coin("token", 5)),
+	})
+	k.SetBet(ctx, types.NewBet("1", carol, "b", sdk.NewCoins(sdk.NewInt64Coin("stake", 7))))
+	k.SetPremove(ctx, types.Premove{Index: "1", Deposit: 3})
+}
+
+func TestGetHeldFunds(t *testing.T) {
+	keeper, context, ctrl, _ := setupKeeperForWagerHandler(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	require.True(t, keeper.GetHeldFunds(ctx).IsZero())
+	setHeldFunds(keeper, ctx)
+	require.Equal(t,
+		sdk.NewCoins(sdk.NewInt64Coin("stake", 50), sdk.NewInt64Coin("token", 25)),
+		keeper.GetHeldFunds(ctx))
+}
+
+func TestModuleAccountBalanceInvariantHolds(t *testing.T) {
+	k, context, ctrl, escrow := setupKeeperForWagerHandler(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	setHeldFunds(k, ctx)
+	escrow.EXPECT().GetAllBalances(ctx, types.GetHouseAddress()).
+		Return(sdk.NewCoins(sdk.NewInt64Coin("stake", 50), sdk.NewInt64Coin("token", 25)))
+	_, broken := keeper.ModuleAccountBalanceInvariant(k)(ctx)
+	require.False(t, broken)
+}
+
+func TestModuleAccountBalanceInvariantBroken(t *testing.T) {
+	k, context, ctrl, escrow := setupKeeperForWagerHandler(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	setHeldFunds(k, ctx)
+	escrow.EXPECT().GetAllBalances(ctx, types.GetHouseAddress()).
+		Return(sdk.NewCoins(sdk.NewInt64Coin("stake", 50), sdk.NewInt64Coin("token", 25), sdk.NewInt64Coin("gold", 1)))
+	msg, broken := keeper.ModuleAccountBalanceInvariant(k)(ctx)
+	require.True(t, broken)
+	require.Contains(t, msg, "sum of held funds: 50stake,25token")
+	require.Contains(t, msg, "module account balance: 1gold,50stake,25token")
+}
@@ -13,18 +13,22 @@ import (
 
 type (
 	Keeper struct {
-		bank       types.BankEscrowKeeper
-		board      types.CheckersLeaderboardKeeper
-		cdc        codec.BinaryCodec
-		storeKey   sdk.StoreKey
-		memKey     sdk.StoreKey
-		paramstore paramtypes.Subspace
+		bank         types.BankEscrowKeeper
+		board        types.CheckersLeaderboardKeeper
+		transfer     types.DenomTraceKeeper
+		distribution types.DistributionKeeper
+		cdc          codec.BinaryCodec
+		storeKey     sdk.StoreKey
+		memKey       sdk.StoreKey
+		paramstore   paramtypes.Subspace
 	}
 )
 
 func NewKeeper(
 	bank types.BankEscrowKeeper,
 	board types.CheckersLeaderboardKeeper,
+	transfer types.DenomTraceKeeper,
+	distribution types.DistributionKeeper,
 	cdc codec.BinaryCodec,
 	storeKey,
 	memKey sdk.StoreKey,
@@ -37,12 +41,14 @@ func NewKeeper(
 	}
 
 	return &Keeper{
-		bank:       bank,
-		board:      board,
-		cdc:        cdc,
-		storeKey:   storeKey,
-		memKey:     memKey,
-		paramstore: ps,
+		bank:         bank,
+		board:        board,
+		transfer:     transfer,
+		distribution: distribution,
+		cdc:          cdc,
+		storeKey:     storeKey,
+		memKey:       memKey,
+		paramstore:   ps,
 	}
 }
 
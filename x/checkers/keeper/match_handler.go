@@ -0,0 +1,164 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/satya/checkers/x/checkers/rules"
+	"github.com/satya/checkers/x/checkers/types"
+)
+
+// AcceptMatchWager records that player accepts the wager of the match. The
+// second acceptance escrows both stakes, player A first. It fails if either
+// cannot pay, in which case neither does, as the transaction is reverted.
+func (k *Keeper) AcceptMatchWager(ctx sdk.Context, match *types.Match, player string) error {
+	if match.AcceptedBy == player {
+		return sdkerrors.Wrapf(types.ErrAlreadyAccepted, "%s", player)
+	}
+	if match.AcceptedBy == "" {
+		match.AcceptedBy = player
+		return nil
+	}
+	for _, payer := range []string{match.PlayerA, match.PlayerB} {
+		payerAddress, err := sdk.AccAddressFromBech32(payer)
+		if err != nil {
+			panic(err.Error())
+		}
+		err = k.collectFromPlayer(ctx, payerAddress, match.Wager)
+		if err != nil {
+			return sdkerrors.Wrapf(err, types.ErrMatchPlayerCannotPay.Error())
+		}
+	}
+	match.Escrowed = match.Wager.Add(match.Wager...)
+	match.AcceptedBy = ""
+	return nil
+}
+
+// startMatchGame creates the next game of a match, from the standard start
+// and with the colours swapped from the previous game. The game is unwagered,
+// as the stakes are on the match, and unrated, as the match is rated instead.
+func (k *Keeper) startMatchGame(ctx sdk.Context, match *types.Match, systemInfo *types.SystemInfo) types.StoredGame {
+	newIndex := strconv.FormatUint(systemInfo.NextId, 10)
+	black, red := match.GetNextColours()
+	newGame := rules.New()
+	storedGame := types.StoredGame{
+		Index:       newIndex,
+		Board:       newGame.String(),
+		Turn:        rules.PieceStrings[newGame.Turn],
+		Black:       black,
+		Red:         red,
+		Winner:      rules.PieceStrings[rules.NO_PLAYER],
+		Deadline:    types.FormatDeadline(types.GetNextDeadline(ctx)),
+		MoveCount:   0,
+		BeforeIndex: types.NoFifoIndex,
+		AfterIndex:  types.NoFifoIndex,
+		Unrated:     true,
+		MatchIndex:  match.Index,
+	}
+	k.SendToFifoTail(ctx, &storedGame, systemInfo)
+	k.FreezeIfOnVacation(ctx, &storedGame, systemInfo)
+	k.SetStoredGame(ctx, storedGame)
+	systemInfo.NextId++
+	match.GameIndices = append(match.GameIndices, newIndex)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.GameCreatedEventType,
+			sdk.NewAttribute(types.GameCreatedEventCreator, match.Creator),
+			sdk.NewAttribute(types.GameCreatedEventGameIndex, newIndex),
+			sdk.NewAttribute(types.GameCreatedEventBlack, black),
+			sdk.NewAttribute(types.GameCreatedEventRed, red),
+			sdk.NewAttribute(types.GameCreatedEventWager, storedGame.Wager.String()),
+			sdk.NewAttribute(types.GameCreatedEventWagerTraced, ""),
+			sdk.NewAttribute(types.GameCreatedEventPrize, storedGame.Prize.String()),
+		),
+	)
+	return storedGame
+}
+
+// MustAdvanceMatch scores a game that ended, if it is part of a match. It then
+// either settles the match, when it is decided, or creates its next game.
+func (k *Keeper) MustAdvanceMatch(ctx sdk.Context, storedGame *types.StoredGame, systemInfo *types.SystemInfo) {
+	if storedGame.MatchIndex == "" {
+		return
+	}
+	match, found := k.GetMatch(ctx, storedGame.MatchIndex)
+	if !found {
+		panic(sdkerrors.Wrapf(types.ErrMatchNotFound, "%s", storedGame.MatchIndex).Error())
+	}
+	match.AddResult(*storedGame)
+	nextGameIndex := ""
+	if winner, decided := match.GetResult(); decided {
+		match.Winner = winner
+		k.mustSettleMatch(ctx, &match)
+	} else {
+		nextGameIndex = k.startMatchGame(ctx, &match, systemInfo).Index
+	}
+	k.SetMatch(ctx, match)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.MatchGameEndedEventType,
+			sdk.NewAttribute(types.MatchGameEndedEventMatchIndex, match.Index),
+			sdk.NewAttribute(types.MatchGameEndedEventGameIndex, storedGame.Index),
+			sdk.NewAttribute(types.MatchGameEndedEventWinner, storedGame.Winner),
+			sdk.NewAttribute(types.MatchGameEndedEventScoreA, strconv.FormatUint(match.ScoreA, 10)),
+			sdk.NewAttribute(types.MatchGameEndedEventScoreB, strconv.FormatUint(match.ScoreB, 10)),
+			sdk.NewAttribute(types.MatchGameEndedEventNextGame, nextGameIndex),
+		),
+	)
+}
+
+// mustSettleMatch pays the escrow of a decided match to its winner, less the
+// platform rake, and registers the result on the leaderboard. A drawn match
+// refunds each player.
+func (k *Keeper) mustSettleMatch(ctx sdk.Context, match *types.Match) {
+	winnings, rake := sdk.NewCoins(), sdk.NewCoins()
+	if match.Winner == types.DrawWinner {
+		if !match.Escrowed.IsZero() {
+			for _, player := range []string{match.PlayerA, match.PlayerB} {
+				playerAddress, err := sdk.AccAddressFromBech32(player)
+				if err != nil {
+					panic(err.Error())
+				}
+				err = k.payToPlayer(ctx, playerAddress, match.Wager)
+				if err != nil {
+					panic(fmt.Sprintf(types.ErrCannotRefundWager.Error(), err.Error()))
+				}
+			}
+		}
+	} else {
+		winnerAddress, err := sdk.AccAddressFromBech32(match.Winner)
+		if err != nil {
+			panic(err.Error())
+		}
+		loserAddress, err := sdk.AccAddressFromBech32(match.GetLoser())
+		if err != nil {
+			panic(err.Error())
+		}
+		rake = types.GetShareOf(match.Escrowed, k.PlatformRakeBps(ctx))
+		winnings = match.Escrowed.Sub(rake)
+		err = k.payRake(ctx, k.RakeDestination(ctx), rake)
+		if err != nil {
+			panic(fmt.Sprintf(types.ErrCannotPayWinnings.Error(), err.Error()))
+		}
+		if !winnings.IsZero() {
+			err = k.payToPlayer(ctx, winnerAddress, winnings)
+			if err != nil {
+				panic(fmt.Sprintf(types.ErrCannotPayWinnings.Error(), err.Error()))
+			}
+		}
+		k.board.MustAddWonGameResultToPlayer(ctx, winnerAddress)
+		k.board.MustAddLostGameResultToPlayer(ctx, loserAddress)
+	}
+	match.Escrowed = nil
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.MatchEndedEventType,
+			sdk.NewAttribute(types.MatchEndedEventMatchIndex, match.Index),
+			sdk.NewAttribute(types.MatchEndedEventWinner, match.Winner),
+			sdk.NewAttribute(types.MatchEndedEventWinnings, winnings.String()),
+			sdk.NewAttribute(types.MatchEndedEventRake, rake.String()),
+		),
+	)
+}
@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/satya/checkers/x/checkers/types"
+)
+
+func (k msgServer) AcceptAdjourn(goCtx context.Context, msg *types.MsgAcceptAdjourn) (*types.MsgAcceptAdjournResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	storedGame, err := k.Keeper.getAdjournGame(ctx, msg.GameIndex, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+	if storedGame.IsAdjourned() {
+		return nil, sdkerrors.Wrapf(types.ErrGameAdjourned, "%s", msg.GameIndex)
+	}
+	if storedGame.AdjournProposer == "" {
+		return nil, sdkerrors.Wrapf(types.ErrNoAdjournProposal, "%s", msg.GameIndex)
+	}
+	// When playing against oneself, one's own proposal can be accepted.
+	if storedGame.AdjournProposer == msg.Creator && storedGame.Black != storedGame.Red {
+		return nil, sdkerrors.Wrapf(types.ErrAdjournNotAllowed, "%s", "the opponent must accept")
+	}
+
+	k.Keeper.adjournGame(ctx, &storedGame)
+
+	ctx.GasMeter().ConsumeGas(types.AdjournGas, "Accept adjourn")
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.GameAdjournedEventType,
+			sdk.NewAttribute(types.GameAdjournedEventGameIndex, msg.GameIndex),
+			sdk.NewAttribute(types.GameAdjournedEventUntil, storedGame.AdjournedUntil),
+		),
+	)
+
+	return &types.MsgAcceptAdjournResponse{
+		AdjournedUntil: storedGame.AdjournedUntil,
+	}, nil
+}
@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/satya/checkers/x/checkers/rules"
+	"github.com/satya/checkers/x/checkers/types"
+)
+
+func (k msgServer) AcceptGame(goCtx context.Context, msg *types.MsgAcceptGame) (*types.MsgAcceptGameResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	storedGame, found := k.Keeper.GetStoredGame(ctx, msg.GameIndex)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrGameNotFound, "%s", msg.GameIndex)
+	}
+	if storedGame.Winner != rules.PieceStrings[rules.NO_PLAYER] {
+		return nil, types.ErrGameFinished
+	}
+	if storedGame.Black != msg.Creator && storedGame.Red != msg.Creator {
+		return nil, sdkerrors.Wrapf(types.ErrCreatorNotPlayer, "%s", msg.Creator)
+	}
+	if storedGame.IsFunded() {
+		return nil, sdkerrors.Wrapf(types.ErrAlreadyAccepted, "%s", msg.Creator)
+	}
+
+	err := k.Keeper.AcceptWager(ctx, &storedGame, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+	if storedGame.IsFunded() {
+		// The clock starts now that play can.
+		k.Keeper.resetDeadline(ctx, &storedGame)
+	} else {
+		k.Keeper.SetStoredGame(ctx, storedGame)
+	}
+
+	ctx.GasMeter().ConsumeGas(types.AcceptGameGas, "Accept game")
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.GameAcceptedEventType,
+			sdk.NewAttribute(types.GameAcceptedEventPlayer, msg.Creator),
+			sdk.NewAttribute(types.GameAcceptedEventGameIndex, msg.GameIndex),
+			sdk.NewAttribute(types.GameAcceptedEventEscrowed, storedGame.Escrowed.String()),
+		),
+	)
+
+	if storedGame.IsFunded() {
+		game, err := storedGame.ParseGame()
+		if err != nil {
+			panic(err.Error())
+		}
+		err = k.Keeper.PlayHouseMoves(ctx, &storedGame, game)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.MsgAcceptGameResponse{
+		Escrowed: storedGame.Escrowed,
+	}, nil
+}
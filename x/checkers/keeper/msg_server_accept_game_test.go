@@ -0,0 +1,156 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+	keepertest "github.com/satya/checkers/testutil/keeper"
+	"github.com/satya/checkers/x/checkers"
+	"github.com/satya/checkers/x/checkers/keeper"
+	"github.com/satya/checkers/x/checkers/testutil"
+	"github.com/satya/checkers/x/checkers/types"
+	"github.com/stretchr/testify/require"
+)
+
+// acceptGame has each player accept the wager of a game.
+func acceptGame(t *testing.T, msgServer types.MsgServer, context context.Context, gameIndex string, players ...string) {
+	for _, player := range players {
+		_, err := msgServer.AcceptGame(context, types.NewMsgAcceptGame(player, gameIndex))
+		require.Nil(t, err)
+	}
+}
+
+func setupMsgServerWithOneGameForAccept(t testing.TB, creator string) (types.MsgServer, keeper.Keeper, context.Context,
+	*gomock.Controller, *testutil.MockBankEscrowKeeper) {
+	ctrl := gomock.NewController(t)
+	bankMock := testutil.NewMockBankEscrowKeeper(ctrl)
+	k, ctx := keepertest.CheckersKeeperWithMocks(t, bankMock, nil)
+	checkers.InitGenesis(ctx, *k, *types.DefaultGenesis())
+	server := keeper.NewMsgServerImpl(*k)
+	context := sdk.WrapSDKContext(ctx)
+	_, err := server.CreateGame(context, &types.MsgCreateGame{
+		Creator: creator,
+		Black:   bob,
+		Red:     carol,
+		Wager:   sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+	})
+	require.Nil(t, err)
+	return server, *k, context, ctrl, bankMock
+}
+
+func TestAcceptGameEscrowsBothOnSecondAccept(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithOneGameForAccept(t, alice)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+
+	response, err := msgServer.AcceptGame(context, types.NewMsgAcceptGame(carol, "1"))
+	require.Nil(t, err)
+	require.EqualValues(t, types.MsgAcceptGameResponse{}, *response)
+	game, _ := keeper.GetStoredGame(ctx, "1")
+	require.EqualValues(t, carol, game.AcceptedBy)
+	require.False(t, game.IsFunded())
+
+	payBob := escrow.ExpectPay(context, bob, 45)
+	escrow.ExpectPay(context, carol, 45).After(payBob)
+	response, err = msgServer.AcceptGame(context, types.NewMsgAcceptGame(bob, "1"))
+	require.Nil(t, err)
+	require.EqualValues(t, types.MsgAcceptGameResponse{Escrowed: sdk.NewCoins(sdk.NewInt64Coin("stake", 90))}, *response)
+	game, _ = keeper.GetStoredGame(ctx, "1")
+	require.EqualValues(t, "", game.AcceptedBy)
+	require.EqualValues(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 90)), game.Escrowed)
+	event := findEvent(t, ctx, types.GameAcceptedEventType)
+	require.EqualValues(t, []sdk.Attribute{
+		{Key: "player", Value: carol},
+		{Key: "game-index", Value: "1"},
+		{Key: "escrowed", Value: ""},
+		{Key: "player", Value: bob},
+		{Key: "game-index", Value: "1"},
+		{Key: "escrowed", Value: "90stake"},
+	}, event.Attributes)
+
+	_, err = msgServer.AcceptGame(context, types.NewMsgAcceptGame(carol, "1"))
+	require.ErrorIs(t, err, types.ErrAlreadyAccepted)
+}
+
+func TestAcceptGameByCreator(t *testing.T) {
+	msgServer, keeper, context, ctrl, _ := setupMsgServerWithOneGameForAccept(t, bob)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+
+	game, _ := keeper.GetStoredGame(ctx, "1")
+	require.EqualValues(t, bob, game.AcceptedBy)
+	_, err := msgServer.AcceptGame(context, types.NewMsgAcceptGame(bob, "1"))
+	require.ErrorIs(t, err, types.ErrAlreadyAccepted)
+	_, err = msgServer.AcceptGame(context, types.NewMsgAcceptGame(alice, "1"))
+	require.ErrorIs(t, err, types.ErrCreatorNotPlayer)
+}
+
+func TestAcceptGameRequiredToPlay(t *testing.T) {
+	msgServer, _, context, ctrl, _ := setupMsgServerWithOneGameForAccept(t, alice)
+	defer ctrl.Finish()
+
+	_, err := msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   bob,
+		GameIndex: "1",
+		FromX:     1,
+		FromY:     2,
+		ToX:       2,
+		ToY:       3,
+	})
+	require.ErrorIs(t, err, types.ErrGameNotAccepted)
+}
+
+func TestAcceptGameCannotPay(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithOneGameForAccept(t, alice)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	acceptGame(t, msgServer, context, "1", bob)
+
+	payBob := escrow.ExpectPay(context, bob, 45)
+	escrow.ExpectPay(context, carol, 45).After(payBob).Return(sdk.ErrEmptyDecimalStr)
+	_, err := msgServer.AcceptGame(context, types.NewMsgAcceptGame(carol, "1"))
+	require.EqualError(t, err, "red cannot pay the wager: decimal string cannot be empty")
+	game, _ := keeper.GetStoredGame(ctx, "1")
+	require.EqualValues(t, bob, game.AcceptedBy)
+	require.Empty(t, game.Escrowed)
+}
+
+func TestAcceptGameWithoutWager(t *testing.T) {
+	msgServer, keeper, context := setupMsgServerCreateGame(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	_, err := msgServer.CreateGame(context, &types.MsgCreateGame{
+		Creator: alice,
+		Black:   bob,
+		Red:     carol,
+	})
+	require.Nil(t, err)
+	game, _ := keeper.GetStoredGame(ctx, "1")
+	require.True(t, game.IsFunded())
+	_, err = msgServer.AcceptGame(context, types.NewMsgAcceptGame(bob, "1"))
+	require.ErrorIs(t, err, types.ErrAlreadyAccepted)
+}
+
+func TestAcceptGameEscrowsSeveralDenoms(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithOneGameForAccept(t, alice)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	keeper.SetParams(ctx, types.NewParams([]string{"stake", "token"}, nil, 0, types.RakeToFeeCollector, 0, types.DefaultBetCutoffMove))
+	wager := sdk.NewCoins(sdk.NewInt64Coin("token", 100), sdk.NewInt64Coin("stake", 5))
+	_, err := msgServer.CreateGame(context, &types.MsgCreateGame{
+		Creator: alice,
+		Black:   bob,
+		Red:     carol,
+		Wager:   wager,
+	})
+	require.Nil(t, err)
+
+	payBob := escrow.ExpectPayCoins(context, bob, wager)
+	escrow.ExpectPayCoins(context, carol, wager).After(payBob)
+	acceptGame(t, msgServer, context, "2", bob, carol)
+	game, _ := keeper.GetStoredGame(ctx, "2")
+	require.EqualValues(t, sdk.NewCoins(sdk.NewInt64Coin("token", 200), sdk.NewInt64Coin("stake", 10)), game.Escrowed)
+	event := findEvent(t, ctx, types.GameAcceptedEventType)
+	require.EqualValues(t, sdk.Attribute{Key: "escrowed", Value: "10stake,200token"}, event.Attributes[5])
+}
@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/satya/checkers/x/checkers/types"
+)
+
+func (k msgServer) AcceptMatch(goCtx context.Context, msg *types.MsgAcceptMatch) (*types.MsgAcceptMatchResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	match, found := k.Keeper.GetMatch(ctx, msg.MatchIndex)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrMatchNotFound, "%s", msg.MatchIndex)
+	}
+	if match.IsOver() {
+		return nil, types.ErrMatchFinished
+	}
+	if !match.IsPlayer(msg.Creator) {
+		return nil, sdkerrors.Wrapf(types.ErrCreatorNotPlayer, "%s", msg.Creator)
+	}
+	if match.IsFunded() {
+		return nil, sdkerrors.Wrapf(types.ErrAlreadyAccepted, "%s", msg.Creator)
+	}
+
+	err := k.Keeper.AcceptMatchWager(ctx, &match, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+	var gameIndex string
+	if match.IsFunded() {
+		systemInfo, found := k.Keeper.GetSystemInfo(ctx)
+		if !found {
+			panic("SystemInfo not found")
+		}
+		gameIndex = k.Keeper.startMatchGame(ctx, &match, &systemInfo).Index
+		k.Keeper.SetSystemInfo(ctx, systemInfo)
+	}
+	k.Keeper.SetMatch(ctx, match)
+
+	ctx.GasMeter().ConsumeGas(types.AcceptMatchGas, "Accept match")
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.MatchAcceptedEventType,
+			sdk.NewAttribute(types.MatchAcceptedEventPlayer, msg.Creator),
+			sdk.NewAttribute(types.MatchAcceptedEventMatchIndex, msg.MatchIndex),
+			sdk.NewAttribute(types.MatchAcceptedEventEscrowed, match.Escrowed.String()),
+		),
+	)
+
+	return &types.MsgAcceptMatchResponse{
+		Escrowed:  match.Escrowed,
+		GameIndex: gameIndex,
+	}, nil
+}
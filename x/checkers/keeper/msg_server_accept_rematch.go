@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/satya/checkers/x/checkers/types"
+)
+
+func (k msgServer) AcceptRematch(goCtx context.Context, msg *types.MsgAcceptRematch) (*types.MsgAcceptRematchResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	storedGame, err := k.Keeper.getRematchGame(ctx, msg.GameIndex, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+	if storedGame.RematchProposer == "" {
+		return nil, sdkerrors.Wrapf(types.ErrNoRematchOffer, "%s", msg.GameIndex)
+	}
+	// When playing against oneself, one's own offer can be accepted.
+	if storedGame.RematchProposer == msg.Creator && storedGame.Black != storedGame.Red {
+		return nil, sdkerrors.Wrapf(types.ErrRematchNotAllowed, "%s", "the opponent must accept")
+	}
+
+	rematch, err := k.Keeper.createRematch(ctx, &storedGame, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.GasMeter().ConsumeGas(types.AcceptRematchGas, "Accept rematch")
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.RematchAcceptedEventType,
+			sdk.NewAttribute(types.RematchAcceptedEventPlayer, msg.Creator),
+			sdk.NewAttribute(types.RematchAcceptedEventGameIndex, msg.GameIndex),
+			sdk.NewAttribute(types.RematchAcceptedEventRematchIndex, rematch.Index),
+		),
+	)
+
+	return &types.MsgAcceptRematchResponse{
+		GameIndex: rematch.Index,
+	}, nil
+}
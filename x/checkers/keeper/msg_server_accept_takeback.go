@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/satya/checkers/x/checkers/types"
+)
+
+func (k msgServer) AcceptTakeback(goCtx context.Context, msg *types.MsgAcceptTakeback) (*types.MsgAcceptTakebackResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	storedGame, err := k.Keeper.getTakebackGame(ctx, msg.GameIndex)
+	if err != nil {
+		return nil, err
+	}
+	if storedGame.TakebackRequester == "" {
+		return nil, sdkerrors.Wrapf(types.ErrNoTakebackRequest, "%s", msg.GameIndex)
+	}
+	toPlay, found, err := storedGame.GetPlayerAddress(storedGame.Turn)
+	if err != nil {
+		panic(err.Error())
+	}
+	if !found || toPlay.String() != msg.Creator {
+		return nil, sdkerrors.Wrapf(types.ErrCreatorNotPlayer, "%s", msg.Creator)
+	}
+	player, err := getLastMover(storedGame, storedGame.TakebackRequester)
+	if err != nil {
+		panic(err.Error())
+	}
+	undone, err := storedGame.TakeBack(player)
+	if err != nil {
+		return nil, err
+	}
+	// The requester has the turn again, so their premove no longer applies.
+	if premove, found := k.Keeper.GetPremove(ctx, msg.GameIndex); found {
+		k.Keeper.ClearPremove(ctx, &storedGame, premove)
+	}
+	k.Keeper.resetDeadline(ctx, &storedGame)
+
+	ctx.GasMeter().ConsumeGas(types.TakebackGas, "Accept takeback")
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.TakebackAcceptedEventType,
+			sdk.NewAttribute(types.TakebackAcceptedEventPlayer, msg.Creator),
+			sdk.NewAttribute(types.TakebackAcceptedEventGameIndex, msg.GameIndex),
+			sdk.NewAttribute(types.TakebackAcceptedEventBoard, storedGame.Board),
+			sdk.NewAttribute(types.TakebackAcceptedEventUndone, strconv.Itoa(undone)),
+		),
+	)
+
+	return &types.MsgAcceptTakebackResponse{
+		Undone: uint64(undone),
+	}, nil
+}
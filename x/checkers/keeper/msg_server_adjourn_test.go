@@ -0,0 +1,178 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+	keepertest "github.com/satya/checkers/testutil/keeper"
+	"github.com/satya/checkers/x/checkers"
+	"github.com/satya/checkers/x/checkers/keeper"
+	"github.com/satya/checkers/x/checkers/testutil"
+	"github.com/satya/checkers/x/checkers/types"
+	"github.com/stretchr/testify/require"
+)
+
+func setupMsgServerWithOneGameForAdjourn(t *testing.T) (types.MsgServer, keeper.Keeper, context.Context,
+	*gomock.Controller, *testutil.MockBankEscrowKeeper) {
+	ctrl := gomock.NewController(t)
+	bankMock := testutil.NewMockBankEscrowKeeper(ctrl)
+	k, ctx := keepertest.CheckersKeeperWithMocks(t, bankMock, nil)
+	checkers.InitGenesis(ctx, *k, *types.DefaultGenesis())
+	server := keeper.NewMsgServerImpl(*k)
+	context := sdk.WrapSDKContext(ctx)
+	bankMock.ExpectAny(context)
+	_, err := server.CreateGame(context, &types.MsgCreateGame{
+		Creator: alice,
+		Black:   bob,
+		Red:     carol,
+		Wager:   sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+	})
+	require.Nil(t, err)
+	acceptGame(t, server, context, "1", bob, carol)
+	playNotation(t, server, context, bob, "11-15")
+	return server, *k, context, ctrl, bankMock
+}
+
+func adjournGame(t *testing.T, msgServer types.MsgServer, context context.Context) {
+	_, err := msgServer.ProposeAdjourn(context, types.NewMsgProposeAdjourn(carol, "1"))
+	require.Nil(t, err)
+	_, err = msgServer.AcceptAdjourn(context, types.NewMsgAcceptAdjourn(bob, "1"))
+	require.Nil(t, err)
+}
+
+func TestAdjournNeedsOpponent(t *testing.T) {
+	msgServer, keeper, context, ctrl, _ := setupMsgServerWithOneGameForAdjourn(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+
+	_, err := msgServer.AcceptAdjourn(context, types.NewMsgAcceptAdjourn(bob, "1"))
+	require.ErrorIs(t, err, types.ErrNoAdjournProposal)
+	_, err = msgServer.ProposeAdjourn(context, types.NewMsgProposeAdjourn(alice, "1"))
+	require.ErrorIs(t, err, types.ErrCreatorNotPlayer)
+	_, err = msgServer.ProposeAdjourn(context, types.NewMsgProposeAdjourn(carol, "1"))
+	require.Nil(t, err)
+	game, _ := keeper.GetStoredGame(ctx, "1")
+	require.EqualValues(t, carol, game.AdjournProposer)
+	_, err = msgServer.AcceptAdjourn(context, types.NewMsgAcceptAdjourn(carol, "1"))
+	require.EqualError(t, err, "the opponent must accept: adjournment is not allowed: %s")
+}
+
+func TestAdjournProposalClearedByMove(t *testing.T) {
+	msgServer, keeper, context, ctrl, _ := setupMsgServerWithOneGameForAdjourn(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+
+	_, err := msgServer.ProposeAdjourn(context, types.NewMsgProposeAdjourn(bob, "1"))
+	require.Nil(t, err)
+	playNotation(t, msgServer, context, carol, "22-18")
+	game, _ := keeper.GetStoredGame(ctx, "1")
+	require.EqualValues(t, "", game.AdjournProposer)
+	_, err = msgServer.AcceptAdjourn(context, types.NewMsgAcceptAdjourn(carol, "1"))
+	require.ErrorIs(t, err, types.ErrNoAdjournProposal)
+}
+
+func TestAdjournTakesGameOffTheClock(t *testing.T) {
+	msgServer, keeper, context, ctrl, _ := setupMsgServerWithOneGameForAdjourn(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+
+	_, err := msgServer.ProposeAdjourn(context, types.NewMsgProposeAdjourn(carol, "1"))
+	require.Nil(t, err)
+	response, err := msgServer.AcceptAdjourn(context, types.NewMsgAcceptAdjourn(bob, "1"))
+	require.Nil(t, err)
+	until := types.FormatDeadline(ctx.BlockTime().Add(types.MaxAdjournDuration))
+	require.EqualValues(t, types.MsgAcceptAdjournResponse{AdjournedUntil: until}, *response)
+	game, _ := keeper.GetStoredGame(ctx, "1")
+	require.EqualValues(t, "", game.AdjournProposer)
+	require.EqualValues(t, until, game.AdjournedUntil)
+	systemInfo, _ := keeper.GetSystemInfo(ctx)
+	require.EqualValues(t, types.NoFifoIndex, systemInfo.FifoHeadIndex)
+	require.EqualValues(t, types.NoFifoIndex, systemInfo.FifoTailIndex)
+	require.Len(t, keeper.GetAllAdjournedStoredGame(ctx), 1)
+
+	_, err = msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   carol,
+		GameIndex: "1",
+		FromX:     0,
+		FromY:     5,
+		ToX:       1,
+		ToY:       4,
+	})
+	require.ErrorIs(t, err, types.ErrGameAdjourned)
+	_, err = msgServer.ProposeAdjourn(context, types.NewMsgProposeAdjourn(carol, "1"))
+	require.ErrorIs(t, err, types.ErrGameAdjourned)
+
+	keeper.ForfeitExpiredGames(sdk.WrapSDKContext(ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))))
+	game, _ = keeper.GetStoredGame(ctx, "1")
+	require.EqualValues(t, "*", game.Winner)
+}
+
+func TestResumeGameGivesFreshDeadline(t *testing.T) {
+	msgServer, keeper, context, ctrl, _ := setupMsgServerWithOneGameForAdjourn(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	_, err := msgServer.ResumeGame(context, types.NewMsgResumeGame(carol, "1"))
+	require.ErrorIs(t, err, types.ErrGameNotAdjourned)
+	adjournGame(t, msgServer, context)
+
+	later := ctx.WithBlockTime(ctx.BlockTime().Add(24 * time.Hour))
+	response, err := msgServer.ResumeGame(sdk.WrapSDKContext(later), types.NewMsgResumeGame(bob, "1"))
+	require.Nil(t, err)
+	deadline := types.FormatDeadline(later.BlockTime().Add(types.MaxTurnDuration))
+	require.EqualValues(t, types.MsgResumeGameResponse{Deadline: deadline}, *response)
+	game, _ := keeper.GetStoredGame(ctx, "1")
+	require.EqualValues(t, "", game.AdjournedUntil)
+	require.EqualValues(t, deadline, game.Deadline)
+	systemInfo, _ := keeper.GetSystemInfo(ctx)
+	require.EqualValues(t, "1", systemInfo.FifoHeadIndex)
+	require.Empty(t, keeper.GetAllAdjournedStoredGame(ctx))
+	playNotation(t, msgServer, context, carol, "22-18")
+}
+
+func TestAdjournExpiredBeforeSecondMoverIsRefunded(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithOneGameForAdjourn(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	adjournGame(t, msgServer, context)
+
+	before := sdk.WrapSDKContext(ctx.WithBlockTime(ctx.BlockTime().Add(types.MaxAdjournDuration - time.Second)))
+	keeper.EndExpiredAdjournments(before)
+	_, found := keeper.GetStoredGame(ctx, "1")
+	require.True(t, found)
+
+	expired := sdk.WrapSDKContext(ctx.WithBlockTime(ctx.BlockTime().Add(types.MaxAdjournDuration)))
+	escrow.ExpectRefund(expired, bob, 45)
+	escrow.ExpectRefund(expired, carol, 45)
+	keeper.EndExpiredAdjournments(expired)
+	_, found = keeper.GetStoredGame(ctx, "1")
+	require.False(t, found)
+	require.Empty(t, keeper.GetAllAdjournedStoredGame(ctx))
+}
+
+func TestAdjournExpiredIsDrawn(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithOneGameForAdjourn(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	playNotation(t, msgServer, context, carol, "22-18")
+	adjournGame(t, msgServer, context)
+
+	expired := sdk.WrapSDKContext(ctx.WithBlockTime(ctx.BlockTime().Add(types.MaxAdjournDuration)))
+	escrow.ExpectRefund(expired, bob, 45)
+	escrow.ExpectRefund(expired, carol, 45)
+	keeper.EndExpiredAdjournments(expired)
+	game, found := keeper.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	require.EqualValues(t, types.DrawWinner, game.Winner)
+	require.EqualValues(t, "", game.Board)
+	require.EqualValues(t, "", game.AdjournedUntil)
+	require.Empty(t, keeper.GetAllAdjournedStoredGame(ctx))
+	event := findEvent(t, sdk.UnwrapSDKContext(expired), types.AdjournExpiredEventType)
+	require.EqualValues(t, []sdk.Attribute{
+		{Key: "game-index", Value: "1"},
+		{Key: "winner", Value: "="},
+		{Key: "board", Value: "*b*b*b*b|b*b*b*b*|*b*b***b|****b***|***r****|r***r*r*|*r*r*r*r|r*r*r*r*"},
+	}, event.Attributes)
+}
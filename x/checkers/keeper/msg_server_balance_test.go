@@ -0,0 +1,112 @@
+package keeper_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+	"github.com/satya/checkers/x/checkers/keeper"
+	"github.com/satya/checkers/x/checkers/testutil"
+	"github.com/satya/checkers/x/checkers/types"
+	"github.com/stretchr/testify/require"
+)
+
+func setupMsgServerForBalance(t testing.TB) (types.MsgServer, keeper.Keeper, context.Context,
+	*gomock.Controller, *testutil.MockBankEscrowKeeper) {
+	k, context, ctrl, escrow := setupKeeperForWagerHandler(t)
+	return keeper.NewMsgServerImpl(k), k, context, ctrl, escrow
+}
+
+func TestDeposit(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerForBalance(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	escrow.ExpectPay(context, alice, 100).Times(1)
+	escrow.ExpectPay(context, alice, 20).Times(1)
+	msgServer.Deposit(context, types.NewMsgDeposit(alice, sdk.NewCoins(sdk.NewInt64Coin("stake", 100))))
+	response, err := msgServer.Deposit(context, types.NewMsgDeposit(alice, sdk.NewCoins(sdk.NewInt64Coin("stake", 20))))
+	require.Nil(t, err)
+	require.EqualValues(t, types.MsgDepositResponse{
+		Balance: sdk.NewCoins(sdk.NewInt64Coin("stake", 120)),
+	}, *response)
+	playerBalance, found := keeper.GetPlayerBalance(ctx, alice)
+	require.True(t, found)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 120)), playerBalance.Funds)
+	event := findEvent(t, ctx, "balance-deposited")
+	require.EqualValues(t, []sdk.Attribute{
+		{Key: "player", Value: alice},
+		{Key: "amount", Value: "100stake"},
+		{Key: "balance", Value: "100stake"},
+		{Key: "player", Value: alice},
+		{Key: "amount", Value: "20stake"},
+		{Key: "balance", Value: "120stake"},
+	}, event.Attributes)
+}
+
+func TestDepositDenomNotAllowed(t *testing.T) {
+	msgServer, _, context, ctrl, _ := setupMsgServerForBalance(t)
+	defer ctrl.Finish()
+	response, err := msgServer.Deposit(context, types.NewMsgDeposit(alice, sdk.NewCoins(sdk.NewInt64Coin("gold", 100))))
+	require.Nil(t, response)
+	require.ErrorIs(t, err, types.ErrDenomNotAllowed)
+}
+
+func TestDepositCannotPay(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerForBalance(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	escrow.ExpectPay(context, alice, 100).Return(errors.New("oops"))
+	response, err := msgServer.Deposit(context, types.NewMsgDeposit(alice, sdk.NewCoins(sdk.NewInt64Coin("stake", 100))))
+	require.Nil(t, response)
+	require.EqualError(t, err, "depositor cannot pay the deposit: oops")
+	_, found := keeper.GetPlayerBalance(ctx, alice)
+	require.False(t, found)
+}
+
+func TestWithdraw(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerForBalance(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	keeper.CreditPlayerBalance(ctx, alice, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)))
+	escrow.ExpectRefund(context, alice, 30).Times(1)
+	response, err := msgServer.Withdraw(context, types.NewMsgWithdraw(alice, sdk.NewCoins(sdk.NewInt64Coin("stake", 30))))
+	require.Nil(t, err)
+	require.EqualValues(t, types.MsgWithdrawResponse{
+		Balance: sdk.NewCoins(sdk.NewInt64Coin("stake", 70)),
+	}, *response)
+	playerBalance, _ := keeper.GetPlayerBalance(ctx, alice)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 70)), playerBalance.Funds)
+	event := findEvent(t, ctx, "balance-withdrawn")
+	require.EqualValues(t, []sdk.Attribute{
+		{Key: "player", Value: alice},
+		{Key: "amount", Value: "30stake"},
+		{Key: "balance", Value: "70stake"},
+	}, event.Attributes)
+}
+
+func TestWithdrawEverythingClosesBalance(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerForBalance(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	keeper.CreditPlayerBalance(ctx, alice, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)))
+	escrow.ExpectRefund(context, alice, 100).Times(1)
+	response, err := msgServer.Withdraw(context, types.NewMsgWithdraw(alice, sdk.NewCoins(sdk.NewInt64Coin("stake", 100))))
+	require.Nil(t, err)
+	require.True(t, response.Balance.IsZero())
+	_, found := keeper.GetPlayerBalance(ctx, alice)
+	require.False(t, found)
+}
+
+func TestWithdrawTooMuch(t *testing.T) {
+	msgServer, keeper, context, ctrl, _ := setupMsgServerForBalance(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	keeper.CreditPlayerBalance(ctx, alice, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)))
+	response, err := msgServer.Withdraw(context, types.NewMsgWithdraw(alice, sdk.NewCoins(sdk.NewInt64Coin("stake", 101))))
+	require.Nil(t, response)
+	require.EqualError(t, err, "100stake: internal balance is too low: %s")
+	playerBalance, _ := keeper.GetPlayerBalance(ctx, alice)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), playerBalance.Funds)
+}
@@ -0,0 +1,193 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+	keepertest "github.com/satya/checkers/testutil/keeper"
+	"github.com/satya/checkers/x/checkers"
+	"github.com/satya/checkers/x/checkers/keeper"
+	"github.com/satya/checkers/x/checkers/testutil"
+	"github.com/satya/checkers/x/checkers/types"
+	"github.com/stretchr/testify/require"
+)
+
+func setupMsgServerWithColourDraw(t testing.TB) (types.MsgServer, keeper.Keeper, context.Context,
+	*gomock.Controller, *testutil.MockCheckersLeaderboardKeeper) {
+	ctrl := gomock.NewController(t)
+	bankMock := testutil.NewMockBankEscrowKeeper(ctrl)
+	leaderboardMock := testutil.NewMockCheckersLeaderboardKeeper(ctrl)
+	k, ctx := keepertest.CheckersKeeperWithMocks(t, bankMock, leaderboardMock)
+	checkers.InitGenesis(ctx, *k, *types.DefaultGenesis())
+	server := keeper.NewMsgServerImpl(*k)
+	context := sdk.WrapSDKContext(ctx)
+	bankMock.ExpectAny(context)
+	_, err := server.CreateGame(context, &types.MsgCreateGame{
+		Creator:    alice,
+		Black:      bob,
+		Red:        carol,
+		Wager:      sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+		ColourDraw: true,
+	})
+	require.Nil(t, err)
+	return server, *k, context, ctrl, leaderboardMock
+}
+
+func commitBoth(t *testing.T, msgServer types.MsgServer, context context.Context, bobSecret string, carolSecret string) {
+	_, err := msgServer.CommitColour(context, types.NewMsgCommitColour(bob, "1", types.ColourCommitment(bob, bobSecret)))
+	require.Nil(t, err)
+	_, err = msgServer.CommitColour(context, types.NewMsgCommitColour(carol, "1", types.ColourCommitment(carol, carolSecret)))
+	require.Nil(t, err)
+}
+
+func TestColourDrawCreatesPendingDraw(t *testing.T) {
+	msgServer, keeper, context, ctrl, _ := setupMsgServerWithColourDraw(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+
+	draw, found := keeper.GetColourDraw(ctx, "1")
+	require.True(t, found)
+	require.EqualValues(t, types.NewColourDraw("1", bob, carol), draw)
+
+	_, err := msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   bob,
+		GameIndex: "1",
+		FromX:     1,
+		FromY:     2,
+		ToX:       2,
+		ToY:       3,
+	})
+	require.ErrorIs(t, err, types.ErrColourDrawPending)
+}
+
+func TestColourDrawSwapsColours(t *testing.T) {
+	msgServer, keeper, context, ctrl, _ := setupMsgServerWithColourDraw(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	commitBoth(t, msgServer, context, "alpha", "delta")
+
+	response, err := msgServer.RevealColour(context, types.NewMsgRevealColour(bob, "1", "alpha"))
+	require.Nil(t, err)
+	require.EqualValues(t, types.MsgRevealColourResponse{}, *response)
+	response, err = msgServer.RevealColour(context, types.NewMsgRevealColour(carol, "1", "delta"))
+	require.Nil(t, err)
+	require.EqualValues(t, types.MsgRevealColourResponse{Black: carol}, *response)
+
+	_, found := keeper.GetColourDraw(ctx, "1")
+	require.False(t, found)
+	game, found := keeper.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	require.Equal(t, carol, game.Black)
+	require.Equal(t, bob, game.Red)
+
+	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
+	require.EqualValues(t, sdk.StringEvent{
+		Type: "colours-drawn",
+		Attributes: []sdk.Attribute{
+			{Key: "game-index", Value: "1"},
+			{Key: "black", Value: carol},
+			{Key: "red", Value: bob},
+		},
+	}, events[2])
+
+	acceptGame(t, msgServer, context, "1", bob, carol)
+	_, err = msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   carol,
+		GameIndex: "1",
+		FromX:     1,
+		FromY:     2,
+		ToX:       2,
+		ToY:       3,
+	})
+	require.Nil(t, err)
+}
+
+func TestColourDrawKeepsColours(t *testing.T) {
+	msgServer, keeper, context, ctrl, _ := setupMsgServerWithColourDraw(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	commitBoth(t, msgServer, context, "alpha", "epsilon")
+	_, err := msgServer.RevealColour(context, types.NewMsgRevealColour(carol, "1", "epsilon"))
+	require.Nil(t, err)
+	response, err := msgServer.RevealColour(context, types.NewMsgRevealColour(bob, "1", "alpha"))
+	require.Nil(t, err)
+	require.Equal(t, bob, response.Black)
+
+	game, _ := keeper.GetStoredGame(ctx, "1")
+	require.Equal(t, bob, game.Black)
+	require.Equal(t, carol, game.Red)
+}
+
+func TestColourDrawWrongSecret(t *testing.T) {
+	msgServer, _, context, ctrl, _ := setupMsgServerWithColourDraw(t)
+	defer ctrl.Finish()
+	commitBoth(t, msgServer, context, "alpha", "delta")
+
+	_, err := msgServer.RevealColour(context, types.NewMsgRevealColour(bob, "1", "beta"))
+	require.ErrorIs(t, err, types.ErrSecretMismatch)
+}
+
+func TestColourDrawRevealBeforeCommits(t *testing.T) {
+	msgServer, _, context, ctrl, _ := setupMsgServerWithColourDraw(t)
+	defer ctrl.Finish()
+	_, err := msgServer.CommitColour(context, types.NewMsgCommitColour(bob, "1", types.ColourCommitment(bob, "alpha")))
+	require.Nil(t, err)
+
+	_, err = msgServer.RevealColour(context, types.NewMsgRevealColour(bob, "1", "alpha"))
+	require.ErrorIs(t, err, types.ErrNotAllCommitted)
+}
+
+func TestColourDrawNotFound(t *testing.T) {
+	msgServer, _, context, ctrl, _ := setupMsgServerWithColourDraw(t)
+	defer ctrl.Finish()
+
+	_, err := msgServer.CommitColour(context, types.NewMsgCommitColour(bob, "2", types.ColourCommitment(bob, "alpha")))
+	require.EqualError(t, err, "2: colour draw by id not found")
+}
+
+func expireGame(t *testing.T, keeper keeper.Keeper, ctx sdk.Context) {
+	game, found := keeper.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	game.Deadline = types.FormatDeadline(ctx.BlockTime().Add(time.Duration(-1)))
+	keeper.SetStoredGame(ctx, game)
+}
+
+func TestColourDrawTimeoutForfeitsNonRevealer(t *testing.T) {
+	msgServer, keeper, context, ctrl, leaderboard := setupMsgServerWithColourDraw(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	commitBoth(t, msgServer, context, "alpha", "delta")
+	_, err := msgServer.RevealColour(context, types.NewMsgRevealColour(bob, "1", "alpha"))
+	require.Nil(t, err)
+	leaderboard.Expectwin(context, bob).Times(1)
+	leaderboard.ExpectForfeit(context, carol).Times(1)
+
+	expireGame(t, keeper, ctx)
+	keeper.ForfeitExpiredGames(context)
+
+	_, found := keeper.GetColourDraw(ctx, "1")
+	require.False(t, found)
+	game, found := keeper.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	require.Equal(t, bob, game.Black)
+	require.Equal(t, carol, game.Red)
+	require.Equal(t, "b", game.Winner)
+	require.Equal(t, "", game.Board)
+}
+
+func TestColourDrawTimeoutDropsGameWithoutCommits(t *testing.T) {
+	_, keeper, context, ctrl, _ := setupMsgServerWithColourDraw(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+
+	expireGame(t, keeper, ctx)
+	keeper.ForfeitExpiredGames(context)
+
+	_, found := keeper.GetColourDraw(ctx, "1")
+	require.False(t, found)
+	_, found = keeper.GetStoredGame(ctx, "1")
+	require.False(t, found)
+}
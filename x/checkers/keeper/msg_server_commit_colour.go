@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/satya/checkers/x/checkers/types"
+)
+
+func (k msgServer) CommitColour(goCtx context.Context, msg *types.MsgCommitColour) (*types.MsgCommitColourResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	draw, found := k.Keeper.GetColourDraw(ctx, msg.GameIndex)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrColourDrawNotFound, "%s", msg.GameIndex)
+	}
+	err := draw.Commit(msg.Creator, msg.Commitment)
+	if err != nil {
+		return nil, err
+	}
+	k.Keeper.SetColourDraw(ctx, draw)
+
+	if draw.IsCommitted() {
+		// The reveal phase gets its own deadline.
+		storedGame, found := k.Keeper.GetStoredGame(ctx, msg.GameIndex)
+		if !found {
+			panic("Game of colour draw not found " + msg.GameIndex)
+		}
+		k.Keeper.resetDeadline(ctx, &storedGame)
+	}
+
+	ctx.GasMeter().ConsumeGas(types.ColourDrawGas, "Commit colour")
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.ColourCommittedEventType,
+			sdk.NewAttribute(types.ColourCommittedEventPlayer, msg.Creator),
+			sdk.NewAttribute(types.ColourCommittedEventGameIndex, msg.GameIndex),
+		),
+	)
+
+	return &types.MsgCommitColourResponse{}, nil
+}
@@ -18,28 +18,99 @@ func (k msgServer) CreateGame(goCtx context.Context, msg *types.MsgCreateGame) (
 	}
 	newIndex := strconv.FormatUint(systemInfo.NextId, 10)
 
-	newGame := rules.New()
+	black, red := msg.Black, msg.Red
+	if msg.House == rules.PieceStrings[rules.BLACK_PLAYER] {
+		black = types.GetHouseAddress().String()
+	} else if msg.House == rules.PieceStrings[rules.RED_PLAYER] {
+		red = types.GetHouseAddress().String()
+	}
+
+	newGame, err := msg.GetStartGame()
+	if err != nil {
+		return nil, err
+	}
+	var ballot string
+	if msg.Ballot {
+		ballot = k.Keeper.DrawBallot(ctx, newIndex)
+		newGame, err = rules.PlayBallot(ballot)
+		if err != nil {
+			panic(err.Error())
+		}
+	}
+	var startBoard, startTurn string
+	if !newGame.IsInitial() {
+		startBoard = newGame.String()
+		startTurn = rules.PieceStrings[newGame.Turn]
+	}
 	storedGame := types.StoredGame{
 		Index:       newIndex,
 		Board:       newGame.String(),
 		Turn:        rules.PieceStrings[newGame.Turn],
-		Black:       msg.Black,
-		Red:         msg.Red,
+		Black:       black,
+		Red:         red,
 		Winner:      rules.PieceStrings[rules.NO_PLAYER],
 		Deadline:    types.FormatDeadline(types.GetNextDeadline(ctx)),
 		MoveCount:   0,
 		BeforeIndex: types.NoFifoIndex,
 		AfterIndex:  types.NoFifoIndex,
 		Wager:       msg.Wager,
-		Denom:       msg.Denom,
+		House:       msg.House,
+		StartBoard:  startBoard,
+		StartTurn:   startTurn,
+		Unrated:     startBoard != "" && ballot == "",
+		Ballot:      ballot,
+	}
+	if !storedGame.Wager.IsZero() && msg.Creator != black && msg.Creator != red {
+		storedGame.Organizer = msg.Creator
+	}
+	if !msg.Prize.IsZero() {
+		storedGame.Sponsor = msg.Creator
+		storedGame.Prize = msg.Prize
 	}
 
-	err := storedGame.Validate()
+	err = storedGame.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.Keeper.ValidateWagerDenoms(ctx, storedGame.Wager)
+	if err != nil {
+		return nil, err
+	}
+	err = k.Keeper.ValidateWagerDenoms(ctx, storedGame.Prize)
+	if err != nil {
+		return nil, err
+	}
+
+	if storedGame.House != "" {
+		houseBankroll, _ := k.Keeper.GetHouseBankroll(ctx)
+		if !houseBankroll.Funds.IsAllGTE(storedGame.Wager) {
+			return nil, types.ErrHouseCannotPay
+		}
+	}
+
+	if !storedGame.Wager.IsZero() {
+		if storedGame.House != "" {
+			storedGame.AcceptedBy = types.GetHouseAddress().String()
+		}
+		if msg.Creator == black || msg.Creator == red {
+			err = k.Keeper.AcceptWager(ctx, &storedGame, msg.Creator)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	err = k.Keeper.EscrowPrize(ctx, &storedGame)
 	if err != nil {
 		return nil, err
 	}
 
 	k.Keeper.SendToFifoTail(ctx, &storedGame, &systemInfo)
+	if msg.ColourDraw {
+		k.Keeper.SetColourDraw(ctx, types.NewColourDraw(newIndex, black, red))
+	}
+	k.Keeper.FreezeIfOnVacation(ctx, &storedGame, &systemInfo)
 	k.Keeper.SetStoredGame(ctx, storedGame)
 	systemInfo.NextId++
 	k.Keeper.SetSystemInfo(ctx, systemInfo)
@@ -50,13 +121,21 @@ func (k msgServer) CreateGame(goCtx context.Context, msg *types.MsgCreateGame) (
 		sdk.NewEvent(types.GameCreatedEventType,
 			sdk.NewAttribute(types.GameCreatedEventCreator, msg.Creator),
 			sdk.NewAttribute(types.GameCreatedEventGameIndex, newIndex),
-			sdk.NewAttribute(types.GameCreatedEventBlack, msg.Black),
-			sdk.NewAttribute(types.GameCreatedEventRed, msg.Red),
-			sdk.NewAttribute(types.GameCreatedEventWager, strconv.FormatUint(msg.Wager, 10)),
-			sdk.NewAttribute(types.GameCreatedEventDenom, msg.Denom),
+			sdk.NewAttribute(types.GameCreatedEventBlack, black),
+			sdk.NewAttribute(types.GameCreatedEventRed, red),
+			sdk.NewAttribute(types.GameCreatedEventWager, msg.Wager.String()),
+			sdk.NewAttribute(types.GameCreatedEventWagerTraced, FormatTracedCoins(k.Keeper.GetTracedCoins(ctx, msg.Wager))),
+			sdk.NewAttribute(types.GameCreatedEventPrize, msg.Prize.String()),
 		),
 	)
 
+	if storedGame.IsFunded() {
+		err = k.Keeper.PlayHouseMoves(ctx, &storedGame, newGame)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return &types.MsgCreateGameResponse{
 		GameIndex: newIndex,
 	}, nil
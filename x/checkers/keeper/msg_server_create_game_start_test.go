@@ -0,0 +1,147 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+	keepertest "github.com/satya/checkers/testutil/keeper"
+	"github.com/satya/checkers/x/checkers/rules"
+	"github.com/satya/checkers/x/checkers/types"
+	"github.com/stretchr/testify/require"
+)
+
+// Red to move, with a double jump from 23 to 5.
+const redDoubleJumpBoard = "********|********|*b******|********|***b****|****r***|********|B*******"
+
+func TestCreateGameFromCustomStart(t *testing.T) {
+	msgServer, keeper, context := setupMsgServerCreateGame(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	_, err := msgServer.CreateGame(context, &types.MsgCreateGame{
+		Creator: alice,
+		Black:   bob,
+		Red:     carol,
+		Wager:   sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+		Board:   redDoubleJumpBoard,
+		Turn:    "r",
+	})
+	require.Nil(t, err)
+	game, found := keeper.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	require.Equal(t, redDoubleJumpBoard, game.Board)
+	require.Equal(t, "r", game.Turn)
+	require.Equal(t, redDoubleJumpBoard, game.StartBoard)
+	require.Equal(t, "r", game.StartTurn)
+	require.True(t, game.Unrated)
+}
+
+func TestCreateGameStandardStartIsRated(t *testing.T) {
+	msgServer, keeper, context := setupMsgServerCreateGame(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	_, err := msgServer.CreateGame(context, &types.MsgCreateGame{
+		Creator: alice,
+		Black:   bob,
+		Red:     carol,
+		Wager:   sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+		Board:   "*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
+		Turn:    "b",
+	})
+	require.Nil(t, err)
+	game, _ := keeper.GetStoredGame(ctx, "1")
+	require.Equal(t, "", game.StartBoard)
+	require.False(t, game.Unrated)
+}
+
+func TestCreateGameFromInvalidStart(t *testing.T) {
+	msgServer, _, context := setupMsgServerCreateGame(t)
+	_, err := msgServer.CreateGame(context, &types.MsgCreateGame{
+		Creator: alice,
+		Black:   bob,
+		Red:     carol,
+		Board:   "********|********|********|********|********|********|********|b*r*****",
+		Turn:    "b",
+	})
+	require.EqualError(t, err, "man on its promotion row: {0 7}: starting position is invalid: %s")
+}
+
+// The leaderboard mock expects no call, as the game is unrated.
+func TestCustomStartWagersEscrowedOnAccept(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerCreateGameWithMock(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	msgServer.CreateGame(context, &types.MsgCreateGame{
+		Creator: alice,
+		Black:   bob,
+		Red:     carol,
+		Wager:   sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+		Board:   redDoubleJumpBoard,
+		Turn:    "r",
+	})
+	gomock.InOrder(
+		escrow.ExpectPay(context, bob, 45).Times(1),
+		escrow.ExpectPay(context, carol, 45).Times(1),
+	)
+	acceptGame(t, msgServer, context, "1", carol, bob)
+	for _, move := range []*types.MsgPlayMove{
+		{Creator: carol, GameIndex: "1", FromX: 4, FromY: 5, ToX: 2, ToY: 3},
+		{Creator: carol, GameIndex: "1", FromX: 2, FromY: 3, ToX: 0, ToY: 1},
+		{Creator: bob, GameIndex: "1", FromX: 0, FromY: 7, ToX: 1, ToY: 6},
+	} {
+		_, err := msgServer.PlayMove(context, move)
+		require.Nil(t, err)
+	}
+	game, _ := keeper.GetStoredGame(ctx, "1")
+	require.Equal(t, []string{"23x14", "14x5", "29-25"}, game.MoveHistory)
+	require.True(t, game.HasSecondMoverPlayed())
+
+	pdn, err := game.ExportPDN()
+	require.Nil(t, err)
+	require.Contains(t, pdn, "[FEN \"W:W23:B9,18,K29\"]")
+	require.Contains(t, pdn, "1... 23x14x5 2. 29-25 *")
+
+	escrow.ExpectRefund(context, bob, 90).Times(1)
+	game.Deadline = types.FormatDeadline(ctx.BlockTime().Add(time.Duration(-1)))
+	keeper.SetStoredGame(ctx, game)
+	keeper.ForfeitExpiredGames(context)
+	game, _ = keeper.GetStoredGame(ctx, "1")
+	require.Equal(t, "b", game.Winner)
+}
+
+func TestCreateGameFromBallot(t *testing.T) {
+	msgServer, keeper, context := setupMsgServerCreateGame(t)
+	ctx := sdk.UnwrapSDKContext(context).WithHeaderHash([]byte("block"))
+	_, err := msgServer.CreateGame(sdk.WrapSDKContext(ctx), &types.MsgCreateGame{
+		Creator: alice,
+		Black:   bob,
+		Red:     carol,
+		Wager:   sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+		Ballot:  true,
+	})
+	require.Nil(t, err)
+	game, found := keeper.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	ballot := keeper.DrawBallot(ctx, "1")
+	require.Equal(t, ballot, game.Ballot)
+	start, err := rules.PlayBallot(ballot)
+	require.Nil(t, err)
+	require.Equal(t, start.String(), game.Board)
+	require.Equal(t, start.String(), game.StartBoard)
+	require.Equal(t, rules.PieceStrings[start.Turn], game.Turn)
+	require.Equal(t, game.Turn, game.StartTurn)
+	require.False(t, game.Unrated)
+}
+
+func TestDrawBallotFollowsBlockAndGame(t *testing.T) {
+	keeper, ctx := keepertest.CheckersKeeper(t)
+	first := ctx.WithHeaderHash([]byte("first block"))
+	second := ctx.WithHeaderHash([]byte("second block"))
+	require.Equal(t, keeper.DrawBallot(first, "1"), keeper.DrawBallot(first, "1"))
+	drawn := map[string]bool{
+		keeper.DrawBallot(first, "1"):  true,
+		keeper.DrawBallot(first, "2"):  true,
+		keeper.DrawBallot(second, "1"): true,
+		keeper.DrawBallot(second, "2"): true,
+	}
+	require.Less(t, 1, len(drawn))
+}
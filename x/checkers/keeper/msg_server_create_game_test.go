@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -37,8 +38,7 @@ func TestCreateGame(t *testing.T) {
 		Creator: alice,
 		Black:   bob,
 		Red:     carol,
-		Wager:   45,
-		Denom:   "coin",
+		Wager:   sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
 	})
 	require.Nil(t, err)
 	require.EqualValues(t, types.MsgCreateGameResponse{
@@ -46,6 +46,20 @@ func TestCreateGame(t *testing.T) {
 	}, *createResponse)
 }
 
+func TestCreateGameDenomNotAllowed(t *testing.T) {
+	msgServer, keeper, context := setupMsgServerCreateGame(t)
+	createResponse, err := msgServer.CreateGame(context, &types.MsgCreateGame{
+		Creator: alice,
+		Black:   bob,
+		Red:     carol,
+		Wager:   sdk.NewCoins(sdk.NewInt64Coin("token", 100), sdk.NewInt64Coin("stake", 5)),
+	})
+	require.Nil(t, createResponse)
+	require.EqualError(t, err, "token: denom is not allowed for wagers: %s")
+	_, found := keeper.GetStoredGame(sdk.UnwrapSDKContext(context), "1")
+	require.False(t, found)
+}
+
 func TestCreate1GameHasSaved(t *testing.T) {
 	msgSrvr, keeper, context := setupMsgServerCreateGame(t)
 	ctx := sdk.UnwrapSDKContext(context)
@@ -53,8 +67,7 @@ func TestCreate1GameHasSaved(t *testing.T) {
 		Creator: alice,
 		Black:   bob,
 		Red:     carol,
-		Wager:   45,
-		Denom:   "stake",
+		Wager:   sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
 	})
 	systemInfo, found := keeper.GetSystemInfo(sdk.UnwrapSDKContext(context))
 	require.True(t, found)
@@ -62,6 +75,7 @@ func TestCreate1GameHasSaved(t *testing.T) {
 		NextId:        2,
 		FifoHeadIndex: "1",
 		FifoTailIndex: "1",
+		NextMatchId:   1,
 	}, systemInfo)
 	game1, found1 := keeper.GetStoredGame(sdk.UnwrapSDKContext(context), "1")
 	require.True(t, found1)
@@ -76,8 +90,8 @@ func TestCreate1GameHasSaved(t *testing.T) {
 		MoveCount:   0,
 		BeforeIndex: "-1",
 		AfterIndex:  "-1",
-		Wager:       45,
-		Denom:       "stake",
+		Wager:       sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+		Organizer:   alice,
 	}, game1)
 
 }
@@ -92,8 +106,7 @@ func TestCreate1GameEmitted(t *testing.T) {
 		Creator: alice,
 		Black:   bob,
 		Red:     carol,
-		Wager:   45,
-		Denom:   "stake",
+		Wager:   sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
 	})
 	require.NotNil(t, ctx)
 	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
@@ -106,8 +119,9 @@ func TestCreate1GameEmitted(t *testing.T) {
 			{Key: "game-index", Value: "1"},
 			{Key: "black", Value: bob},
 			{Key: "red", Value: carol},
-			{Key: "wager", Value: "45"},
-			{Key: "denom", Value: "stake"},
+			{Key: "wager", Value: "45stake"},
+			{Key: "wager-traced", Value: "45stake"},
+			{Key: "prize", Value: ""},
 		},
 	}, event)
 }
@@ -122,7 +136,7 @@ func TestCreate1GameConsumedGas(t *testing.T) {
 		Creator: alice,
 		Black:   bob,
 		Red:     carol,
-		Wager:   45,
+		Wager:   sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
 	})
 	after := ctx.GasMeter().GasConsumed()
 	require.GreaterOrEqual(t, after, before+25_000)
@@ -135,7 +149,7 @@ func TestSavedCreatedDeadlineIsParseable(t *testing.T) {
 		Creator: alice,
 		Black:   bob,
 		Red:     carol,
-		Wager:   45,
+		Wager:   sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
 	})
 	game, found := keeper.GetStoredGame(ctx, "1")
 	require.True(t, found)
@@ -150,19 +164,19 @@ func TestCreate3GamesHasSaved(t *testing.T) {
 		Creator: alice,
 		Black:   bob,
 		Red:     carol,
-		Wager:   45,
+		Wager:   sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
 	})
 	msgSrvr.CreateGame(context, &types.MsgCreateGame{
 		Creator: bob,
 		Black:   carol,
 		Red:     alice,
-		Wager:   45,
+		Wager:   sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
 	})
 	msgSrvr.CreateGame(context, &types.MsgCreateGame{
 		Creator: carol,
 		Black:   alice,
 		Red:     bob,
-		Wager:   45,
+		Wager:   sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
 	})
 
 	systemInfo, found := keeper.GetSystemInfo(ctx)
@@ -171,6 +185,7 @@ func TestCreate3GamesHasSaved(t *testing.T) {
 		NextId:        4,
 		FifoHeadIndex: "1",
 		FifoTailIndex: "3",
+		NextMatchId:   1,
 	}, systemInfo)
 	game1, found1 := keeper.GetStoredGame(ctx, "1")
 	require.True(t, found1)
@@ -185,7 +200,8 @@ func TestCreate3GamesHasSaved(t *testing.T) {
 		MoveCount:   0,
 		BeforeIndex: "-1",
 		AfterIndex:  "2",
-		Wager:       45,
+		Wager:       sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+		Organizer:   alice,
 	}, game1)
 
 	game2, found2 := keeper.GetStoredGame(ctx, "2")
@@ -201,7 +217,8 @@ func TestCreate3GamesHasSaved(t *testing.T) {
 		MoveCount:   0,
 		BeforeIndex: "1",
 		AfterIndex:  "3",
-		Wager:       45,
+		Wager:       sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+		Organizer:   bob,
 	}, game2)
 
 	game3, found3 := keeper.GetStoredGame(ctx, "3")
@@ -217,7 +234,83 @@ func TestCreate3GamesHasSaved(t *testing.T) {
 		MoveCount:   0,
 		BeforeIndex: "2",
 		AfterIndex:  "-1",
-		Wager:       45,
+		Wager:       sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+		Organizer:   carol,
 	}, game3)
 
 }
+
+func TestCreateGameNoOrganizerWhenPlayingOrUnwagered(t *testing.T) {
+	msgSrvr, keeper, context := setupMsgServerCreateGame(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	msgSrvr.CreateGame(context, &types.MsgCreateGame{
+		Creator: alice,
+		Black:   alice,
+		Red:     bob,
+		Wager:   sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+	})
+	msgSrvr.CreateGame(context, &types.MsgCreateGame{
+		Creator: alice,
+		Black:   bob,
+		Red:     carol,
+	})
+	game1, found := keeper.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	require.Empty(t, game1.Organizer)
+	game2, found := keeper.GetStoredGame(ctx, "2")
+	require.True(t, found)
+	require.Empty(t, game2.Organizer)
+}
+
+func TestCreateGameSponsorPrizeEscrowed(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerCreateGameWithMock(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	keeper.SetParams(ctx, types.NewParams([]string{"stake", "token"}, nil, 0, types.RakeToFeeCollector, 0, types.DefaultBetCutoffMove))
+	prize := sdk.NewCoins(sdk.NewInt64Coin("token", 1000))
+	escrow.ExpectPayCoins(context, alice, prize).Times(1)
+	_, err := msgServer.CreateGame(context, &types.MsgCreateGame{
+		Creator: alice,
+		Black:   bob,
+		Red:     carol,
+		Prize:   prize,
+	})
+	require.Nil(t, err)
+	game1, found := keeper.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	require.Equal(t, alice, game1.Sponsor)
+	require.Equal(t, prize, game1.Prize)
+	require.Empty(t, game1.Organizer)
+	require.True(t, game1.IsFunded())
+	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
+	require.EqualValues(t, sdk.Attribute{Key: "prize", Value: "1000token"}, events[0].Attributes[6])
+}
+
+func TestCreateGameSponsorCannotPay(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerCreateGameWithMock(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	escrow.ExpectPay(context, alice, 1000).Return(errors.New("oops"))
+	createResponse, err := msgServer.CreateGame(context, &types.MsgCreateGame{
+		Creator: alice,
+		Black:   bob,
+		Red:     carol,
+		Prize:   sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)),
+	})
+	require.Nil(t, createResponse)
+	require.EqualError(t, err, "sponsor cannot pay the prize: oops")
+	_, found := keeper.GetStoredGame(ctx, "1")
+	require.False(t, found)
+}
+
+func TestCreateGamePrizeDenomNotAllowed(t *testing.T) {
+	msgServer, _, context := setupMsgServerCreateGame(t)
+	createResponse, err := msgServer.CreateGame(context, &types.MsgCreateGame{
+		Creator: alice,
+		Black:   bob,
+		Red:     carol,
+		Prize:   sdk.NewCoins(sdk.NewInt64Coin("token", 1000)),
+	})
+	require.Nil(t, createResponse)
+	require.EqualError(t, err, "token: denom is not allowed for wagers: %s")
+}
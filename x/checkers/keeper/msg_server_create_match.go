@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/satya/checkers/x/checkers/types"
+)
+
+func (k msgServer) CreateMatch(goCtx context.Context, msg *types.MsgCreateMatch) (*types.MsgCreateMatchResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	systemInfo, found := k.Keeper.GetSystemInfo(ctx)
+	if !found {
+		panic("SystemInfo not found")
+	}
+	newIndex := strconv.FormatUint(systemInfo.NextMatchId, 10)
+
+	err := k.Keeper.ValidateWagerDenoms(ctx, msg.Wager)
+	if err != nil {
+		return nil, err
+	}
+
+	match := types.NewMatch(newIndex, msg.Creator, msg.PlayerA, msg.PlayerB, msg.NumberOfGames, msg.Wager)
+	if !match.Wager.IsZero() && match.IsPlayer(msg.Creator) {
+		err = k.Keeper.AcceptMatchWager(ctx, &match, msg.Creator)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var gameIndex string
+	if match.IsFunded() {
+		gameIndex = k.Keeper.startMatchGame(ctx, &match, &systemInfo).Index
+	}
+	k.Keeper.SetMatch(ctx, match)
+	systemInfo.NextMatchId++
+	k.Keeper.SetSystemInfo(ctx, systemInfo)
+
+	ctx.GasMeter().ConsumeGas(types.CreateMatchGas, "Create match")
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.MatchCreatedEventType,
+			sdk.NewAttribute(types.MatchCreatedEventCreator, msg.Creator),
+			sdk.NewAttribute(types.MatchCreatedEventMatchIndex, newIndex),
+			sdk.NewAttribute(types.MatchCreatedEventPlayerA, msg.PlayerA),
+			sdk.NewAttribute(types.MatchCreatedEventPlayerB, msg.PlayerB),
+			sdk.NewAttribute(types.MatchCreatedEventNumberOfGames, strconv.FormatUint(msg.NumberOfGames, 10)),
+			sdk.NewAttribute(types.MatchCreatedEventWager, msg.Wager.String()),
+		),
+	)
+
+	return &types.MsgCreateMatchResponse{
+		MatchIndex: newIndex,
+		GameIndex:  gameIndex,
+	}, nil
+}
@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/satya/checkers/x/checkers/types"
+)
+
+func (k msgServer) Deposit(goCtx context.Context, msg *types.MsgDeposit) (*types.MsgDepositResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := k.Keeper.ValidateWagerDenoms(ctx, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+	depositor, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err.Error())
+	}
+	err = k.bank.SendCoinsFromAccountToModule(ctx, depositor, types.ModuleName, msg.Amount)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, types.ErrDepositorCannotPay.Error())
+	}
+	playerBalance := k.Keeper.CreditPlayerBalance(ctx, msg.Creator, msg.Amount)
+
+	ctx.GasMeter().ConsumeGas(types.DepositGas, "Deposit")
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.BalanceDepositedEventType,
+			sdk.NewAttribute(types.BalanceDepositedEventPlayer, msg.Creator),
+			sdk.NewAttribute(types.BalanceDepositedEventAmount, msg.Amount.String()),
+			sdk.NewAttribute(types.BalanceDepositedEventBalance, playerBalance.Funds.String()),
+		),
+	)
+
+	return &types.MsgDepositResponse{
+		Balance: playerBalance.Funds,
+	}, nil
+}
@@ -0,0 +1,25 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/satya/checkers/x/checkers/types"
+)
+
+func (k msgServer) EndVacation(goCtx context.Context, msg *types.MsgEndVacation) (*types.MsgEndVacationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	vacation, found := k.Keeper.GetVacation(ctx, msg.Creator)
+	if !found || !vacation.IsOnVacation() {
+		return nil, types.ErrNotOnVacation
+	}
+
+	k.Keeper.EndVacation(ctx, &vacation)
+
+	ctx.GasMeter().ConsumeGas(types.VacationGas, "End vacation")
+
+	return &types.MsgEndVacationResponse{
+		UsedSeconds: vacation.UsedSeconds,
+	}, nil
+}
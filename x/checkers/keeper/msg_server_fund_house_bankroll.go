@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/satya/checkers/x/checkers/types"
+)
+
+func (k msgServer) FundHouseBankroll(goCtx context.Context, msg *types.MsgFundHouseBankroll) (*types.MsgFundHouseBankrollResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := k.Keeper.ValidateWagerDenoms(ctx, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+	funder, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err.Error())
+	}
+	err = k.bank.SendCoinsFromAccountToModule(ctx, funder, types.ModuleName, msg.Amount)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, types.ErrFunderCannotPay.Error())
+	}
+	k.Keeper.CreditHouseBankroll(ctx, msg.Amount)
+	houseBankroll, _ := k.Keeper.GetHouseBankroll(ctx)
+
+	ctx.GasMeter().ConsumeGas(types.FundHouseBankrollGas, "Fund house bankroll")
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.HouseBankrollFundedEventType,
+			sdk.NewAttribute(types.HouseBankrollFundedEventFunder, msg.Creator),
+			sdk.NewAttribute(types.HouseBankrollFundedEventAmount, msg.Amount.String()),
+			sdk.NewAttribute(types.HouseBankrollFundedEventBankroll, houseBankroll.Funds.String()),
+		),
+	)
+
+	return &types.MsgFundHouseBankrollResponse{
+		Bankroll: houseBankroll.Funds,
+	}, nil
+}
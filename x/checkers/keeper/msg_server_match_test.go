@@ -0,0 +1,242 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+	keepertest "github.com/satya/checkers/testutil/keeper"
+	"github.com/satya/checkers/x/checkers"
+	"github.com/satya/checkers/x/checkers/keeper"
+	"github.com/satya/checkers/x/checkers/testutil"
+	"github.com/satya/checkers/x/checkers/types"
+	"github.com/stretchr/testify/require"
+)
+
+func setupMsgServerForMatch(t *testing.T) (types.MsgServer, keeper.Keeper, context.Context,
+	*gomock.Controller, *testutil.MockBankEscrowKeeper, *testutil.MockCheckersLeaderboardKeeper) {
+	ctrl := gomock.NewController(t)
+	bankMock := testutil.NewMockBankEscrowKeeper(ctrl)
+	leaderboardMock := testutil.NewMockCheckersLeaderboardKeeper(ctrl)
+	k, ctx := keepertest.CheckersKeeperWithMocks(t, bankMock, leaderboardMock)
+	checkers.InitGenesis(ctx, *k, *types.DefaultGenesis())
+	return keeper.NewMsgServerImpl(*k), *k, sdk.WrapSDKContext(ctx), ctrl, bankMock, leaderboardMock
+}
+
+// endMatchGame ends the game as if won by the given colour, or drawn, and
+// advances its match.
+func endMatchGame(keeper keeper.Keeper, ctx sdk.Context, gameIndex string, winner string) {
+	storedGame, _ := keeper.GetStoredGame(ctx, gameIndex)
+	systemInfo, _ := keeper.GetSystemInfo(ctx)
+	storedGame.Winner = winner
+	keeper.RemoveFromFifo(ctx, &storedGame, &systemInfo)
+	keeper.SetStoredGame(ctx, storedGame)
+	keeper.MustAdvanceMatch(ctx, &storedGame, &systemInfo)
+	keeper.SetSystemInfo(ctx, systemInfo)
+}
+
+func TestCreateMatchWithoutWagerStartsFirstGame(t *testing.T) {
+	msgServer, keeper, context, ctrl, _, _ := setupMsgServerForMatch(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+
+	response, err := msgServer.CreateMatch(context, types.NewMsgCreateMatch(alice, bob, carol, 3, nil))
+	require.Nil(t, err)
+	require.EqualValues(t, types.MsgCreateMatchResponse{
+		MatchIndex: "1",
+		GameIndex:  "1",
+	}, *response)
+	match, found := keeper.GetMatch(ctx, "1")
+	require.True(t, found)
+	require.EqualValues(t, []string{"1"}, match.GameIndices)
+	require.True(t, match.IsFunded())
+	game, found := keeper.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	require.EqualValues(t, bob, game.Black)
+	require.EqualValues(t, carol, game.Red)
+	require.EqualValues(t, "1", game.MatchIndex)
+	require.True(t, game.Unrated)
+	require.True(t, game.Wager.IsZero())
+	systemInfo, _ := keeper.GetSystemInfo(ctx)
+	require.EqualValues(t, uint64(2), systemInfo.NextId)
+	require.EqualValues(t, uint64(2), systemInfo.NextMatchId)
+	require.EqualValues(t, "1", systemInfo.FifoHeadIndex)
+	event := findEvent(t, ctx, types.MatchCreatedEventType)
+	require.EqualValues(t, []sdk.Attribute{
+		{Key: "creator", Value: alice},
+		{Key: "match-index", Value: "1"},
+		{Key: "player-a", Value: bob},
+		{Key: "player-b", Value: carol},
+		{Key: "number-of-games", Value: "3"},
+		{Key: "wager", Value: ""},
+	}, event.Attributes)
+}
+
+func TestCreateMatchWithWagerWaitsForAccept(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow, _ := setupMsgServerForMatch(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+
+	response, err := msgServer.CreateMatch(context, types.NewMsgCreateMatch(bob, bob, carol, 3, stakeCoins(45)))
+	require.Nil(t, err)
+	require.EqualValues(t, types.MsgCreateMatchResponse{MatchIndex: "1"}, *response)
+	match, _ := keeper.GetMatch(ctx, "1")
+	require.EqualValues(t, bob, match.AcceptedBy)
+	require.False(t, match.IsFunded())
+	require.Empty(t, match.GameIndices)
+	_, found := keeper.GetStoredGame(ctx, "1")
+	require.False(t, found)
+
+	payBob := escrow.ExpectPay(context, bob, 45)
+	escrow.ExpectPay(context, carol, 45).After(payBob)
+	acceptResponse, err := msgServer.AcceptMatch(context, types.NewMsgAcceptMatch(carol, "1"))
+	require.Nil(t, err)
+	require.EqualValues(t, types.MsgAcceptMatchResponse{
+		Escrowed:  stakeCoins(90),
+		GameIndex: "1",
+	}, *acceptResponse)
+	match, _ = keeper.GetMatch(ctx, "1")
+	require.EqualValues(t, "", match.AcceptedBy)
+	require.EqualValues(t, stakeCoins(90), match.Escrowed)
+	require.EqualValues(t, []string{"1"}, match.GameIndices)
+	game, _ := keeper.GetStoredGame(ctx, "1")
+	require.True(t, game.Escrowed.IsZero())
+
+	_, err = msgServer.AcceptMatch(context, types.NewMsgAcceptMatch(bob, "1"))
+	require.ErrorIs(t, err, types.ErrAlreadyAccepted)
+}
+
+func TestAcceptMatchErrors(t *testing.T) {
+	msgServer, _, context, ctrl, _, _ := setupMsgServerForMatch(t)
+	defer ctrl.Finish()
+
+	_, err := msgServer.AcceptMatch(context, types.NewMsgAcceptMatch(bob, "1"))
+	require.EqualError(t, err, "1: match by id not found")
+
+	_, err = msgServer.CreateMatch(context, types.NewMsgCreateMatch(alice, bob, carol, 3, stakeCoins(45)))
+	require.Nil(t, err)
+	_, err = msgServer.AcceptMatch(context, types.NewMsgAcceptMatch(dave, "1"))
+	require.ErrorIs(t, err, types.ErrCreatorNotPlayer)
+
+	_, err = msgServer.AcceptMatch(context, types.NewMsgAcceptMatch(bob, "1"))
+	require.Nil(t, err)
+	_, err = msgServer.AcceptMatch(context, types.NewMsgAcceptMatch(bob, "1"))
+	require.ErrorIs(t, err, types.ErrAlreadyAccepted)
+}
+
+func TestMatchGameWinStartsNextGameWithSwappedColours(t *testing.T) {
+	msgServer, keeper, context, ctrl, _, _ := setupMsgServerForMatch(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	_, err := msgServer.CreateMatch(context, types.NewMsgCreateMatch(alice, bob, carol, 3, nil))
+	require.Nil(t, err)
+
+	endMatchGame(keeper, ctx, "1", "b")
+
+	match, _ := keeper.GetMatch(ctx, "1")
+	require.EqualValues(t, uint64(2), match.ScoreA)
+	require.EqualValues(t, uint64(0), match.ScoreB)
+	require.EqualValues(t, "", match.Winner)
+	require.EqualValues(t, []string{"1", "2"}, match.GameIndices)
+	game, found := keeper.GetStoredGame(ctx, "2")
+	require.True(t, found)
+	require.EqualValues(t, carol, game.Black)
+	require.EqualValues(t, bob, game.Red)
+	require.EqualValues(t, "1", game.MatchIndex)
+	systemInfo, _ := keeper.GetSystemInfo(ctx)
+	require.EqualValues(t, uint64(3), systemInfo.NextId)
+	require.EqualValues(t, "2", systemInfo.FifoHeadIndex)
+	event := findEvent(t, ctx, types.MatchGameEndedEventType)
+	require.EqualValues(t, []sdk.Attribute{
+		{Key: "match-index", Value: "1"},
+		{Key: "game-index", Value: "1"},
+		{Key: "winner", Value: "b"},
+		{Key: "score-a", Value: "2"},
+		{Key: "score-b", Value: "0"},
+		{Key: "next-game-index", Value: "2"},
+	}, event.Attributes)
+}
+
+func TestMatchWonPaysWinnerLessRake(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow, leaderboard := setupMsgServerForMatch(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	setFees(keeper, ctx, 250, types.RakeToFeeCollector, 100)
+	escrow.ExpectPay(context, bob, 45)
+	escrow.ExpectPay(context, carol, 45)
+	_, err := msgServer.CreateMatch(context, types.NewMsgCreateMatch(bob, bob, carol, 3, stakeCoins(45)))
+	require.Nil(t, err)
+	_, err = msgServer.AcceptMatch(context, types.NewMsgAcceptMatch(carol, "1"))
+	require.Nil(t, err)
+	endMatchGame(keeper, ctx, "1", "r")
+
+	// Carol, who was red in game 1, is black in game 2.
+	escrow.ExpectPayFee(context, 2)
+	escrow.ExpectRefund(context, carol, 88)
+	win := leaderboard.Expectwin(context, carol)
+	leaderboard.ExpectLoss(context, bob).After(win)
+	endMatchGame(keeper, ctx, "2", "b")
+
+	match, _ := keeper.GetMatch(ctx, "1")
+	require.EqualValues(t, uint64(0), match.ScoreA)
+	require.EqualValues(t, uint64(4), match.ScoreB)
+	require.EqualValues(t, carol, match.Winner)
+	require.True(t, match.IsOver())
+	require.True(t, match.Escrowed.IsZero())
+	require.EqualValues(t, []string{"1", "2"}, match.GameIndices)
+	_, found := keeper.GetStoredGame(ctx, "3")
+	require.False(t, found)
+	event := findEvent(t, ctx, types.MatchEndedEventType)
+	require.EqualValues(t, []sdk.Attribute{
+		{Key: "match-index", Value: "1"},
+		{Key: "winner", Value: carol},
+		{Key: "winnings", Value: "88stake"},
+		{Key: "rake", Value: "2stake"},
+	}, event.Attributes)
+
+	_, err = msgServer.AcceptMatch(context, types.NewMsgAcceptMatch(bob, "1"))
+	require.ErrorIs(t, err, types.ErrMatchFinished)
+}
+
+func TestMatchDrawnRefundsBothPlayers(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow, _ := setupMsgServerForMatch(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	escrow.ExpectPay(context, bob, 45)
+	escrow.ExpectPay(context, carol, 45)
+	_, err := msgServer.CreateMatch(context, types.NewMsgCreateMatch(bob, bob, carol, 2, stakeCoins(45)))
+	require.Nil(t, err)
+	_, err = msgServer.AcceptMatch(context, types.NewMsgAcceptMatch(carol, "1"))
+	require.Nil(t, err)
+	endMatchGame(keeper, ctx, "1", "b")
+
+	refundBob := escrow.ExpectRefund(context, bob, 45)
+	escrow.ExpectRefund(context, carol, 45).After(refundBob)
+	endMatchGame(keeper, ctx, "2", "b")
+
+	match, _ := keeper.GetMatch(ctx, "1")
+	require.EqualValues(t, uint64(2), match.ScoreA)
+	require.EqualValues(t, uint64(2), match.ScoreB)
+	require.EqualValues(t, types.DrawWinner, match.Winner)
+	require.True(t, match.Escrowed.IsZero())
+}
+
+func TestMatchEscrowCountsInHeldFunds(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow, _ := setupMsgServerForMatch(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	escrow.ExpectAny(context)
+	_, err := msgServer.CreateMatch(context, types.NewMsgCreateMatch(bob, bob, carol, 3, stakeCoins(45)))
+	require.Nil(t, err)
+	_, err = msgServer.AcceptMatch(context, types.NewMsgAcceptMatch(carol, "1"))
+	require.Nil(t, err)
+
+	require.EqualValues(t, stakeCoins(90), keeper.GetHeldFunds(ctx))
+	response, err := keeper.EscrowByPlayer(context, &types.QueryEscrowByPlayerRequest{Player: bob})
+	require.Nil(t, err)
+	require.EqualValues(t, []types.PlayerMatchEscrow{
+		{MatchIndex: "1", Wager: stakeCoins(45)},
+	}, response.Matches)
+	require.EqualValues(t, stakeCoins(45), response.Total)
+}
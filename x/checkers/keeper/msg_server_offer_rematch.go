@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/satya/checkers/x/checkers/types"
+)
+
+func (k msgServer) OfferRematch(goCtx context.Context, msg *types.MsgOfferRematch) (*types.MsgOfferRematchResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	storedGame, err := k.Keeper.getRematchGame(ctx, msg.GameIndex, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	storedGame.RematchProposer = msg.Creator
+	k.Keeper.SetStoredGame(ctx, storedGame)
+
+	ctx.GasMeter().ConsumeGas(types.OfferRematchGas, "Offer rematch")
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.RematchOfferedEventType,
+			sdk.NewAttribute(types.RematchOfferedEventPlayer, msg.Creator),
+			sdk.NewAttribute(types.RematchOfferedEventGameIndex, msg.GameIndex),
+		),
+	)
+
+	return &types.MsgOfferRematchResponse{}, nil
+}
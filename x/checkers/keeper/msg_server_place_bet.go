@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/satya/checkers/x/checkers/rules"
+	"github.com/satya/checkers/x/checkers/types"
+)
+
+func (k msgServer) PlaceBet(goCtx context.Context, msg *types.MsgPlaceBet) (*types.MsgPlaceBetResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	storedGame, found := k.Keeper.GetStoredGame(ctx, msg.GameIndex)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrGameNotFound, "%s", msg.GameIndex)
+	}
+	if storedGame.Winner != rules.PieceStrings[rules.NO_PLAYER] {
+		return nil, types.ErrGameFinished
+	}
+	// Until the colours are drawn, the players are not known by colour.
+	if _, found := k.Keeper.GetColourDraw(ctx, msg.GameIndex); found {
+		return nil, types.ErrColourDrawPending
+	}
+	if storedGame.Black == msg.Creator || storedGame.Red == msg.Creator {
+		return nil, sdkerrors.Wrapf(types.ErrPlayerCannotBet, "%s", msg.Creator)
+	}
+	cutoff := k.Keeper.BetCutoffMove(ctx)
+	if cutoff <= storedGame.MoveCount {
+		return nil, sdkerrors.Wrapf(types.ErrBetsClosed, "%d", cutoff)
+	}
+	err := k.Keeper.ValidateWagerDenoms(ctx, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+	bet, found := k.Keeper.GetBet(ctx, msg.GameIndex, msg.Creator)
+	if !found {
+		if types.MaxBettorsPerGame <= len(k.Keeper.GetGameBets(ctx, msg.GameIndex)) {
+			return nil, sdkerrors.Wrapf(types.ErrTooManyBettors, "%d", types.MaxBettorsPerGame)
+		}
+		bet = types.NewBet(msg.GameIndex, msg.Creator, msg.Side, sdk.NewCoins())
+	} else if bet.Side != msg.Side {
+		return nil, sdkerrors.Wrapf(types.ErrBetOnOtherSide, "%s", bet.Side)
+	}
+
+	bettor, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err.Error())
+	}
+	err = k.Keeper.collectFromPlayer(ctx, bettor, msg.Amount)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, types.ErrBettorCannotPay.Error())
+	}
+	bet.Amount = bet.Amount.Add(msg.Amount...)
+	k.Keeper.SetBet(ctx, bet)
+
+	ctx.GasMeter().ConsumeGas(types.PlaceBetGas, "Place bet")
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.BetPlacedEventType,
+			sdk.NewAttribute(types.BetPlacedEventBettor, msg.Creator),
+			sdk.NewAttribute(types.BetPlacedEventGameIndex, msg.GameIndex),
+			sdk.NewAttribute(types.BetPlacedEventSide, msg.Side),
+			sdk.NewAttribute(types.BetPlacedEventAmount, msg.Amount.String()),
+		),
+	)
+
+	return &types.MsgPlaceBetResponse{
+		Amount: bet.Amount,
+	}, nil
+}
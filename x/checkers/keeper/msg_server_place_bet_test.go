@@ -0,0 +1,201 @@
+package keeper_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/satya/checkers/x/checkers/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlaceBet(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow, _ := setupMsgServerWithOneGameForPlayMoveWithMock(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	escrow.ExpectPay(context, alice, 100).Times(1)
+	response, err := msgServer.PlaceBet(context, types.NewMsgPlaceBet(alice, "1", "b", sdk.NewCoins(sdk.NewInt64Coin("stake", 100))))
+	require.Nil(t, err)
+	require.EqualValues(t, types.MsgPlaceBetResponse{
+		Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+	}, *response)
+	bet, found := keeper.GetBet(ctx, "1", alice)
+	require.True(t, found)
+	require.EqualValues(t, types.NewBet("1", alice, "b", sdk.NewCoins(sdk.NewInt64Coin("stake", 100))), bet)
+	event := findEvent(t, ctx, "bet-placed")
+	require.EqualValues(t, []sdk.Attribute{
+		{Key: "bettor", Value: alice},
+		{Key: "game-index", Value: "1"},
+		{Key: "side", Value: "b"},
+		{Key: "amount", Value: "100stake"},
+	}, event.Attributes)
+}
+
+func TestPlaceBetFromPlayerBalance(t *testing.T) {
+	msgServer, keeper, context, ctrl, _, _ := setupMsgServerWithOneGameForPlayMoveWithMock(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	keeper.CreditPlayerBalance(ctx, alice, sdk.NewCoins(sdk.NewInt64Coin("stake", 150)))
+	_, err := msgServer.PlaceBet(context, types.NewMsgPlaceBet(alice, "1", "b", sdk.NewCoins(sdk.NewInt64Coin("stake", 100))))
+	require.Nil(t, err)
+	aliceBalance, _ := keeper.GetPlayerBalance(ctx, alice)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 50)), aliceBalance.Funds)
+}
+
+func TestPlaceBetAddsUpOnSameSide(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow, _ := setupMsgServerWithOneGameForPlayMoveWithMock(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	escrow.ExpectPay(context, alice, 100).Times(1)
+	escrow.ExpectPay(context, alice, 20).Times(1)
+	msgServer.PlaceBet(context, types.NewMsgPlaceBet(alice, "1", "r", sdk.NewCoins(sdk.NewInt64Coin("stake", 100))))
+	response, err := msgServer.PlaceBet(context, types.NewMsgPlaceBet(alice, "1", "r", sdk.NewCoins(sdk.NewInt64Coin("stake", 20))))
+	require.Nil(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 120)), response.Amount)
+	bet, _ := keeper.GetBet(ctx, "1", alice)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 120)), bet.Amount)
+}
+
+func TestPlaceBetOnOtherSideFails(t *testing.T) {
+	msgServer, _, context, ctrl, escrow, _ := setupMsgServerWithOneGameForPlayMoveWithMock(t)
+	defer ctrl.Finish()
+	escrow.ExpectPay(context, alice, 100).Times(1)
+	msgServer.PlaceBet(context, types.NewMsgPlaceBet(alice, "1", "r", sdk.NewCoins(sdk.NewInt64Coin("stake", 100))))
+	response, err := msgServer.PlaceBet(context, types.NewMsgPlaceBet(alice, "1", "b", sdk.NewCoins(sdk.NewInt64Coin("stake", 20))))
+	require.Nil(t, response)
+	require.EqualError(t, err, "r: bettor already backs the other side: %s")
+}
+
+func TestPlaceBetByPlayerFails(t *testing.T) {
+	msgServer, _, context, ctrl, _, _ := setupMsgServerWithOneGameForPlayMoveWithMock(t)
+	defer ctrl.Finish()
+	response, err := msgServer.PlaceBet(context, types.NewMsgPlaceBet(bob, "1", "b", sdk.NewCoins(sdk.NewInt64Coin("stake", 100))))
+	require.Nil(t, response)
+	require.EqualError(t, err, bob+": players cannot bet on their game: %s")
+}
+
+func TestPlaceBetAfterCutoffFails(t *testing.T) {
+	msgServer, keeper, context, ctrl, _, _ := setupMsgServerWithOneGameForPlayMoveWithMock(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	params := keeper.GetParams(ctx)
+	params.BetCutoffMove = 1
+	keeper.SetParams(ctx, params)
+	msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   bob,
+		GameIndex: "1",
+		FromX:     1,
+		FromY:     2,
+		ToX:       2,
+		ToY:       3,
+	})
+	response, err := msgServer.PlaceBet(context, types.NewMsgPlaceBet(alice, "1", "b", sdk.NewCoins(sdk.NewInt64Coin("stake", 100))))
+	require.Nil(t, response)
+	require.EqualError(t, err, "1: bets are closed from move %d")
+}
+
+func TestPlaceBetDenomNotAllowed(t *testing.T) {
+	msgServer, _, context, ctrl, _, _ := setupMsgServerWithOneGameForPlayMoveWithMock(t)
+	defer ctrl.Finish()
+	response, err := msgServer.PlaceBet(context, types.NewMsgPlaceBet(alice, "1", "b", sdk.NewCoins(sdk.NewInt64Coin("token", 100))))
+	require.Nil(t, response)
+	require.EqualError(t, err, "token: denom is not allowed for wagers: %s")
+}
+
+func TestPlaceBetCannotPay(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow, _ := setupMsgServerWithOneGameForPlayMoveWithMock(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	escrow.ExpectPay(context, alice, 100).Return(errors.New("oops"))
+	response, err := msgServer.PlaceBet(context, types.NewMsgPlaceBet(alice, "1", "b", sdk.NewCoins(sdk.NewInt64Coin("stake", 100))))
+	require.Nil(t, response)
+	require.EqualError(t, err, "bettor cannot pay the bet: oops")
+	_, found := keeper.GetBet(ctx, "1", alice)
+	require.False(t, found)
+}
+
+func TestPlaceBetGameNotFound(t *testing.T) {
+	msgServer, _, context, ctrl, _, _ := setupMsgServerWithOneGameForPlayMoveWithMock(t)
+	defer ctrl.Finish()
+	response, err := msgServer.PlaceBet(context, types.NewMsgPlaceBet(alice, "2", "b", sdk.NewCoins(sdk.NewInt64Coin("stake", 100))))
+	require.Nil(t, response)
+	require.EqualError(t, err, "2: game by id not found")
+}
+
+func TestPlaceBetColourDrawPending(t *testing.T) {
+	msgServer, keeper, context, ctrl, _, _ := setupMsgServerWithOneGameForPlayMoveWithMock(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	keeper.SetColourDraw(ctx, types.ColourDraw{Index: "1", First: bob, Second: carol})
+	response, err := msgServer.PlaceBet(context, types.NewMsgPlaceBet(alice, "1", "b", sdk.NewCoins(sdk.NewInt64Coin("stake", 100))))
+	require.Nil(t, response)
+	require.ErrorIs(t, err, types.ErrColourDrawPending)
+}
+
+func TestPlaceBetTooManyBettors(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow, _ := setupMsgServerWithOneGameForPlayMoveWithMock(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	escrow.ExpectPay(context, dave, 20).Times(1)
+	keeper.SetBet(ctx, types.NewBet("1", dave, "r", sdk.NewCoins(sdk.NewInt64Coin("stake", 50))))
+	for i := 1; i < types.MaxBettorsPerGame; i++ {
+		bettor := sdk.AccAddress([]byte(fmt.Sprintf("bettor%d", i))).String()
+		keeper.SetBet(ctx, types.NewBet("1", bettor, "b", sdk.NewCoins(sdk.NewInt64Coin("stake", 10))))
+	}
+	response, err := msgServer.PlaceBet(context, types.NewMsgPlaceBet(alice, "1", "b", sdk.NewCoins(sdk.NewInt64Coin("stake", 100))))
+	require.Nil(t, response)
+	require.EqualError(t, err, "100: game already has %d bettors")
+
+	// A bettor already in the pool can still add to their bet.
+	response, err = msgServer.PlaceBet(context, types.NewMsgPlaceBet(dave, "1", "r", sdk.NewCoins(sdk.NewInt64Coin("stake", 20))))
+	require.Nil(t, err)
+	require.EqualValues(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 70)), response.Amount)
+}
+
+func TestPlaceBetWinnersPaidAtEnd(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow, board := setupMsgServerWithOneGameForPlayMoveWithMock(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	board.ExpectAny(context)
+	escrow.ExpectPay(context, alice, 100).Times(1)
+	escrow.ExpectPay(context, dave, 50).Times(1)
+	escrow.ExpectRefund(context, bob, 90).Times(1)
+	escrow.ExpectRefund(context, alice, 150).Times(1)
+	msgServer.PlaceBet(context, types.NewMsgPlaceBet(alice, "1", "b", sdk.NewCoins(sdk.NewInt64Coin("stake", 100))))
+	msgServer.PlaceBet(context, types.NewMsgPlaceBet(dave, "1", "r", sdk.NewCoins(sdk.NewInt64Coin("stake", 50))))
+
+	playAllMoves(t, msgServer, context, "1", game1Moves)
+
+	require.Empty(t, keeper.GetAllBet(ctx))
+	event := findEvent(t, ctx, "bets-settled")
+	require.EqualValues(t, []sdk.Attribute{
+		{Key: "game-index", Value: "1"},
+		{Key: "winner", Value: "b"},
+		{Key: "black-pool", Value: "100stake"},
+		{Key: "red-pool", Value: "50stake"},
+		{Key: "leftover", Value: ""},
+	}, event.Attributes)
+}
+
+func TestPlaceBetRefundedWhenDropped(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow, _ := setupMsgServerWithOneGameForPlayMoveWithMock(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	escrow.ExpectPay(context, alice, 100).Times(1)
+	escrow.ExpectPay(context, dave, 50).Times(1)
+	escrow.ExpectRefund(context, bob, 45).Times(1)
+	escrow.ExpectRefund(context, carol, 45).Times(1)
+	escrow.ExpectRefund(context, alice, 100).Times(1)
+	escrow.ExpectRefund(context, dave, 50).Times(1)
+	msgServer.PlaceBet(context, types.NewMsgPlaceBet(alice, "1", "b", sdk.NewCoins(sdk.NewInt64Coin("stake", 100))))
+	msgServer.PlaceBet(context, types.NewMsgPlaceBet(dave, "1", "r", sdk.NewCoins(sdk.NewInt64Coin("stake", 50))))
+	game1, _ := keeper.GetStoredGame(ctx, "1")
+	game1.Deadline = types.FormatDeadline(ctx.BlockTime().Add(time.Duration(-1)))
+	keeper.SetStoredGame(ctx, game1)
+
+	keeper.ForfeitExpiredGames(context)
+
+	require.Empty(t, keeper.GetAllBet(ctx))
+}
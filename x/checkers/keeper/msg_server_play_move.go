@@ -22,6 +22,22 @@ func (k msgServer) PlayMove(goCtx context.Context, msg *types.MsgPlayMove) (*typ
 		return nil, types.ErrGameFinished
 	}
 
+	if msg.ExpectedMoveCount != nil && *msg.ExpectedMoveCount != storedGame.MoveCount {
+		return nil, sdkerrors.Wrapf(types.ErrMoveCountMismatch, "expected %d, actual %d", *msg.ExpectedMoveCount, storedGame.MoveCount)
+	}
+
+	if _, found := k.Keeper.GetColourDraw(ctx, msg.GameIndex); found {
+		return nil, types.ErrColourDrawPending
+	}
+
+	if !storedGame.IsFunded() {
+		return nil, sdkerrors.Wrapf(types.ErrGameNotAccepted, "%s", msg.GameIndex)
+	}
+
+	if storedGame.IsAdjourned() {
+		return nil, sdkerrors.Wrapf(types.ErrGameAdjourned, "%s", msg.GameIndex)
+	}
+
 	isBlack := storedGame.Black == msg.Creator
 	isRed := storedGame.Red == msg.Creator
 	var player rules.Player
@@ -44,68 +60,93 @@ func (k msgServer) PlayMove(goCtx context.Context, msg *types.MsgPlayMove) (*typ
 		return nil, sdkerrors.Wrapf(types.ErrNotPlayerTurn, "%s", player)
 	}
 
-	err = k.Keeper.CollectWager(ctx, &storedGame)
-	if err != nil {
-		return nil, err
-	}
-
-	captured, moveErr := game.Move(
-		rules.Pos{
+	captured, err := k.Keeper.PlayStep(ctx, &storedGame, game, msg.Creator, rules.Step{
+		Src: rules.Pos{
 			X: int(msg.FromX),
 			Y: int(msg.FromY),
 		},
-		rules.Pos{
+		Dst: rules.Pos{
 			X: int(msg.ToX),
 			Y: int(msg.ToY),
 		},
-	)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	k.Keeper.PlayPremove(ctx, &storedGame, game)
+
+	err = k.Keeper.PlayHouseMoves(ctx, &storedGame, game)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgPlayMoveResponse{
+		CapturedX: int32(captured.X),
+		CapturedY: int32(captured.Y),
+		Winner:    rules.PieceStrings[game.Winner()],
+	}, nil
+}
+
+// PlayStep plays one step for the player whose turn it is, on behalf of
+// creator, and saves the game. The game is updated in place.
+func (k Keeper) PlayStep(ctx sdk.Context, storedGame *types.StoredGame, game *rules.Game, creator string, step rules.Step) (captured rules.Pos, err error) {
+	captured, moveErr := game.Move(step.Src, step.Dst)
 
 	if moveErr != nil {
-		return nil, sdkerrors.Wrapf(types.ErrWrongMove, moveErr.Error())
+		return rules.Pos{}, sdkerrors.Wrapf(types.ErrWrongMove, moveErr.Error())
 	}
 
+	stepNotation, err := rules.FormatStep(step)
+	if err != nil {
+		panic(err.Error())
+	}
+	storedGame.MoveHistory = append(storedGame.MoveHistory, stepNotation)
+
 	//storedGame.Board = game.String()
 	storedGame.Winner = rules.PieceStrings[game.Winner()]
 
 	lastBoard := game.String()
 
-	systemInfo, found := k.Keeper.GetSystemInfo(ctx)
+	systemInfo, found := k.GetSystemInfo(ctx)
 	if !found {
 		panic("SystemInfo not found")
 	}
 
 	if storedGame.Winner == rules.PieceStrings[rules.NO_PLAYER] {
 		storedGame.Board = lastBoard
-		k.Keeper.SendToFifoTail(ctx, &storedGame, &systemInfo)
+		k.SendToFifoTail(ctx, storedGame, &systemInfo)
 	} else {
-		k.Keeper.RemoveFromFifo(ctx, &storedGame, &systemInfo)
+		k.RemoveFromFifo(ctx, storedGame, &systemInfo)
 		storedGame.Board = ""
-		k.Keeper.MustPayWinnings(ctx, &storedGame)
-		k.Keeper.MustRegisterPlayerWin(ctx, &storedGame)
+		k.MustPayWinnings(ctx, storedGame)
+		k.MustSettleBets(ctx, storedGame)
+		k.MustRegisterPlayerWin(ctx, storedGame)
+		k.MustAdvanceMatch(ctx, storedGame, &systemInfo)
 	}
 
 	storedGame.Deadline = types.FormatDeadline(types.GetNextDeadline((ctx)))
 	storedGame.MoveCount++
+	storedGame.TakebackRequester = ""
+	storedGame.AdjournProposer = ""
 	storedGame.Turn = rules.PieceStrings[game.Turn]
-	k.Keeper.SetStoredGame(ctx, storedGame)
-	k.Keeper.SetSystemInfo(ctx, systemInfo)
+	k.FreezeIfOnVacation(ctx, storedGame, &systemInfo)
+	k.SetStoredGame(ctx, *storedGame)
+	k.SetSystemInfo(ctx, systemInfo)
 
 	ctx.GasMeter().ConsumeGas(types.PlayMoveGas, "Play a move")
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(types.MovePlayedEventType,
-			sdk.NewAttribute(types.MovePlayedEventCreator, msg.Creator),
-			sdk.NewAttribute(types.MovePlayedEventGameIndex, msg.GameIndex),
+			sdk.NewAttribute(types.MovePlayedEventCreator, creator),
+			sdk.NewAttribute(types.MovePlayedEventGameIndex, storedGame.Index),
 			sdk.NewAttribute(types.MovePlayedEventCapturedX, strconv.FormatInt(int64(captured.X), 10)),
 			sdk.NewAttribute(types.MovePlayedEventCapturedY, strconv.FormatInt(int64(captured.Y), 10)),
 			sdk.NewAttribute(types.MovePlayedEventWinner, rules.PieceStrings[game.Winner()]),
 			sdk.NewAttribute(types.MovePlayedEventBoard, lastBoard),
+			sdk.NewAttribute(types.MovePlayedEventMove, stepNotation),
 		),
 	)
 
-	return &types.MsgPlayMoveResponse{
-		CapturedX: int32(captured.X),
-		CapturedY: int32(captured.Y),
-		Winner:    rules.PieceStrings[game.Winner()],
-	}, nil
+	return captured, nil
 }
@@ -33,6 +33,7 @@ func TestPlayMove2Games1MoveHasSavedFifo(t *testing.T) {
 		NextId:        3,
 		FifoHeadIndex: "2",
 		FifoTailIndex: "1",
+		NextMatchId:   1,
 	}, systemInfo1)
 	game1, found := keeper.GetStoredGame(ctx, "1")
 	require.True(t, found)
@@ -47,6 +48,10 @@ func TestPlayMove2Games1MoveHasSavedFifo(t *testing.T) {
 		MoveCount:   uint64(1),
 		BeforeIndex: "2",
 		AfterIndex:  "-1",
+		Wager:       sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+		MoveHistory: []string{"9-14"},
+		Escrowed:    sdk.NewCoins(sdk.NewInt64Coin("stake", 90)),
+		Organizer:   alice,
 	}, game1)
 
 	game2, found := keeper.GetStoredGame(ctx, "2")
@@ -97,6 +102,7 @@ func TestPlayMove2Games2MoveHasSavedFifo(t *testing.T) {
 		NextId:        3,
 		FifoHeadIndex: "1",
 		FifoTailIndex: "2",
+		NextMatchId:   1,
 	}, systemInfo1)
 	game1, found := keeper.GetStoredGame(ctx, "1")
 	require.True(t, found)
@@ -111,6 +117,10 @@ func TestPlayMove2Games2MoveHasSavedFifo(t *testing.T) {
 		MoveCount:   uint64(1),
 		BeforeIndex: "-1",
 		AfterIndex:  "2",
+		Wager:       sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+		MoveHistory: []string{"9-14"},
+		Escrowed:    sdk.NewCoins(sdk.NewInt64Coin("stake", 90)),
+		Organizer:   alice,
 	}, game1)
 
 	game2, found := keeper.GetStoredGame(ctx, "2")
@@ -126,5 +136,6 @@ func TestPlayMove2Games2MoveHasSavedFifo(t *testing.T) {
 		MoveCount:   uint64(1),
 		BeforeIndex: "1",
 		AfterIndex:  "-1",
+		MoveHistory: []string{"9-14"},
 	}, game2)
 }
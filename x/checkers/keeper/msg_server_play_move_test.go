@@ -14,13 +14,13 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
-func setupMsgServerWithOneGameForPlayMove(t testing.TB) (types.MsgServer, keeper.Keeper, context.Context) {
+func setupMsgServerWithOneGameForPlayMove(t *testing.T) (types.MsgServer, keeper.Keeper, context.Context) {
 	server, k, context, _, escrow, _ := setupMsgServerWithOneGameForPlayMoveWithMock(t)
 	escrow.ExpectAny(context)
 	return server, k, context
 }
 
-func setupMsgServerWithOneGameForPlayMoveWithMock(t testing.TB) (types.MsgServer, keeper.Keeper, context.Context,
+func setupMsgServerWithOneGameForPlayMoveWithMock(t *testing.T) (types.MsgServer, keeper.Keeper, context.Context,
 	*gomock.Controller, *testutil.MockBankEscrowKeeper, *testutil.MockCheckersLeaderboardKeeper) {
 	//k, ctx := keepertest.CheckersKeeper(t)
 	ctrl := gomock.NewController(t)
@@ -36,9 +36,11 @@ func setupMsgServerWithOneGameForPlayMoveWithMock(t testing.TB) (types.MsgServer
 		Creator: alice,
 		Black:   bob,
 		Red:     carol,
-		Wager:   45,
-		Denom:   "stake",
+		Wager:   sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
 	})
+	payBob := bankMock.ExpectPay(context, bob, 45)
+	bankMock.ExpectPay(context, carol, 45).After(payBob)
+	acceptGame(t, server, context, "1", bob, carol)
 	return server, *k, context, ctrl, bankMock, leaderboardMock
 }
 
@@ -60,6 +62,52 @@ func TestPlayMove(t *testing.T) {
 	}, *playMoveResponse)
 }
 
+func TestPlayMoveExpectedMoveCount(t *testing.T) {
+	msgServer, keeper, context := setupMsgServerWithOneGameForPlayMove(t)
+	expected := uint64(0)
+	_, err := msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:           bob,
+		GameIndex:         "1",
+		FromX:             1,
+		FromY:             2,
+		ToX:               2,
+		ToY:               3,
+		ExpectedMoveCount: &expected,
+	})
+	require.Nil(t, err)
+	game, _ := keeper.GetStoredGame(sdk.UnwrapSDKContext(context), "1")
+	require.EqualValues(t, 1, game.MoveCount)
+}
+
+func TestPlayMoveStaleMoveCount(t *testing.T) {
+	msgServer, keeper, context := setupMsgServerWithOneGameForPlayMove(t)
+	_, err := msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   bob,
+		GameIndex: "1",
+		FromX:     1,
+		FromY:     2,
+		ToX:       2,
+		ToY:       3,
+	})
+	require.Nil(t, err)
+	// A retry of the same move, computed against the start position.
+	expected := uint64(0)
+	playMoveResponse, err := msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:           bob,
+		GameIndex:         "1",
+		FromX:             1,
+		FromY:             2,
+		ToX:               2,
+		ToY:               3,
+		ExpectedMoveCount: &expected,
+	})
+	require.Nil(t, playMoveResponse)
+	require.ErrorIs(t, err, types.ErrMoveCountMismatch)
+	require.EqualError(t, err, "expected 0, actual 1: game is not at the expected move count")
+	game, _ := keeper.GetStoredGame(sdk.UnwrapSDKContext(context), "1")
+	require.EqualValues(t, 1, game.MoveCount)
+}
+
 func TestPlayMoveGameNotFound(t *testing.T) {
 	msgServer, _, context := setupMsgServerWithOneGameForPlayMove(t)
 
@@ -81,9 +129,9 @@ func TestPlayMoveSameBlackRed(t *testing.T) {
 		Creator: alice,
 		Black:   bob,
 		Red:     bob,
-		Wager:   46,
-		Denom:   "coin",
+		Wager:   sdk.NewCoins(sdk.NewInt64Coin("stake", 46)),
 	})
+	acceptGame(t, msgServer, context, "1", bob)
 	playMoveResponse, err := msgServer.PlayMove(context, &types.MsgPlayMove{
 		Creator:   bob,
 		GameIndex: "1",
@@ -119,6 +167,7 @@ func TestPlayMoveSaveGame(t *testing.T) {
 		NextId:        2,
 		FifoHeadIndex: "1",
 		FifoTailIndex: "1",
+		NextMatchId:   1,
 	}, systemInfo)
 
 	game1, found := keeper.GetStoredGame(ctx, "1")
@@ -134,7 +183,10 @@ func TestPlayMoveSaveGame(t *testing.T) {
 		MoveCount:   1,
 		BeforeIndex: "-1",
 		AfterIndex:  "-1",
-		Wager:       45,
+		Wager:       sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+		MoveHistory: []string{"9-14"},
+		Escrowed:    sdk.NewCoins(sdk.NewInt64Coin("stake", 90)),
+		Organizer:   alice,
 	}, game1)
 }
 
@@ -186,8 +238,8 @@ func TestPlayMove2Emitted(t *testing.T) {
 
 	require.NotNil(t, ctx)
 	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
-	require.Len(t, events, 2)
-	event := events[0]
+	require.Len(t, events, 3)
+	event := events[1]
 	require.Equal(t, "move-played", event.Type)
 	require.EqualValues(t, []sdk.Attribute{
 		{Key: "creator", Value: carol},
@@ -196,7 +248,8 @@ func TestPlayMove2Emitted(t *testing.T) {
 		{Key: "captured-y", Value: "-1"},
 		{Key: "winner", Value: "*"},
 		{Key: "board", Value: "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|*r******|**r*r*r*|*r*r*r*r|r*r*r*r*"},
-	}, event.Attributes[6:])
+		{Key: "move", Value: "21-17"},
+	}, event.Attributes[7:])
 }
 
 func TestPlayMoveEmitted(t *testing.T) {
@@ -214,8 +267,8 @@ func TestPlayMoveEmitted(t *testing.T) {
 
 	require.NotNil(t, ctx)
 	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
-	require.Len(t, events, 2)
-	event := events[0]
+	require.Len(t, events, 3)
+	event := events[1]
 	require.EqualValues(t, sdk.StringEvent{
 		Type: "move-played",
 		Attributes: []sdk.Attribute{
@@ -225,6 +278,7 @@ func TestPlayMoveEmitted(t *testing.T) {
 			{Key: "captured-y", Value: "-1"},
 			{Key: "winner", Value: "*"},
 			{Key: "board", Value: "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|********|r*r*r*r*|*r*r*r*r|r*r*r*r*"},
+			{Key: "move", Value: "9-14"},
 		},
 	}, event)
 }
@@ -249,25 +303,9 @@ func TestSavedPlayedDeadlineIsParseable(t *testing.T) {
 	require.Nil(t, err)
 }
 
-func TestPlayMoveCalledBank(t *testing.T) {
-	msgServer, _, context, ctrl, escrow, _ := setupMsgServerWithOneGameForPlayMoveWithMock(t)
-	defer ctrl.Finish()
-	escrow.ExpectPay(context, bob, 45).Times(1)
-	msgServer.PlayMove(context, &types.MsgPlayMove{
-		Creator:   bob,
-		GameIndex: "1",
-		FromX:     1,
-		FromY:     2,
-		ToX:       2,
-		ToY:       3,
-	})
-}
-
-func TestPlayMove2CalledBank(t *testing.T) {
-	msgServer, _, context, ctrl, escrow, _ := setupMsgServerWithOneGameForPlayMoveWithMock(t)
+func TestPlayMoveDoesNotCallBank(t *testing.T) {
+	msgServer, _, context, ctrl, _, _ := setupMsgServerWithOneGameForPlayMoveWithMock(t)
 	defer ctrl.Finish()
-	payBob := escrow.ExpectPay(context, bob, 45).Times(1)
-	escrow.ExpectPay(context, carol, 45).Times(1).After(payBob)
 	msgServer.PlayMove(context, &types.MsgPlayMove{
 		Creator:   bob,
 		GameIndex: "1",
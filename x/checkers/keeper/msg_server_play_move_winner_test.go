@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/satya/checkers/x/checkers/rules"
 	"github.com/satya/checkers/x/checkers/types"
 	"github.com/stretchr/testify/require"
 )
@@ -84,6 +85,18 @@ func playAllMoves(t *testing.T, msgServer types.MsgServer, context context.Conte
 	}
 }
 
+func getMoveHistory(t *testing.T, moves []GameMoveTest) (history []string) {
+	for _, move := range moves {
+		notation, err := rules.FormatStep(rules.Step{
+			Src: rules.Pos{X: int(move.fromX), Y: int(move.fromY)},
+			Dst: rules.Pos{X: int(move.toX), Y: int(move.toY)},
+		})
+		require.Nil(t, err)
+		history = append(history, notation)
+	}
+	return history
+}
+
 func TestPlayMoveUpToWinner(t *testing.T) {
 	msgServer, keeper, context, ctrl, escrow, board := setupMsgServerWithOneGameForPlayMoveWithMock(t)
 	ctx := sdk.UnwrapSDKContext(context)
@@ -99,6 +112,7 @@ func TestPlayMoveUpToWinner(t *testing.T) {
 		NextId:        2,
 		FifoHeadIndex: "-1",
 		FifoTailIndex: "-1",
+		NextMatchId:   1,
 	}, systemInfo)
 
 	game, found := keeper.GetStoredGame(ctx, "1")
@@ -114,12 +128,13 @@ func TestPlayMoveUpToWinner(t *testing.T) {
 		AfterIndex:  "-1",
 		Deadline:    types.FormatDeadline(ctx.BlockTime().Add(types.MaxTurnDuration)),
 		Winner:      "b",
-		Wager:       45,
-		Denom:       "stake",
+		Wager:       sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+		MoveHistory: getMoveHistory(t, game1Moves),
+		Organizer:   alice,
 	}, game)
 	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
-	require.Len(t, events, 2)
-	event := events[0]
+	require.Len(t, events, 4)
+	event := events[1]
 	require.Equal(t, event.Type, "move-played")
 	require.EqualValues(t, []sdk.Attribute{
 		{Key: "creator", Value: bob},
@@ -128,16 +143,27 @@ func TestPlayMoveUpToWinner(t *testing.T) {
 		{Key: "captured-y", Value: "5"},
 		{Key: "winner", Value: "b"},
 		{Key: "board", Value: "*b*b****|**b*b***|*****b**|********|***B****|********|*****b**|********"},
-	}, event.Attributes[(len(game1Moves)-1)*6:])
+		{Key: "move", Value: "25x18"},
+	}, event.Attributes[(len(game1Moves)-1)*7:])
+	require.EqualValues(t, sdk.StringEvent{
+		Type: "winnings-paid",
+		Attributes: []sdk.Attribute{
+			{Key: "game-index", Value: "1"},
+			{Key: "winner", Value: bob},
+			{Key: "winnings", Value: "90stake"},
+			{Key: "prize", Value: ""},
+			{Key: "rake", Value: ""},
+			{Key: "organizer", Value: alice},
+			{Key: "organizer-fee", Value: ""},
+		},
+	}, events[3])
 }
 
 func TestPlayMoveUpToWinnerCalledBank(t *testing.T) {
 	msgServer, _, context, ctrl, escrow, board := setupMsgServerWithOneGameForPlayMoveWithMock(t)
 	defer ctrl.Finish()
 	board.ExpectAny(context)
-	payBob := escrow.ExpectPay(context, bob, 45).Times(1)
-	payCarol := escrow.ExpectPay(context, carol, 45).Times(1).After(payBob)
-	escrow.ExpectRefund(context, bob, 90).Times(1).After(payCarol)
+	escrow.ExpectRefund(context, bob, 90).Times(1)
 
 	playAllMoves(t, msgServer, context, "1", game1Moves)
 }
@@ -0,0 +1,187 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/golang/mock/gomock"
+	keepertest "github.com/satya/checkers/testutil/keeper"
+	"github.com/satya/checkers/x/checkers"
+	"github.com/satya/checkers/x/checkers/keeper"
+	"github.com/satya/checkers/x/checkers/testutil"
+	"github.com/satya/checkers/x/checkers/types"
+	"github.com/stretchr/testify/require"
+)
+
+func setupMsgServerWithOneGameForPremove(t *testing.T) (types.MsgServer, keeper.Keeper, context.Context,
+	*gomock.Controller, *testutil.MockBankEscrowKeeper) {
+	ctrl := gomock.NewController(t)
+	bankMock := testutil.NewMockBankEscrowKeeper(ctrl)
+	k, ctx := keepertest.CheckersKeeperWithMocks(t, bankMock, nil)
+	checkers.InitGenesis(ctx, *k, *types.DefaultGenesis())
+	server := keeper.NewMsgServerImpl(*k)
+	context := sdk.WrapSDKContext(ctx)
+	_, err := server.CreateGame(context, &types.MsgCreateGame{
+		Creator: alice,
+		Black:   bob,
+		Red:     carol,
+		Wager:   sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+	})
+	require.Nil(t, err)
+	payBob := bankMock.ExpectPay(context, bob, 45)
+	bankMock.ExpectPay(context, carol, 45).After(payBob)
+	acceptGame(t, server, context, "1", bob, carol)
+	return server, *k, context, ctrl, bankMock
+}
+
+func playNotation(t *testing.T, msgServer types.MsgServer, context context.Context, creator string, move string) {
+	msgs, err := types.NewMsgPlayMovesFromNotation(creator, "1", move)
+	require.Nil(t, err)
+	for _, msg := range msgs {
+		_, err = msgServer.PlayMove(context, msg)
+		require.Nil(t, err)
+	}
+}
+
+func findEvent(t *testing.T, ctx sdk.Context, eventType string) sdk.StringEvent {
+	for _, event := range sdk.StringifyEvents(ctx.EventManager().ABCIEvents()) {
+		if event.Type == eventType {
+			return event
+		}
+	}
+	require.FailNow(t, "event not found", eventType)
+	return sdk.StringEvent{}
+}
+
+func TestSetPremoveStoresPremove(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithOneGameForPremove(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	escrow.ExpectPay(context, carol, 100).Times(1)
+
+	response, err := msgServer.SetPremove(context, types.NewMsgSetPremove(carol, "1", "11-15", "22-18", 100))
+	require.Nil(t, err)
+	require.EqualValues(t, types.MsgSetPremoveResponse{}, *response)
+	premove, found := keeper.GetPremove(ctx, "1")
+	require.True(t, found)
+	require.EqualValues(t, types.NewPremove("1", carol, 0, "11-15", "22-18", 100), premove)
+	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
+	require.Len(t, events, 3)
+	require.EqualValues(t, sdk.StringEvent{
+		Type: "premove-set",
+		Attributes: []sdk.Attribute{
+			{Key: "player", Value: carol},
+			{Key: "game-index", Value: "1"},
+			{Key: "if-move", Value: "11-15"},
+			{Key: "deposit", Value: "100"},
+		},
+	}, events[2])
+}
+
+func TestSetPremoveRejected(t *testing.T) {
+	msgServer, _, context, ctrl, _ := setupMsgServerWithOneGameForPremove(t)
+	defer ctrl.Finish()
+
+	_, err := msgServer.SetPremove(context, types.NewMsgSetPremove(bob, "1", "22-18", "11-15", 100))
+	require.EqualError(t, err, "it is the player's turn: premove is invalid: %s")
+	_, err = msgServer.SetPremove(context, types.NewMsgSetPremove(alice, "1", "11-15", "22-18", 100))
+	require.ErrorIs(t, err, types.ErrCreatorNotPlayer)
+	_, err = msgServer.SetPremove(context, types.NewMsgSetPremove(carol, "1", "15-19", "22-18", 100))
+	require.ErrorIs(t, err, types.ErrInvalidPremove)
+	_, err = msgServer.SetPremove(context, types.NewMsgSetPremove(carol, "1", "11-15", "18-14", 100))
+	require.ErrorIs(t, err, types.ErrInvalidPremove)
+	_, err = msgServer.SetPremove(context, types.NewMsgSetPremove(carol, "1", "", "", 0))
+	require.ErrorIs(t, err, types.ErrPremoveNotFound)
+}
+
+func TestSetPremoveClearRefunds(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithOneGameForPremove(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	gomock.InOrder(
+		escrow.ExpectPay(context, carol, 100).Times(1),
+		escrow.ExpectRefund(context, carol, 100).Times(1),
+	)
+
+	_, err := msgServer.SetPremove(context, types.NewMsgSetPremove(carol, "1", "11-15", "22-18", 100))
+	require.Nil(t, err)
+	_, err = msgServer.SetPremove(context, types.NewMsgSetPremove(carol, "1", "", "", 0))
+	require.Nil(t, err)
+	_, found := keeper.GetPremove(ctx, "1")
+	require.False(t, found)
+}
+
+func TestPremovePlayedAfterMatchingMove(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithOneGameForPremove(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	carolAddress, _ := sdk.AccAddressFromBech32(carol)
+	var fee, refund sdk.Coins
+	gomock.InOrder(
+		escrow.ExpectPay(context, carol, 100).Times(1),
+		escrow.EXPECT().SendCoinsFromModuleToModule(ctx, types.ModuleName, authtypes.FeeCollectorName, gomock.Any()).
+			Do(func(_ sdk.Context, _ string, _ string, amount sdk.Coins) { fee = amount }).Times(1),
+		escrow.EXPECT().SendCoinsFromModuleToAccount(ctx, types.ModuleName, carolAddress, gomock.Any()).
+			Do(func(_ sdk.Context, _ string, _ sdk.AccAddress, amount sdk.Coins) { refund = amount }).Times(1),
+	)
+
+	_, err := msgServer.SetPremove(context, types.NewMsgSetPremove(carol, "1", "11-15", "22-18", 100))
+	require.Nil(t, err)
+	playNotation(t, msgServer, context, bob, "11-15")
+
+	require.True(t, fee.IsAllPositive())
+	require.EqualValues(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), fee.Add(refund...))
+	_, found := keeper.GetPremove(ctx, "1")
+	require.False(t, found)
+	game, found := keeper.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	require.EqualValues(t, 2, game.MoveCount)
+	require.EqualValues(t, "b", game.Turn)
+	require.EqualValues(t, []string{"11-15", "22-18"}, game.MoveHistory)
+	played := findEvent(t, ctx, "premove-played")
+	require.EqualValues(t, sdk.Attribute{Key: "fee", Value: fee.AmountOf("stake").String()}, played.Attributes[3])
+}
+
+func TestPremoveClearedAfterOtherMove(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithOneGameForPremove(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	gomock.InOrder(
+		escrow.ExpectPay(context, carol, 100).Times(1),
+		escrow.ExpectRefund(context, carol, 100).Times(1),
+	)
+
+	_, err := msgServer.SetPremove(context, types.NewMsgSetPremove(carol, "1", "11-15", "22-18", 100))
+	require.Nil(t, err)
+	playNotation(t, msgServer, context, bob, "10-14")
+
+	_, found := keeper.GetPremove(ctx, "1")
+	require.False(t, found)
+	game, _ := keeper.GetStoredGame(ctx, "1")
+	require.EqualValues(t, 1, game.MoveCount)
+	require.EqualValues(t, "r", game.Turn)
+}
+
+func TestPremoveOutOfGasKeepsDeposit(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithOneGameForPremove(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	gomock.InOrder(
+		escrow.ExpectPay(context, carol, 1).Times(1),
+		escrow.ExpectPayFee(context, 1).Times(1),
+	)
+
+	_, err := msgServer.SetPremove(context, types.NewMsgSetPremove(carol, "1", "11-15", "22-18", 1))
+	require.Nil(t, err)
+	playNotation(t, msgServer, context, bob, "11-15")
+
+	_, found := keeper.GetPremove(ctx, "1")
+	require.False(t, found)
+	game, _ := keeper.GetStoredGame(ctx, "1")
+	require.EqualValues(t, 1, game.MoveCount)
+	require.EqualValues(t, "r", game.Turn)
+	require.EqualValues(t, []string{"11-15"}, game.MoveHistory)
+	findEvent(t, ctx, "premove-cleared")
+}
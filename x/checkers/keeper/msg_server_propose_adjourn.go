@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/satya/checkers/x/checkers/types"
+)
+
+func (k msgServer) ProposeAdjourn(goCtx context.Context, msg *types.MsgProposeAdjourn) (*types.MsgProposeAdjournResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	storedGame, err := k.Keeper.getAdjournGame(ctx, msg.GameIndex, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+	if storedGame.IsAdjourned() {
+		return nil, sdkerrors.Wrapf(types.ErrGameAdjourned, "%s", msg.GameIndex)
+	}
+	if storedGame.House != "" {
+		return nil, sdkerrors.Wrapf(types.ErrAdjournNotAllowed, "%s", "the house does not agree")
+	}
+	if _, found := k.Keeper.GetColourDraw(ctx, msg.GameIndex); found {
+		return nil, types.ErrColourDrawPending
+	}
+
+	storedGame.AdjournProposer = msg.Creator
+	k.Keeper.SetStoredGame(ctx, storedGame)
+
+	ctx.GasMeter().ConsumeGas(types.AdjournGas, "Propose adjourn")
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.AdjournProposedEventType,
+			sdk.NewAttribute(types.AdjournProposedEventPlayer, msg.Creator),
+			sdk.NewAttribute(types.AdjournProposedEventGameIndex, msg.GameIndex),
+		),
+	)
+
+	return &types.MsgProposeAdjournResponse{}, nil
+}
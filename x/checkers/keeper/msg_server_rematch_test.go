@@ -0,0 +1,150 @@
+package keeper_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+	keepertest "github.com/satya/checkers/testutil/keeper"
+	"github.com/satya/checkers/x/checkers"
+	"github.com/satya/checkers/x/checkers/keeper"
+	"github.com/satya/checkers/x/checkers/testutil"
+	"github.com/satya/checkers/x/checkers/types"
+	"github.com/stretchr/testify/require"
+)
+
+func setupMsgServerWithOneGameForRematch(t *testing.T) (types.MsgServer, keeper.Keeper, context.Context,
+	*gomock.Controller, *testutil.MockBankEscrowKeeper) {
+	ctrl := gomock.NewController(t)
+	bankMock := testutil.NewMockBankEscrowKeeper(ctrl)
+	k, ctx := keepertest.CheckersKeeperWithMocks(t, bankMock, nil)
+	checkers.InitGenesis(ctx, *k, *types.DefaultGenesis())
+	server := keeper.NewMsgServerImpl(*k)
+	context := sdk.WrapSDKContext(ctx)
+	payBob := bankMock.ExpectPay(context, bob, 45)
+	bankMock.ExpectPay(context, carol, 45).After(payBob)
+	_, err := server.CreateGame(context, &types.MsgCreateGame{
+		Creator: alice,
+		Black:   bob,
+		Red:     carol,
+		Wager:   sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+	})
+	require.Nil(t, err)
+	acceptGame(t, server, context, "1", bob, carol)
+	return server, *k, context, ctrl, bankMock
+}
+
+// finishGame ends the game with the given winner, as if the pot had been paid
+// out.
+func finishGame(keeper keeper.Keeper, ctx sdk.Context, gameIndex string, winner string) {
+	storedGame, _ := keeper.GetStoredGame(ctx, gameIndex)
+	systemInfo, _ := keeper.GetSystemInfo(ctx)
+	keeper.RemoveFromFifo(ctx, &storedGame, &systemInfo)
+	storedGame.Winner = winner
+	storedGame.Board = ""
+	storedGame.Escrowed = nil
+	keeper.SetStoredGame(ctx, storedGame)
+	keeper.SetSystemInfo(ctx, systemInfo)
+}
+
+func TestOfferRematchNeedsFinishedGame(t *testing.T) {
+	msgServer, keeper, context, ctrl, _ := setupMsgServerWithOneGameForRematch(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+
+	_, err := msgServer.OfferRematch(context, types.NewMsgOfferRematch(bob, "2"))
+	require.EqualError(t, err, "2: game by id not found")
+	_, err = msgServer.OfferRematch(context, types.NewMsgOfferRematch(bob, "1"))
+	require.ErrorIs(t, err, types.ErrGameNotFinished)
+
+	finishGame(keeper, ctx, "1", "r")
+	_, err = msgServer.OfferRematch(context, types.NewMsgOfferRematch(alice, "1"))
+	require.ErrorIs(t, err, types.ErrCreatorNotPlayer)
+	_, err = msgServer.AcceptRematch(context, types.NewMsgAcceptRematch(carol, "1"))
+	require.ErrorIs(t, err, types.ErrNoRematchOffer)
+}
+
+func TestOfferRematchNotAllowedInMatch(t *testing.T) {
+	msgServer, keeper, context, ctrl, _ := setupMsgServerWithOneGameForRematch(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	finishGame(keeper, ctx, "1", "r")
+	game, _ := keeper.GetStoredGame(ctx, "1")
+	game.MatchIndex = "1"
+	keeper.SetStoredGame(ctx, game)
+
+	_, err := msgServer.OfferRematch(context, types.NewMsgOfferRematch(bob, "1"))
+	require.EqualError(t, err, "the game is part of a match: rematch is not allowed: %s")
+}
+
+func TestRematchSwapsColoursAndEscrowsWagers(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithOneGameForRematch(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	finishGame(keeper, ctx, "1", "r")
+
+	response, err := msgServer.OfferRematch(context, types.NewMsgOfferRematch(bob, "1"))
+	require.Nil(t, err)
+	require.EqualValues(t, types.MsgOfferRematchResponse{}, *response)
+	game, _ := keeper.GetStoredGame(ctx, "1")
+	require.EqualValues(t, bob, game.RematchProposer)
+	event := findEvent(t, ctx, types.RematchOfferedEventType)
+	require.EqualValues(t, []sdk.Attribute{
+		{Key: "player", Value: bob},
+		{Key: "game-index", Value: "1"},
+	}, event.Attributes)
+
+	_, err = msgServer.AcceptRematch(context, types.NewMsgAcceptRematch(bob, "1"))
+	require.EqualError(t, err, "the opponent must accept: rematch is not allowed: %s")
+
+	payCarol := escrow.ExpectPay(context, carol, 45)
+	escrow.ExpectPay(context, bob, 45).After(payCarol)
+	acceptResponse, err := msgServer.AcceptRematch(context, types.NewMsgAcceptRematch(carol, "1"))
+	require.Nil(t, err)
+	require.EqualValues(t, types.MsgAcceptRematchResponse{GameIndex: "2"}, *acceptResponse)
+
+	rematch, found := keeper.GetStoredGame(ctx, "2")
+	require.True(t, found)
+	require.EqualValues(t, carol, rematch.Black)
+	require.EqualValues(t, bob, rematch.Red)
+	require.EqualValues(t, "1", rematch.PreviousGameIndex)
+	require.EqualValues(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 45)), rematch.Wager)
+	require.EqualValues(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 90)), rematch.Escrowed)
+	require.EqualValues(t, "", rematch.AcceptedBy)
+	require.EqualValues(t, "", rematch.Organizer)
+	require.EqualValues(t, "*", rematch.Winner)
+	require.False(t, rematch.Unrated)
+	game, _ = keeper.GetStoredGame(ctx, "1")
+	require.EqualValues(t, "", game.RematchProposer)
+	require.EqualValues(t, "2", game.RematchIndex)
+	systemInfo, _ := keeper.GetSystemInfo(ctx)
+	require.EqualValues(t, uint64(3), systemInfo.NextId)
+	require.EqualValues(t, "2", systemInfo.FifoHeadIndex)
+	require.EqualValues(t, "2", systemInfo.FifoTailIndex)
+	event = findEvent(t, ctx, types.RematchAcceptedEventType)
+	require.EqualValues(t, []sdk.Attribute{
+		{Key: "player", Value: carol},
+		{Key: "game-index", Value: "1"},
+		{Key: "rematch-index", Value: "2"},
+	}, event.Attributes)
+
+	_, err = msgServer.OfferRematch(context, types.NewMsgOfferRematch(carol, "1"))
+	require.EqualError(t, err, "2: game already has a rematch: %s")
+}
+
+func TestAcceptRematchFailsWhenPlayerCannotPay(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithOneGameForRematch(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	finishGame(keeper, ctx, "1", "b")
+	_, err := msgServer.OfferRematch(context, types.NewMsgOfferRematch(carol, "1"))
+	require.Nil(t, err)
+
+	escrow.ExpectPay(context, carol, 45).Return(errors.New("oops"))
+	_, err = msgServer.AcceptRematch(context, types.NewMsgAcceptRematch(bob, "1"))
+	require.EqualError(t, err, "black cannot pay the wager: oops")
+	_, found := keeper.GetStoredGame(ctx, "2")
+	require.False(t, found)
+}
@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/satya/checkers/x/checkers/types"
+)
+
+func (k msgServer) RequestTakeback(goCtx context.Context, msg *types.MsgRequestTakeback) (*types.MsgRequestTakebackResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	storedGame, err := k.Keeper.getTakebackGame(ctx, msg.GameIndex)
+	if err != nil {
+		return nil, err
+	}
+	player, err := getLastMover(storedGame, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+	// Check on a copy that the takeback would be possible.
+	restored := storedGame
+	_, err = restored.TakeBack(player)
+	if err != nil {
+		return nil, err
+	}
+
+	storedGame.TakebackRequester = msg.Creator
+	k.Keeper.SetStoredGame(ctx, storedGame)
+
+	ctx.GasMeter().ConsumeGas(types.TakebackGas, "Request takeback")
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.TakebackRequestedEventType,
+			sdk.NewAttribute(types.TakebackRequestedEventPlayer, msg.Creator),
+			sdk.NewAttribute(types.TakebackRequestedEventGameIndex, msg.GameIndex),
+		),
+	)
+
+	return &types.MsgRequestTakebackResponse{}, nil
+}
@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/satya/checkers/x/checkers/types"
+)
+
+func (k msgServer) ResumeGame(goCtx context.Context, msg *types.MsgResumeGame) (*types.MsgResumeGameResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	storedGame, err := k.Keeper.getAdjournGame(ctx, msg.GameIndex, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+	if !storedGame.IsAdjourned() {
+		return nil, sdkerrors.Wrapf(types.ErrGameNotAdjourned, "%s", msg.GameIndex)
+	}
+
+	storedGame.AdjournedUntil = ""
+	k.Keeper.resetDeadline(ctx, &storedGame)
+
+	ctx.GasMeter().ConsumeGas(types.AdjournGas, "Resume game")
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.GameResumedEventType,
+			sdk.NewAttribute(types.GameResumedEventPlayer, msg.Creator),
+			sdk.NewAttribute(types.GameResumedEventGameIndex, msg.GameIndex),
+			sdk.NewAttribute(types.GameResumedEventDeadline, storedGame.Deadline),
+		),
+	)
+
+	return &types.MsgResumeGameResponse{
+		Deadline: storedGame.Deadline,
+	}, nil
+}
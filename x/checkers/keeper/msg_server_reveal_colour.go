@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/satya/checkers/x/checkers/types"
+)
+
+func (k msgServer) RevealColour(goCtx context.Context, msg *types.MsgRevealColour) (*types.MsgRevealColourResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	draw, found := k.Keeper.GetColourDraw(ctx, msg.GameIndex)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrColourDrawNotFound, "%s", msg.GameIndex)
+	}
+	err := draw.Reveal(msg.Creator, msg.Secret)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.GasMeter().ConsumeGas(types.ColourDrawGas, "Reveal colour")
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.ColourRevealedEventType,
+			sdk.NewAttribute(types.ColourRevealedEventPlayer, msg.Creator),
+			sdk.NewAttribute(types.ColourRevealedEventGameIndex, msg.GameIndex),
+		),
+	)
+
+	if !draw.IsRevealed() {
+		k.Keeper.SetColourDraw(ctx, draw)
+		return &types.MsgRevealColourResponse{}, nil
+	}
+
+	storedGame, found := k.Keeper.GetStoredGame(ctx, msg.GameIndex)
+	if !found {
+		panic("Game of colour draw not found " + msg.GameIndex)
+	}
+	k.Keeper.startDrawnGame(ctx, &storedGame, draw)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.ColoursDrawnEventType,
+			sdk.NewAttribute(types.ColoursDrawnEventGameIndex, msg.GameIndex),
+			sdk.NewAttribute(types.ColoursDrawnEventBlack, storedGame.Black),
+			sdk.NewAttribute(types.ColoursDrawnEventRed, storedGame.Red),
+		),
+	)
+
+	return &types.MsgRevealColourResponse{
+		Black: storedGame.Black,
+	}, nil
+}
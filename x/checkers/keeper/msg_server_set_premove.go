@@ -0,0 +1,102 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/satya/checkers/x/checkers/rules"
+	"github.com/satya/checkers/x/checkers/types"
+)
+
+func (k msgServer) SetPremove(goCtx context.Context, msg *types.MsgSetPremove) (*types.MsgSetPremoveResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	storedGame, found := k.Keeper.GetStoredGame(ctx, msg.GameIndex)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrGameNotFound, "%s", msg.GameIndex)
+	}
+	if storedGame.Winner != rules.PieceStrings[rules.NO_PLAYER] {
+		return nil, types.ErrGameFinished
+	}
+	if _, found := k.Keeper.GetColourDraw(ctx, msg.GameIndex); found {
+		return nil, types.ErrColourDrawPending
+	}
+	if storedGame.House != "" {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidPremove, "%s", "the house replies at once")
+	}
+
+	var player rules.Player
+	if storedGame.Black == msg.Creator {
+		player = rules.BLACK_PLAYER
+	} else if storedGame.Red == msg.Creator {
+		player = rules.RED_PLAYER
+	} else {
+		return nil, sdkerrors.Wrapf(types.ErrCreatorNotPlayer, "%s", msg.Creator)
+	}
+	game, err := storedGame.ParseGame()
+	if err != nil {
+		panic(err.Error())
+	}
+	// This also rejects a player who plays against themselves.
+	if game.TurnIs(player) {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidPremove, "%s", "it is the player's turn")
+	}
+
+	previous, found := k.Keeper.GetPremove(ctx, msg.GameIndex)
+	if found {
+		k.Keeper.ClearPremove(ctx, &storedGame, previous)
+	}
+	if msg.IsClear() {
+		if !found {
+			return nil, sdkerrors.Wrapf(types.ErrPremoveNotFound, "%s", msg.GameIndex)
+		}
+		return &types.MsgSetPremoveResponse{}, nil
+	}
+
+	// Both moves are checked from the current position and stored as the
+	// paths actually played.
+	ifSteps, err := game.PlayNotation(msg.IfMove)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidPremove, "%s", err)
+	}
+	if !game.TurnIs(player) || game.Winner() != rules.NO_PLAYER {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidPremove, "%s", "the move does not hand over the turn")
+	}
+	replySteps, err := game.PlayNotation(msg.Reply)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidPremove, "%s", err)
+	}
+	ifMove, err := types.FormatSteps(ifSteps)
+	if err != nil {
+		panic(err.Error())
+	}
+	reply, err := types.FormatSteps(replySteps)
+	if err != nil {
+		panic(err.Error())
+	}
+
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err.Error())
+	}
+	err = k.Keeper.bank.SendCoinsFromAccountToModule(ctx, creator, types.ModuleName, types.PremoveCoins(msg.Deposit))
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, types.ErrCannotPayPremove.Error())
+	}
+	k.Keeper.SetPremove(ctx, types.NewPremove(msg.GameIndex, msg.Creator, storedGame.MoveCount, ifMove, reply, msg.Deposit))
+
+	ctx.GasMeter().ConsumeGas(types.PremoveGas, "Set premove")
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.PremoveSetEventType,
+			sdk.NewAttribute(types.PremoveSetEventPlayer, msg.Creator),
+			sdk.NewAttribute(types.PremoveSetEventGameIndex, msg.GameIndex),
+			sdk.NewAttribute(types.PremoveSetEventIfMove, ifMove),
+			sdk.NewAttribute(types.PremoveSetEventDeposit, strconv.FormatUint(msg.Deposit, 10)),
+		),
+	)
+
+	return &types.MsgSetPremoveResponse{}, nil
+}
@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/satya/checkers/x/checkers/types"
+)
+
+func (k msgServer) StartVacation(goCtx context.Context, msg *types.MsgStartVacation) (*types.MsgStartVacationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	vacation, found := k.Keeper.GetVacation(ctx, msg.Creator)
+	if !found {
+		vacation = types.NewVacation(msg.Creator)
+	}
+	if vacation.IsOnVacation() {
+		return nil, types.ErrOnVacation
+	}
+	vacation.ForYear(uint64(ctx.BlockTime().Year()))
+	remaining := vacation.GetRemaining(ctx.BlockTime())
+	if remaining <= 0 {
+		return nil, types.ErrVacationBudgetSpent
+	}
+
+	k.Keeper.StartVacation(ctx, &vacation)
+
+	ctx.GasMeter().ConsumeGas(types.VacationGas, "Start vacation")
+
+	return &types.MsgStartVacationResponse{
+		RemainingSeconds: uint64(remaining / time.Second),
+	}, nil
+}
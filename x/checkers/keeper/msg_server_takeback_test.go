@@ -0,0 +1,111 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+	keepertest "github.com/satya/checkers/testutil/keeper"
+	"github.com/satya/checkers/x/checkers"
+	"github.com/satya/checkers/x/checkers/keeper"
+	"github.com/satya/checkers/x/checkers/rules"
+	"github.com/satya/checkers/x/checkers/testutil"
+	"github.com/satya/checkers/x/checkers/types"
+	"github.com/stretchr/testify/require"
+)
+
+func setupMsgServerWithOneGameForTakeback(t *testing.T, wager int64) (types.MsgServer, keeper.Keeper, context.Context,
+	*gomock.Controller) {
+	ctrl := gomock.NewController(t)
+	bankMock := testutil.NewMockBankEscrowKeeper(ctrl)
+	k, ctx := keepertest.CheckersKeeperWithMocks(t, bankMock, nil)
+	checkers.InitGenesis(ctx, *k, *types.DefaultGenesis())
+	server := keeper.NewMsgServerImpl(*k)
+	context := sdk.WrapSDKContext(ctx)
+	bankMock.ExpectAny(context)
+	_, err := server.CreateGame(context, &types.MsgCreateGame{
+		Creator: alice,
+		Black:   bob,
+		Red:     carol,
+		Wager:   sdk.NewCoins(sdk.NewInt64Coin("stake", wager)),
+	})
+	require.Nil(t, err)
+	if 0 < wager {
+		acceptGame(t, server, context, "1", bob, carol)
+	}
+	return server, *k, context, ctrl
+}
+
+func TestTakebackRestoresGame(t *testing.T) {
+	msgServer, keeper, context, ctrl := setupMsgServerWithOneGameForTakeback(t, 0)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	playNotation(t, msgServer, context, bob, "11-15")
+	playNotation(t, msgServer, context, carol, "22-18")
+	before, _ := keeper.GetStoredGame(ctx, "1")
+	playNotation(t, msgServer, context, bob, "15x22")
+
+	_, err := msgServer.RequestTakeback(context, types.NewMsgRequestTakeback(bob, "1"))
+	require.Nil(t, err)
+	requested, _ := keeper.GetStoredGame(ctx, "1")
+	require.EqualValues(t, bob, requested.TakebackRequester)
+
+	later := ctx.WithBlockTime(ctx.BlockTime().Add(types.MaxTurnDuration / 2))
+	response, err := msgServer.AcceptTakeback(sdk.WrapSDKContext(later), types.NewMsgAcceptTakeback(carol, "1"))
+	require.Nil(t, err)
+	require.EqualValues(t, types.MsgAcceptTakebackResponse{Undone: 1}, *response)
+	game, _ := keeper.GetStoredGame(ctx, "1")
+	require.EqualValues(t, before.Board, game.Board)
+	require.EqualValues(t, "b", game.Turn)
+	require.EqualValues(t, 2, game.MoveCount)
+	require.EqualValues(t, []string{"11-15", "22-18"}, game.MoveHistory)
+	require.EqualValues(t, "", game.TakebackRequester)
+	require.EqualValues(t, types.FormatDeadline(types.GetNextDeadline(later)), game.Deadline)
+	systemInfo, _ := keeper.GetSystemInfo(ctx)
+	require.EqualValues(t, "1", systemInfo.FifoTailIndex)
+
+	playNotation(t, msgServer, context, bob, "15x22")
+	game, _ = keeper.GetStoredGame(ctx, "1")
+	require.EqualValues(t, rules.PieceStrings[rules.RED_PLAYER], game.Turn)
+	require.EqualValues(t, 3, game.MoveCount)
+}
+
+func TestTakebackRequestLapsesOnMove(t *testing.T) {
+	msgServer, _, context, ctrl := setupMsgServerWithOneGameForTakeback(t, 0)
+	defer ctrl.Finish()
+	playNotation(t, msgServer, context, bob, "11-15")
+	_, err := msgServer.RequestTakeback(context, types.NewMsgRequestTakeback(bob, "1"))
+	require.Nil(t, err)
+	playNotation(t, msgServer, context, carol, "22-18")
+
+	_, err = msgServer.AcceptTakeback(context, types.NewMsgAcceptTakeback(bob, "1"))
+	require.ErrorIs(t, err, types.ErrNoTakebackRequest)
+}
+
+func TestTakebackRejected(t *testing.T) {
+	msgServer, _, context, ctrl := setupMsgServerWithOneGameForTakeback(t, 0)
+	defer ctrl.Finish()
+	playNotation(t, msgServer, context, bob, "11-15")
+
+	_, err := msgServer.AcceptTakeback(context, types.NewMsgAcceptTakeback(carol, "1"))
+	require.ErrorIs(t, err, types.ErrNoTakebackRequest)
+	_, err = msgServer.RequestTakeback(context, types.NewMsgRequestTakeback(carol, "1"))
+	require.EqualError(t, err, "the player did not play the last move: takeback is not allowed: %s")
+	_, err = msgServer.RequestTakeback(context, types.NewMsgRequestTakeback(alice, "1"))
+	require.ErrorIs(t, err, types.ErrCreatorNotPlayer)
+	_, err = msgServer.RequestTakeback(context, types.NewMsgRequestTakeback(bob, "1"))
+	require.Nil(t, err)
+	_, err = msgServer.AcceptTakeback(context, types.NewMsgAcceptTakeback(bob, "1"))
+	require.ErrorIs(t, err, types.ErrCreatorNotPlayer)
+}
+
+func TestTakebackNotInRatedGameWithWager(t *testing.T) {
+	msgServer, _, context, ctrl := setupMsgServerWithOneGameForTakeback(t, 45)
+	defer ctrl.Finish()
+	playNotation(t, msgServer, context, bob, "11-15")
+	playNotation(t, msgServer, context, carol, "22-18")
+
+	_, err := msgServer.RequestTakeback(context, types.NewMsgRequestTakeback(carol, "1"))
+	require.EqualError(t, err, "the game is rated and has a wager: takeback is not allowed: %s")
+}
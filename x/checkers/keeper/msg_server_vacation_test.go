@@ -0,0 +1,213 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+	keepertest "github.com/satya/checkers/testutil/keeper"
+	"github.com/satya/checkers/x/checkers"
+	"github.com/satya/checkers/x/checkers/keeper"
+	"github.com/satya/checkers/x/checkers/testutil"
+	"github.com/satya/checkers/x/checkers/types"
+	"github.com/stretchr/testify/require"
+)
+
+func setupMsgServerWithOneGameForVacation(t testing.TB) (types.MsgServer, keeper.Keeper, sdk.Context,
+	*gomock.Controller) {
+	ctrl := gomock.NewController(t)
+	bankMock := testutil.NewMockBankEscrowKeeper(ctrl)
+	bankMock.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	k, ctx := keepertest.CheckersKeeperWithMocks(t, bankMock, nil)
+	ctx = ctx.WithBlockTime(time.Date(2026, time.March, 1, 0, 0, 0, 0, time.UTC))
+	checkers.InitGenesis(ctx, *k, *types.DefaultGenesis())
+	server := keeper.NewMsgServerImpl(*k)
+	_, err := server.CreateGame(sdk.WrapSDKContext(ctx), &types.MsgCreateGame{
+		Creator: alice,
+		Black:   bob,
+		Red:     carol,
+	})
+	require.Nil(t, err)
+	return server, *k, ctx, ctrl
+}
+
+func at(ctx sdk.Context, elapsed time.Duration) (sdk.Context, context.Context) {
+	later := ctx.WithBlockTime(ctx.BlockTime().Add(elapsed))
+	return later, sdk.WrapSDKContext(later)
+}
+
+func TestVacationFreezesGameToMove(t *testing.T) {
+	msgServer, keeper, ctx, ctrl := setupMsgServerWithOneGameForVacation(t)
+	defer ctrl.Finish()
+
+	startCtx, start := at(ctx, time.Minute)
+	response, err := msgServer.StartVacation(start, types.NewMsgStartVacation(bob))
+	require.Nil(t, err)
+	require.EqualValues(t, types.MsgStartVacationResponse{RemainingSeconds: 30 * 24 * 3600}, *response)
+	game, _ := keeper.GetStoredGame(ctx, "1")
+	require.EqualValues(t, "4m0s", game.FrozenRemaining)
+	systemInfo, _ := keeper.GetSystemInfo(ctx)
+	require.EqualValues(t, types.NoFifoIndex, systemInfo.FifoHeadIndex)
+	vacation, _ := keeper.GetVacation(ctx, bob)
+	require.EqualValues(t, types.Vacation{
+		Index:       bob,
+		Year:        2026,
+		Start:       types.FormatDeadline(startCtx.BlockTime()),
+		FrozenGames: []string{"1"},
+	}, vacation)
+
+	_, end := at(ctx, time.Hour)
+	keeper.ForfeitExpiredGames(end)
+	game, _ = keeper.GetStoredGame(ctx, "1")
+	require.EqualValues(t, "*", game.Winner)
+
+	endCtx, end := at(ctx, time.Hour)
+	endResponse, err := msgServer.EndVacation(end, types.NewMsgEndVacation(bob))
+	require.Nil(t, err)
+	require.EqualValues(t, types.MsgEndVacationResponse{UsedSeconds: 59 * 60}, *endResponse)
+	game, _ = keeper.GetStoredGame(ctx, "1")
+	require.EqualValues(t, "", game.FrozenRemaining)
+	require.EqualValues(t, types.FormatDeadline(endCtx.BlockTime().Add(4*time.Minute)), game.Deadline)
+	systemInfo, _ = keeper.GetSystemInfo(ctx)
+	require.EqualValues(t, "1", systemInfo.FifoHeadIndex)
+	vacation, _ = keeper.GetVacation(ctx, bob)
+	require.EqualValues(t, types.Vacation{Index: bob, Year: 2026, UsedSeconds: 59 * 60}, vacation)
+	require.Empty(t, keeper.GetAllActiveVacation(ctx))
+}
+
+func TestVacationFreezesGameOnOpponentMove(t *testing.T) {
+	msgServer, keeper, ctx, ctrl := setupMsgServerWithOneGameForVacation(t)
+	defer ctrl.Finish()
+	playNotation(t, msgServer, sdk.WrapSDKContext(ctx), bob, "11-15")
+
+	_, start := at(ctx, time.Minute)
+	_, err := msgServer.StartVacation(start, types.NewMsgStartVacation(bob))
+	require.Nil(t, err)
+	game, _ := keeper.GetStoredGame(ctx, "1")
+	require.EqualValues(t, "", game.FrozenRemaining)
+
+	_, move := at(ctx, 2*time.Minute)
+	playNotation(t, msgServer, move, carol, "22-18")
+	game, _ = keeper.GetStoredGame(ctx, "1")
+	require.EqualValues(t, "5m0s", game.FrozenRemaining)
+	vacation, _ := keeper.GetVacation(ctx, bob)
+	require.EqualValues(t, []string{"1"}, vacation.FrozenGames)
+
+	// Playing while on vacation unfreezes that game.
+	_, move = at(ctx, 3*time.Minute)
+	playNotation(t, msgServer, move, bob, "15x22")
+	game, _ = keeper.GetStoredGame(ctx, "1")
+	require.EqualValues(t, "", game.FrozenRemaining)
+	vacation, _ = keeper.GetVacation(ctx, bob)
+	require.Empty(t, vacation.FrozenGames)
+	require.True(t, vacation.IsOnVacation())
+}
+
+func TestVacationFreezesEveryGameOfPlayer(t *testing.T) {
+	msgServer, keeper, ctx, ctrl := setupMsgServerWithOneGameForVacation(t)
+	defer ctrl.Finish()
+	_, err := msgServer.CreateGame(sdk.WrapSDKContext(ctx), &types.MsgCreateGame{
+		Creator: alice,
+		Black:   carol,
+		Red:     bob,
+	})
+	require.Nil(t, err)
+
+	_, start := at(ctx, time.Minute)
+	_, err = msgServer.StartVacation(start, types.NewMsgStartVacation(bob))
+	require.Nil(t, err)
+	vacation, _ := keeper.GetVacation(ctx, bob)
+	require.EqualValues(t, []string{"1"}, vacation.FrozenGames)
+
+	// Carol's clock runs until she moves, then bob's is frozen at once.
+	_, move := at(ctx, 2*time.Minute)
+	msgs, err := types.NewMsgPlayMovesFromNotation(carol, "2", "11-15")
+	require.Nil(t, err)
+	_, err = msgServer.PlayMove(move, msgs[0])
+	require.Nil(t, err)
+	game2, _ := keeper.GetStoredGame(ctx, "2")
+	require.EqualValues(t, "5m0s", game2.FrozenRemaining)
+	vacation, _ = keeper.GetVacation(ctx, bob)
+	require.EqualValues(t, []string{"1", "2"}, vacation.FrozenGames)
+	systemInfo, _ := keeper.GetSystemInfo(ctx)
+	require.EqualValues(t, types.NoFifoIndex, systemInfo.FifoHeadIndex)
+
+	// Neither game can be forfeited on bob's clock.
+	_, later := at(ctx, time.Hour)
+	keeper.ForfeitExpiredGames(later)
+	game1, _ := keeper.GetStoredGame(ctx, "1")
+	require.EqualValues(t, "*", game1.Winner)
+	game2, _ = keeper.GetStoredGame(ctx, "2")
+	require.EqualValues(t, "*", game2.Winner)
+
+	endCtx, end := at(ctx, time.Hour)
+	endResponse, err := msgServer.EndVacation(end, types.NewMsgEndVacation(bob))
+	require.Nil(t, err)
+	require.EqualValues(t, 59*60, endResponse.UsedSeconds)
+	game1, _ = keeper.GetStoredGame(ctx, "1")
+	require.EqualValues(t, types.FormatDeadline(endCtx.BlockTime().Add(4*time.Minute)), game1.Deadline)
+	game2, _ = keeper.GetStoredGame(ctx, "2")
+	require.EqualValues(t, "", game2.FrozenRemaining)
+	require.EqualValues(t, types.FormatDeadline(endCtx.BlockTime().Add(5*time.Minute)), game2.Deadline)
+}
+
+func TestVacationResumesInDeadlineOrder(t *testing.T) {
+	msgServer, keeper, ctx, ctrl := setupMsgServerWithOneGameForVacation(t)
+	defer ctrl.Finish()
+
+	_, start := at(ctx, time.Minute)
+	_, err := msgServer.StartVacation(start, types.NewMsgStartVacation(bob))
+	require.Nil(t, err)
+	_, create := at(ctx, 2*time.Minute)
+	_, err = msgServer.CreateGame(create, &types.MsgCreateGame{
+		Creator: alice,
+		Black:   carol,
+		Red:     alice,
+	})
+	require.Nil(t, err)
+
+	_, err = msgServer.EndVacation(create, types.NewMsgEndVacation(bob))
+	require.Nil(t, err)
+	systemInfo, _ := keeper.GetSystemInfo(ctx)
+	require.EqualValues(t, "1", systemInfo.FifoHeadIndex)
+	require.EqualValues(t, "2", systemInfo.FifoTailIndex)
+	game1, _ := keeper.GetStoredGame(ctx, "1")
+	require.EqualValues(t, types.NoFifoIndex, game1.BeforeIndex)
+	require.EqualValues(t, "2", game1.AfterIndex)
+	game2, _ := keeper.GetStoredGame(ctx, "2")
+	require.EqualValues(t, "1", game2.BeforeIndex)
+	require.EqualValues(t, types.NoFifoIndex, game2.AfterIndex)
+}
+
+func TestVacationBudgetRunsOut(t *testing.T) {
+	msgServer, keeper, ctx, ctrl := setupMsgServerWithOneGameForVacation(t)
+	defer ctrl.Finish()
+
+	_, err := msgServer.EndVacation(sdk.WrapSDKContext(ctx), types.NewMsgEndVacation(bob))
+	require.ErrorIs(t, err, types.ErrNotOnVacation)
+	_, err = msgServer.StartVacation(sdk.WrapSDKContext(ctx), types.NewMsgStartVacation(bob))
+	require.Nil(t, err)
+	_, err = msgServer.StartVacation(sdk.WrapSDKContext(ctx), types.NewMsgStartVacation(bob))
+	require.ErrorIs(t, err, types.ErrOnVacation)
+
+	_, before := at(ctx, types.VacationBudget-time.Second)
+	keeper.EndSpentVacations(before)
+	vacation, _ := keeper.GetVacation(ctx, bob)
+	require.True(t, vacation.IsOnVacation())
+
+	spentCtx, spent := at(ctx, types.VacationBudget)
+	keeper.EndSpentVacations(spent)
+	vacation, _ = keeper.GetVacation(ctx, bob)
+	require.False(t, vacation.IsOnVacation())
+	require.EqualValues(t, 30*24*3600, vacation.UsedSeconds)
+	game, _ := keeper.GetStoredGame(ctx, "1")
+	require.EqualValues(t, types.FormatDeadline(spentCtx.BlockTime().Add(types.MaxTurnDuration)), game.Deadline)
+
+	_, err = msgServer.StartVacation(spent, types.NewMsgStartVacation(bob))
+	require.ErrorIs(t, err, types.ErrVacationBudgetSpent)
+	_, nextYear := at(ctx, 365*24*time.Hour)
+	_, err = msgServer.StartVacation(nextYear, types.NewMsgStartVacation(bob))
+	require.Nil(t, err)
+}
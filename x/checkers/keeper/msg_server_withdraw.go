@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/satya/checkers/x/checkers/types"
+)
+
+func (k msgServer) Withdraw(goCtx context.Context, msg *types.MsgWithdraw) (*types.MsgWithdrawResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	playerBalance, err := k.Keeper.DebitPlayerBalance(ctx, msg.Creator, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+	// Withdrawing everything closes the balance, so that payouts go to the bank
+	// account again.
+	if playerBalance.Funds.IsZero() {
+		k.Keeper.RemovePlayerBalance(ctx, msg.Creator)
+	}
+	withdrawer, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err.Error())
+	}
+	err = k.bank.SendCoinsFromModuleToAccount(ctx, types.ModuleName, withdrawer, msg.Amount)
+	if err != nil {
+		panic(err.Error())
+	}
+
+	ctx.GasMeter().ConsumeGas(types.WithdrawGas, "Withdraw")
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.BalanceWithdrawnEventType,
+			sdk.NewAttribute(types.BalanceWithdrawnEventPlayer, msg.Creator),
+			sdk.NewAttribute(types.BalanceWithdrawnEventAmount, msg.Amount.String()),
+			sdk.NewAttribute(types.BalanceWithdrawnEventBalance, playerBalance.Funds.String()),
+		),
+	)
+
+	return &types.MsgWithdrawResponse{
+		Balance: playerBalance.Funds,
+	}, nil
+}
@@ -7,10 +7,53 @@ import (
 
 // GetParams get all parameters as types.Params
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
-	return types.NewParams()
+	return types.NewParams(
+		k.AllowedDenoms(ctx),
+		k.AllowedVouchers(ctx),
+		k.PlatformRakeBps(ctx),
+		k.RakeDestination(ctx),
+		k.OrganizerFeeBps(ctx),
+		k.BetCutoffMove(ctx),
+	)
 }
 
 // SetParams set the params
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	k.paramstore.SetParamSet(ctx, &params)
 }
+
+// AllowedDenoms returns the AllowedDenoms param
+func (k Keeper) AllowedDenoms(ctx sdk.Context) (res []string) {
+	k.paramstore.Get(ctx, types.KeyAllowedDenoms, &res)
+	return
+}
+
+// AllowedVouchers returns the AllowedVouchers param
+func (k Keeper) AllowedVouchers(ctx sdk.Context) (res []types.AllowedVoucher) {
+	k.paramstore.Get(ctx, types.KeyAllowedVouchers, &res)
+	return
+}
+
+// PlatformRakeBps returns the PlatformRakeBps param
+func (k Keeper) PlatformRakeBps(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyPlatformRakeBps, &res)
+	return
+}
+
+// RakeDestination returns the RakeDestination param
+func (k Keeper) RakeDestination(ctx sdk.Context) (res string) {
+	k.paramstore.Get(ctx, types.KeyRakeDestination, &res)
+	return
+}
+
+// OrganizerFeeBps returns the OrganizerFeeBps param
+func (k Keeper) OrganizerFeeBps(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyOrganizerFeeBps, &res)
+	return
+}
+
+// BetCutoffMove returns the BetCutoffMove param
+func (k Keeper) BetCutoffMove(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyBetCutoffMove, &res)
+	return
+}
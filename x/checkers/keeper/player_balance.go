@@ -0,0 +1,89 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/satya/checkers/x/checkers/types"
+)
+
+// SetPlayerBalance set a specific playerBalance in the store from its index
+func (k Keeper) SetPlayerBalance(ctx sdk.Context, playerBalance types.PlayerBalance) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PlayerBalanceKeyPrefix))
+	b := k.cdc.MustMarshal(&playerBalance)
+	store.Set(types.PlayerBalanceKey(
+		playerBalance.Index,
+	), b)
+}
+
+// GetPlayerBalance returns a playerBalance from its index
+func (k Keeper) GetPlayerBalance(
+	ctx sdk.Context,
+	index string,
+
+) (val types.PlayerBalance, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PlayerBalanceKeyPrefix))
+
+	b := store.Get(types.PlayerBalanceKey(
+		index,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemovePlayerBalance removes a playerBalance from the store
+func (k Keeper) RemovePlayerBalance(
+	ctx sdk.Context,
+	index string,
+
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PlayerBalanceKeyPrefix))
+	store.Delete(types.PlayerBalanceKey(
+		index,
+	))
+}
+
+// GetAllPlayerBalance returns all playerBalance
+func (k Keeper) GetAllPlayerBalance(ctx sdk.Context) (list []types.PlayerBalance) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PlayerBalanceKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.PlayerBalance
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// DebitPlayerBalance takes coins out of the internal balance of a player. The
+// coins stay in the module account. The balance is kept even when emptied, so
+// that the payouts of the player keep being credited to it.
+func (k Keeper) DebitPlayerBalance(ctx sdk.Context, player string, amount sdk.Coins) (types.PlayerBalance, error) {
+	playerBalance, found := k.GetPlayerBalance(ctx, player)
+	if !found || !playerBalance.Funds.IsAllGTE(amount) {
+		return playerBalance, sdkerrors.Wrapf(types.ErrBalanceTooLow, "%s", playerBalance.Funds)
+	}
+	playerBalance.Funds = playerBalance.Funds.Sub(amount)
+	k.SetPlayerBalance(ctx, playerBalance)
+	return playerBalance, nil
+}
+
+// CreditPlayerBalance adds coins, already in the module account, to the
+// internal balance of a player, which it creates if needed.
+func (k Keeper) CreditPlayerBalance(ctx sdk.Context, player string, amount sdk.Coins) types.PlayerBalance {
+	playerBalance, found := k.GetPlayerBalance(ctx, player)
+	if !found {
+		playerBalance = types.PlayerBalance{Index: player, Funds: sdk.NewCoins()}
+	}
+	playerBalance.Funds = playerBalance.Funds.Add(amount...)
+	k.SetPlayerBalance(ctx, playerBalance)
+	return playerBalance
+}
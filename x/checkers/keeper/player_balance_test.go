@@ -0,0 +1,99 @@
+package keeper_test
+
+import (
+	"strconv"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/satya/checkers/testutil/keeper"
+	"github.com/satya/checkers/testutil/nullify"
+	"github.com/satya/checkers/x/checkers/keeper"
+	"github.com/satya/checkers/x/checkers/types"
+	"github.com/stretchr/testify/require"
+)
+
+// Prevent strconv unused error
+var _ = strconv.IntSize
+
+func createNPlayerBalance(keeper *keeper.Keeper, ctx sdk.Context, n int) []types.PlayerBalance {
+	items := make([]types.PlayerBalance, n)
+	for i := range items {
+		items[i].Index = strconv.Itoa(i)
+
+		keeper.SetPlayerBalance(ctx, items[i])
+	}
+	return items
+}
+
+func TestPlayerBalanceGet(t *testing.T) {
+	keeper, ctx := keepertest.CheckersKeeper(t)
+	items := createNPlayerBalance(keeper, ctx, 10)
+	for _, item := range items {
+		rst, found := keeper.GetPlayerBalance(ctx,
+			item.Index,
+		)
+		require.True(t, found)
+		require.Equal(t,
+			nullify.Fill(&item),
+			nullify.Fill(&rst),
+		)
+	}
+}
+func TestPlayerBalanceRemove(t *testing.T) {
+	keeper, ctx := keepertest.CheckersKeeper(t)
+	items := createNPlayerBalance(keeper, ctx, 10)
+	for _, item := range items {
+		keeper.RemovePlayerBalance(ctx,
+			item.Index,
+		)
+		_, found := keeper.GetPlayerBalance(ctx,
+			item.Index,
+		)
+		require.False(t, found)
+	}
+}
+
+func TestPlayerBalanceGetAll(t *testing.T) {
+	keeper, ctx := keepertest.CheckersKeeper(t)
+	items := createNPlayerBalance(keeper, ctx, 10)
+	require.ElementsMatch(t,
+		nullify.Fill(items),
+		nullify.Fill(keeper.GetAllPlayerBalance(ctx)),
+	)
+}
+
+func TestPlayerBalanceCreditCreates(t *testing.T) {
+	keeper, ctx := keepertest.CheckersKeeper(t)
+	credited := keeper.CreditPlayerBalance(ctx, alice, sdk.NewCoins(sdk.NewInt64Coin("stake", 45)))
+	require.Equal(t, types.PlayerBalance{
+		Index: alice,
+		Funds: sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+	}, credited)
+	credited = keeper.CreditPlayerBalance(ctx, alice, sdk.NewCoins(sdk.NewInt64Coin("stake", 5), sdk.NewInt64Coin("token", 3)))
+	stored, found := keeper.GetPlayerBalance(ctx, alice)
+	require.True(t, found)
+	require.Equal(t, credited, stored)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 50), sdk.NewInt64Coin("token", 3)), stored.Funds)
+}
+
+func TestPlayerBalanceDebitKeepsEmptied(t *testing.T) {
+	keeper, ctx := keepertest.CheckersKeeper(t)
+	keeper.CreditPlayerBalance(ctx, alice, sdk.NewCoins(sdk.NewInt64Coin("stake", 45)))
+	debited, err := keeper.DebitPlayerBalance(ctx, alice, sdk.NewCoins(sdk.NewInt64Coin("stake", 45)))
+	require.Nil(t, err)
+	require.True(t, debited.Funds.IsZero())
+	stored, found := keeper.GetPlayerBalance(ctx, alice)
+	require.True(t, found)
+	require.True(t, stored.Funds.IsZero())
+}
+
+func TestPlayerBalanceDebitTooMuch(t *testing.T) {
+	keeper, ctx := keepertest.CheckersKeeper(t)
+	keeper.CreditPlayerBalance(ctx, alice, sdk.NewCoins(sdk.NewInt64Coin("stake", 45)))
+	_, err := keeper.DebitPlayerBalance(ctx, alice, sdk.NewCoins(sdk.NewInt64Coin("stake", 46)))
+	require.EqualError(t, err, "45stake: internal balance is too low: %s")
+	stored, _ := keeper.GetPlayerBalance(ctx, alice)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 45)), stored.Funds)
+	_, err = keeper.DebitPlayerBalance(ctx, bob, sdk.NewCoins(sdk.NewInt64Coin("stake", 1)))
+	require.EqualError(t, err, ": internal balance is too low: %s")
+}
@@ -33,12 +33,18 @@ func getWinnerAndLoserAddresses(storedGame *types.StoredGame) (winnerAddress sdk
 }
 
 func (k *Keeper) MustRegisterPlayerWin(ctx sdk.Context, storedGame *types.StoredGame) {
+	if storedGame.Unrated {
+		return
+	}
 	winnerAddress, loserAddress := getWinnerAndLoserAddresses(storedGame)
 	k.board.MustAddWonGameResultToPlayer(ctx, winnerAddress)
 	k.board.MustAddLostGameResultToPlayer(ctx, loserAddress)
 }
 
 func (k *Keeper) MustRegisterPlayerForfeit(ctx sdk.Context, storedGame *types.StoredGame) {
+	if storedGame.Unrated {
+		return
+	}
 	winnerAddress, loserAddress := getWinnerAndLoserAddresses(storedGame)
 	k.board.MustAddWonGameResultToPlayer(ctx, winnerAddress)
 	k.board.MustAddForfeitedGameResultToPlayer(ctx, loserAddress)
@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/satya/checkers/x/checkers/types"
+)
+
+// SetPremove set a specific premove in the store from its index, and keeps
+// track of the deposit locked in it
+func (k Keeper) SetPremove(ctx sdk.Context, premove types.Premove) {
+	previous, _ := k.GetPremove(ctx, premove.Index)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PremoveKeyPrefix))
+	b := k.cdc.MustMarshal(&premove)
+	store.Set(types.PremoveKey(
+		premove.Index,
+	), b)
+	k.indexGameEscrows(ctx, premove.Index, previous.Player, premove.Player)
+}
+
+// GetPremove returns a premove from its index
+func (k Keeper) GetPremove(
+	ctx sdk.Context,
+	index string,
+
+) (val types.Premove, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PremoveKeyPrefix))
+
+	b := store.Get(types.PremoveKey(
+		index,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemovePremove removes a premove from the store
+func (k Keeper) RemovePremove(
+	ctx sdk.Context,
+	index string,
+
+) {
+	previous, _ := k.GetPremove(ctx, index)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PremoveKeyPrefix))
+	store.Delete(types.PremoveKey(
+		index,
+	))
+	k.indexGameEscrows(ctx, index, previous.Player)
+}
+
+// GetAllPremove returns all premove
+func (k Keeper) GetAllPremove(ctx sdk.Context) (list []types.Premove) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PremoveKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Premove
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
@@ -0,0 +1,130 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/satya/checkers/x/checkers/rules"
+	"github.com/satya/checkers/x/checkers/types"
+)
+
+// PlayPremove is called after each step of a move. When the opponent of a
+// premove setter has completed the expected move, it plays the reply for the
+// setter. A premove that can no longer match is cleared.
+func (k Keeper) PlayPremove(ctx sdk.Context, storedGame *types.StoredGame, game *rules.Game) {
+	premove, found := k.GetPremove(ctx, storedGame.Index)
+	if !found {
+		return
+	}
+	if storedGame.Winner != rules.PieceStrings[rules.NO_PLAYER] {
+		k.ClearPremove(ctx, storedGame, premove)
+		return
+	}
+	prefix, whole, err := premove.Matches(storedGame.MoveHistory[premove.MoveCount:])
+	if err != nil {
+		panic(err.Error())
+	}
+	if !prefix {
+		k.ClearPremove(ctx, storedGame, premove)
+		return
+	}
+	if whole {
+		k.playPremoveReply(ctx, storedGame, game, premove)
+	}
+}
+
+// playPremoveReply plays the reply in a cached context, so that a reply that
+// fails leaves no trace, and on its own gas meter, limited by the deposit. The
+// gas used is paid from the deposit either way.
+func (k Keeper) playPremoveReply(ctx sdk.Context, storedGame *types.StoredGame, game *rules.Game, premove types.Premove) {
+	gasMeter := sdk.NewGasMeter(premove.GetGasLimit())
+	replyCtx, write := ctx.CacheContext()
+	replyCtx = replyCtx.WithGasMeter(gasMeter)
+	replyStoredGame, replyGame := *storedGame, game.Copy()
+
+	err := k.tryPlayReply(replyCtx, &replyStoredGame, replyGame, premove)
+	if err == nil {
+		write()
+		ctx.EventManager().EmitEvents(replyCtx.EventManager().Events())
+		*storedGame, *game = replyStoredGame, *replyGame
+	}
+
+	gasUsed := gasMeter.GasConsumedToLimit()
+	fee := premove.GetFee(gasUsed)
+	k.settlePremove(ctx, storedGame, premove, fee)
+	if err != nil {
+		k.emitPremoveCleared(ctx, premove)
+		return
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.PremovePlayedEventType,
+			sdk.NewAttribute(types.PremovePlayedEventPlayer, premove.Player),
+			sdk.NewAttribute(types.PremovePlayedEventGameIndex, premove.Index),
+			sdk.NewAttribute(types.PremovePlayedEventGasUsed, strconv.FormatUint(gasUsed, 10)),
+			sdk.NewAttribute(types.PremovePlayedEventFee, strconv.FormatUint(fee, 10)),
+		),
+	)
+}
+
+func (k Keeper) tryPlayReply(ctx sdk.Context, storedGame *types.StoredGame, game *rules.Game, premove types.Premove) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(sdk.ErrorOutOfGas); !ok {
+				panic(r)
+			}
+			err = sdkerrors.Wrapf(sdkerrors.ErrOutOfGas, "premove deposit of %d", premove.Deposit)
+		}
+	}()
+	ctx.GasMeter().ConsumeGas(types.PremoveGas, "Play premove")
+	steps, err := game.Copy().PlayNotation(premove.Reply)
+	if err != nil {
+		return sdkerrors.Wrapf(types.ErrWrongMove, err.Error())
+	}
+	for _, step := range steps {
+		_, err = k.PlayStep(ctx, storedGame, game, premove.Player, step)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClearPremove removes a premove that was not played and refunds its deposit.
+func (k Keeper) ClearPremove(ctx sdk.Context, storedGame *types.StoredGame, premove types.Premove) {
+	k.settlePremove(ctx, storedGame, premove, 0)
+	k.emitPremoveCleared(ctx, premove)
+}
+
+// settlePremove removes a premove, pays the fee out of its deposit to the fee
+// collector and refunds the rest.
+func (k Keeper) settlePremove(ctx sdk.Context, storedGame *types.StoredGame, premove types.Premove, fee uint64) {
+	k.RemovePremove(ctx, premove.Index)
+	if 0 < fee {
+		err := k.bank.SendCoinsFromModuleToModule(ctx, types.ModuleName, authtypes.FeeCollectorName, types.PremoveCoins(fee))
+		if err != nil {
+			panic(fmt.Sprintf("cannot pay premove fee: %s", err.Error()))
+		}
+	}
+	if fee < premove.Deposit {
+		player, err := sdk.AccAddressFromBech32(premove.Player)
+		if err != nil {
+			panic(err.Error())
+		}
+		err = k.bank.SendCoinsFromModuleToAccount(ctx, types.ModuleName, player, types.PremoveCoins(premove.Deposit-fee))
+		if err != nil {
+			panic(fmt.Sprintf("cannot refund premove deposit: %s", err.Error()))
+		}
+	}
+}
+
+func (k Keeper) emitPremoveCleared(ctx sdk.Context, premove types.Premove) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.PremoveClearedEventType,
+			sdk.NewAttribute(types.PremoveClearedEventPlayer, premove.Player),
+			sdk.NewAttribute(types.PremoveClearedEventGameIndex, premove.Index),
+		),
+	)
+}
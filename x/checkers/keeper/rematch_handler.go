@@ -0,0 +1,99 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/satya/checkers/x/checkers/rules"
+	"github.com/satya/checkers/x/checkers/types"
+)
+
+// getRematchGame returns a finished game in which creator plays and that has
+// no rematch yet. The games of a match are left out, as the match schedules
+// its own games.
+func (k Keeper) getRematchGame(ctx sdk.Context, gameIndex string, creator string) (storedGame types.StoredGame, err error) {
+	storedGame, found := k.GetStoredGame(ctx, gameIndex)
+	if !found {
+		return storedGame, sdkerrors.Wrapf(types.ErrGameNotFound, "%s", gameIndex)
+	}
+	if storedGame.Winner == rules.PieceStrings[rules.NO_PLAYER] {
+		return storedGame, types.ErrGameNotFinished
+	}
+	if storedGame.Black != creator && storedGame.Red != creator {
+		return storedGame, sdkerrors.Wrapf(types.ErrCreatorNotPlayer, "%s", creator)
+	}
+	if storedGame.MatchIndex != "" {
+		return storedGame, sdkerrors.Wrapf(types.ErrRematchNotAllowed, "%s", "the game is part of a match")
+	}
+	if storedGame.House != "" {
+		return storedGame, sdkerrors.Wrapf(types.ErrRematchNotAllowed, "%s", "the house does not agree")
+	}
+	if storedGame.RematchIndex != "" {
+		return storedGame, sdkerrors.Wrapf(types.ErrAlreadyRematched, "%s", storedGame.RematchIndex)
+	}
+	return storedGame, nil
+}
+
+// createRematch creates a game from the standard start between the players of
+// previous, with the colours swapped and the same wager. Both players have
+// agreed to it, so both wagers are escrowed at once.
+func (k Keeper) createRematch(ctx sdk.Context, previous *types.StoredGame, accepter string) (types.StoredGame, error) {
+	systemInfo, found := k.GetSystemInfo(ctx)
+	if !found {
+		panic("SystemInfo not found")
+	}
+	newIndex := strconv.FormatUint(systemInfo.NextId, 10)
+	newGame := rules.New()
+	storedGame := types.StoredGame{
+		Index:             newIndex,
+		Board:             newGame.String(),
+		Turn:              rules.PieceStrings[newGame.Turn],
+		Black:             previous.Red,
+		Red:               previous.Black,
+		Winner:            rules.PieceStrings[rules.NO_PLAYER],
+		Deadline:          types.FormatDeadline(types.GetNextDeadline(ctx)),
+		MoveCount:         0,
+		BeforeIndex:       types.NoFifoIndex,
+		AfterIndex:        types.NoFifoIndex,
+		Wager:             previous.Wager,
+		PreviousGameIndex: previous.Index,
+	}
+
+	err := k.ValidateWagerDenoms(ctx, storedGame.Wager)
+	if err != nil {
+		return storedGame, err
+	}
+	if !storedGame.Wager.IsZero() {
+		err = k.AcceptWager(ctx, &storedGame, previous.RematchProposer)
+		if err == nil && !storedGame.IsFunded() {
+			err = k.AcceptWager(ctx, &storedGame, accepter)
+		}
+		if err != nil {
+			return storedGame, err
+		}
+	}
+
+	k.SendToFifoTail(ctx, &storedGame, &systemInfo)
+	k.FreezeIfOnVacation(ctx, &storedGame, &systemInfo)
+	k.SetStoredGame(ctx, storedGame)
+	systemInfo.NextId++
+	k.SetSystemInfo(ctx, systemInfo)
+
+	previous.RematchProposer = ""
+	previous.RematchIndex = newIndex
+	k.SetStoredGame(ctx, *previous)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.GameCreatedEventType,
+			sdk.NewAttribute(types.GameCreatedEventCreator, accepter),
+			sdk.NewAttribute(types.GameCreatedEventGameIndex, newIndex),
+			sdk.NewAttribute(types.GameCreatedEventBlack, storedGame.Black),
+			sdk.NewAttribute(types.GameCreatedEventRed, storedGame.Red),
+			sdk.NewAttribute(types.GameCreatedEventWager, storedGame.Wager.String()),
+			sdk.NewAttribute(types.GameCreatedEventWagerTraced, FormatTracedCoins(k.GetTracedCoins(ctx, storedGame.Wager))),
+			sdk.NewAttribute(types.GameCreatedEventPrize, storedGame.Prize.String()),
+		),
+	)
+	return storedGame, nil
+}
@@ -6,13 +6,24 @@ import (
 	"github.com/satya/checkers/x/checkers/types"
 )
 
-// SetStoredGame set a specific storedGame in the store from its index
+// SetStoredGame set a specific storedGame in the store from its index, and
+// keeps track of whether it is adjourned and of what its players and sponsor
+// have locked in it
 func (k Keeper) SetStoredGame(ctx sdk.Context, storedGame types.StoredGame) {
+	previous, _ := k.GetStoredGame(ctx, storedGame.Index)
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.StoredGameKeyPrefix))
 	b := k.cdc.MustMarshal(&storedGame)
 	store.Set(types.StoredGameKey(
 		storedGame.Index,
 	), b)
+	adjournedStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.StoredGameAdjournedKeyPrefix))
+	if storedGame.IsAdjourned() {
+		adjournedStore.Set(types.StoredGameKey(storedGame.Index), []byte{1})
+	} else {
+		adjournedStore.Delete(types.StoredGameKey(storedGame.Index))
+	}
+	k.indexGameEscrows(ctx, storedGame.Index, previous.Black, previous.Red, previous.Sponsor,
+		storedGame.Black, storedGame.Red, storedGame.Sponsor)
 }
 
 // GetStoredGame returns a storedGame from its index
@@ -40,10 +51,14 @@ func (k Keeper) RemoveStoredGame(
 	index string,
 
 ) {
+	previous, _ := k.GetStoredGame(ctx, index)
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.StoredGameKeyPrefix))
 	store.Delete(types.StoredGameKey(
 		index,
 	))
+	adjournedStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.StoredGameAdjournedKeyPrefix))
+	adjournedStore.Delete(types.StoredGameKey(index))
+	k.indexGameEscrows(ctx, index, previous.Black, previous.Red, previous.Sponsor)
 }
 
 // GetAllStoredGame returns all storedGame
@@ -61,3 +76,20 @@ func (k Keeper) GetAllStoredGame(ctx sdk.Context) (list []types.StoredGame) {
 
 	return
 }
+
+// GetAllAdjournedStoredGame returns the games that are adjourned
+func (k Keeper) GetAllAdjournedStoredGame(ctx sdk.Context) (list []types.StoredGame) {
+	adjournedStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.StoredGameAdjournedKeyPrefix))
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.StoredGameKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(adjournedStore, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.StoredGame
+		k.cdc.MustUnmarshal(store.Get(iterator.Key()), &val)
+		list = append(list, val)
+	}
+
+	return
+}
@@ -62,3 +62,45 @@ func (k Keeper) SendToFifoTail(ctx sdk.Context, game *types.StoredGame, info *ty
 	}
 
 }
+
+// SendToFifoByDeadline places a game after the games whose deadline is not
+// later than its own. Games normally get a full turn and go to the tail, but a
+// game that resumes with less time left may need to go further up.
+func (k Keeper) SendToFifoByDeadline(ctx sdk.Context, game *types.StoredGame, info *types.SystemInfo) {
+	k.RemoveFromFifo(ctx, game, info)
+	deadline, err := game.GetDeadlineAsTime()
+	if err != nil {
+		panic(err.Error())
+	}
+	beforeIndex, afterIndex := info.FifoTailIndex, types.NoFifoIndex
+	for beforeIndex != types.NoFifoIndex {
+		beforeElement, found := k.GetStoredGame(ctx, beforeIndex)
+		if !found {
+			panic("Element before in Fifo was not found")
+		}
+		beforeDeadline, err := beforeElement.GetDeadlineAsTime()
+		if err != nil {
+			panic(err.Error())
+		}
+		if !beforeDeadline.After(deadline) {
+			break
+		}
+		beforeIndex, afterIndex = beforeElement.BeforeIndex, beforeElement.Index
+	}
+
+	game.BeforeIndex, game.AfterIndex = beforeIndex, afterIndex
+	if beforeIndex == types.NoFifoIndex {
+		info.FifoHeadIndex = game.Index
+	} else {
+		beforeElement, _ := k.GetStoredGame(ctx, beforeIndex)
+		beforeElement.AfterIndex = game.Index
+		k.SetStoredGame(ctx, beforeElement)
+	}
+	if afterIndex == types.NoFifoIndex {
+		info.FifoTailIndex = game.Index
+	} else {
+		afterElement, _ := k.GetStoredGame(ctx, afterIndex)
+		afterElement.BeforeIndex = game.Index
+		k.SetStoredGame(ctx, afterElement)
+	}
+}
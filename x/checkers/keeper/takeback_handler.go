@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/satya/checkers/x/checkers/rules"
+	"github.com/satya/checkers/x/checkers/types"
+)
+
+// getTakebackGame returns a game in progress that allows takebacks, that is an
+// unrated game or one without a wager, and not against the house.
+func (k Keeper) getTakebackGame(ctx sdk.Context, gameIndex string) (storedGame types.StoredGame, err error) {
+	storedGame, found := k.GetStoredGame(ctx, gameIndex)
+	if !found {
+		return storedGame, sdkerrors.Wrapf(types.ErrGameNotFound, "%s", gameIndex)
+	}
+	if storedGame.Winner != rules.PieceStrings[rules.NO_PLAYER] {
+		return storedGame, types.ErrGameFinished
+	}
+	if storedGame.IsAdjourned() {
+		return storedGame, sdkerrors.Wrapf(types.ErrGameAdjourned, "%s", gameIndex)
+	}
+	if storedGame.House != "" {
+		return storedGame, sdkerrors.Wrapf(types.ErrTakebackNotAllowed, "%s", "the house does not agree")
+	}
+	if !storedGame.Unrated && !storedGame.Wager.IsZero() {
+		return storedGame, sdkerrors.Wrapf(types.ErrTakebackNotAllowed, "%s", "the game is rated and has a wager")
+	}
+	return storedGame, nil
+}
+
+// getLastMover returns the colour of player as the one who moved last. When
+// playing against oneself, that is the colour not to move.
+func getLastMover(storedGame types.StoredGame, player string) (rules.Player, error) {
+	isBlack := storedGame.Black == player
+	isRed := storedGame.Red == player
+	if isBlack && isRed {
+		return rules.Opponents[rules.StringPieces[storedGame.Turn].Player], nil
+	} else if isBlack {
+		return rules.BLACK_PLAYER, nil
+	} else if isRed {
+		return rules.RED_PLAYER, nil
+	}
+	return rules.NO_PLAYER, sdkerrors.Wrapf(types.ErrCreatorNotPlayer, "%s", player)
+}
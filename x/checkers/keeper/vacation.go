@@ -0,0 +1,89 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/satya/checkers/x/checkers/types"
+)
+
+// SetVacation set a specific vacation in the store from its index, and keeps
+// track of whether the player is on vacation
+func (k Keeper) SetVacation(ctx sdk.Context, vacation types.Vacation) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.VacationKeyPrefix))
+	b := k.cdc.MustMarshal(&vacation)
+	store.Set(types.VacationKey(
+		vacation.Index,
+	), b)
+	activeStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.VacationActiveKeyPrefix))
+	if vacation.IsOnVacation() {
+		activeStore.Set(types.VacationKey(vacation.Index), []byte{1})
+	} else {
+		activeStore.Delete(types.VacationKey(vacation.Index))
+	}
+}
+
+// GetVacation returns a vacation from its index
+func (k Keeper) GetVacation(
+	ctx sdk.Context,
+	index string,
+
+) (val types.Vacation, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.VacationKeyPrefix))
+
+	b := store.Get(types.VacationKey(
+		index,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveVacation removes a vacation from the store
+func (k Keeper) RemoveVacation(
+	ctx sdk.Context,
+	index string,
+
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.VacationKeyPrefix))
+	store.Delete(types.VacationKey(
+		index,
+	))
+	activeStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.VacationActiveKeyPrefix))
+	activeStore.Delete(types.VacationKey(index))
+}
+
+// GetAllVacation returns all vacation
+func (k Keeper) GetAllVacation(ctx sdk.Context) (list []types.Vacation) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.VacationKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Vacation
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetAllActiveVacation returns the vacations in progress
+func (k Keeper) GetAllActiveVacation(ctx sdk.Context) (list []types.Vacation) {
+	activeStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.VacationActiveKeyPrefix))
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.VacationKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(activeStore, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Vacation
+		k.cdc.MustUnmarshal(store.Get(iterator.Key()), &val)
+		list = append(list, val)
+	}
+
+	return
+}
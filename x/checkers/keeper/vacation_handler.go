@@ -0,0 +1,156 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/satya/checkers/x/checkers/rules"
+	"github.com/satya/checkers/x/checkers/types"
+)
+
+// getPlayerToMove returns the player whose clock runs in a game in progress.
+// A pending colour draw runs the clock of both players, so it has none.
+func (k Keeper) getPlayerToMove(ctx sdk.Context, storedGame *types.StoredGame) (player string, found bool) {
+	if storedGame.Winner != rules.PieceStrings[rules.NO_PLAYER] {
+		return "", false
+	}
+	if _, found := k.GetColourDraw(ctx, storedGame.Index); found {
+		return "", false
+	}
+	address, found, err := storedGame.GetPlayerAddress(storedGame.Turn)
+	if err != nil {
+		panic(err.Error())
+	}
+	if !found {
+		return "", false
+	}
+	return address.String(), true
+}
+
+// FreezeIfOnVacation is called whenever a game gets a new deadline, before it
+// is saved. When the player to move is on vacation, it takes the game out of
+// the FIFO with the time it has left. It first drops a freeze that no longer
+// applies.
+func (k Keeper) FreezeIfOnVacation(ctx sdk.Context, storedGame *types.StoredGame, systemInfo *types.SystemInfo) {
+	if storedGame.FrozenRemaining != "" {
+		k.dropFreeze(ctx, storedGame)
+	}
+	player, found := k.getPlayerToMove(ctx, storedGame)
+	if !found {
+		return
+	}
+	vacation, found := k.GetVacation(ctx, player)
+	if !found || !vacation.IsOnVacation() {
+		return
+	}
+	k.freezeGame(ctx, storedGame, systemInfo, &vacation)
+	k.SetVacation(ctx, vacation)
+}
+
+func (k Keeper) freezeGame(ctx sdk.Context, storedGame *types.StoredGame, systemInfo *types.SystemInfo, vacation *types.Vacation) {
+	deadline, err := storedGame.GetDeadlineAsTime()
+	if err != nil {
+		panic(err.Error())
+	}
+	remaining := deadline.Sub(ctx.BlockTime())
+	if remaining < 0 {
+		remaining = 0
+	}
+	k.RemoveFromFifo(ctx, storedGame, systemInfo)
+	storedGame.FrozenRemaining = remaining.String()
+	vacation.AddFrozenGame(storedGame.Index)
+}
+
+// dropFreeze forgets the freeze of a game that was played or given a new
+// deadline while frozen.
+func (k Keeper) dropFreeze(ctx sdk.Context, storedGame *types.StoredGame) {
+	for _, player := range []string{storedGame.Black, storedGame.Red} {
+		vacation, found := k.GetVacation(ctx, player)
+		if found && vacation.RemoveFrozenGame(storedGame.Index) {
+			k.SetVacation(ctx, vacation)
+		}
+	}
+	storedGame.FrozenRemaining = ""
+}
+
+// StartVacation freezes the games in which player has the turn. Each other
+// game of player is frozen by FreezeIfOnVacation as soon as its turn passes to
+// them, so no clock of player runs during the vacation.
+func (k Keeper) StartVacation(ctx sdk.Context, vacation *types.Vacation) {
+	vacation.Start = types.FormatDeadline(ctx.BlockTime())
+	systemInfo, found := k.GetSystemInfo(ctx)
+	if !found {
+		panic("SystemInfo not found")
+	}
+	gameIndex := systemInfo.FifoHeadIndex
+	for gameIndex != types.NoFifoIndex {
+		storedGame, found := k.GetStoredGame(ctx, gameIndex)
+		if !found {
+			panic("Fifo game not found " + gameIndex)
+		}
+		gameIndex = storedGame.AfterIndex
+		if player, found := k.getPlayerToMove(ctx, &storedGame); found && player == vacation.Index {
+			k.freezeGame(ctx, &storedGame, &systemInfo, vacation)
+			k.SetStoredGame(ctx, storedGame)
+		}
+	}
+	k.SetSystemInfo(ctx, systemInfo)
+	k.SetVacation(ctx, *vacation)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.VacationStartedEventType,
+			sdk.NewAttribute(types.VacationStartedEventPlayer, vacation.Index),
+			sdk.NewAttribute(types.VacationStartedEventRemaining,
+				strconv.FormatUint(uint64(vacation.GetRemaining(ctx.BlockTime())/time.Second), 10)),
+		),
+	)
+}
+
+// EndVacation resumes the frozen games of a player with the time they had
+// left.
+func (k Keeper) EndVacation(ctx sdk.Context, vacation *types.Vacation) {
+	vacation.Stop(ctx.BlockTime())
+	systemInfo, found := k.GetSystemInfo(ctx)
+	if !found {
+		panic("SystemInfo not found")
+	}
+	for _, gameIndex := range vacation.FrozenGames {
+		storedGame, found := k.GetStoredGame(ctx, gameIndex)
+		if !found {
+			panic("Frozen game not found " + gameIndex)
+		}
+		remaining, err := time.ParseDuration(storedGame.FrozenRemaining)
+		if err != nil {
+			panic(err.Error())
+		}
+		storedGame.FrozenRemaining = ""
+		storedGame.Deadline = types.FormatDeadline(ctx.BlockTime().Add(remaining))
+		k.SendToFifoByDeadline(ctx, &storedGame, &systemInfo)
+		k.SetStoredGame(ctx, storedGame)
+	}
+	resumed := len(vacation.FrozenGames)
+	vacation.FrozenGames = nil
+	k.SetSystemInfo(ctx, systemInfo)
+	k.SetVacation(ctx, *vacation)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.VacationEndedEventType,
+			sdk.NewAttribute(types.VacationEndedEventPlayer, vacation.Index),
+			sdk.NewAttribute(types.VacationEndedEventUsed, strconv.FormatUint(vacation.UsedSeconds, 10)),
+			sdk.NewAttribute(types.VacationEndedEventResumed, strconv.Itoa(resumed)),
+		),
+	)
+}
+
+// EndSpentVacations ends the vacations that used up the year's budget.
+func (k Keeper) EndSpentVacations(goCtx context.Context) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	for _, vacation := range k.GetAllActiveVacation(ctx) {
+		if !vacation.GetEnd().After(ctx.BlockTime()) {
+			k.EndVacation(ctx, &vacation)
+		}
+	}
+}
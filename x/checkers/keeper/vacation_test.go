@@ -0,0 +1,63 @@
+package keeper_test
+
+import (
+	"strconv"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/satya/checkers/testutil/keeper"
+	"github.com/satya/checkers/testutil/nullify"
+	"github.com/satya/checkers/x/checkers/keeper"
+	"github.com/satya/checkers/x/checkers/types"
+	"github.com/stretchr/testify/require"
+)
+
+// Prevent strconv unused error
+var _ = strconv.IntSize
+
+func createNVacation(keeper *keeper.Keeper, ctx sdk.Context, n int) []types.Vacation {
+	items := make([]types.Vacation, n)
+	for i := range items {
+		items[i].Index = strconv.Itoa(i)
+
+		keeper.SetVacation(ctx, items[i])
+	}
+	return items
+}
+
+func TestVacationGet(t *testing.T) {
+	keeper, ctx := keepertest.CheckersKeeper(t)
+	items := createNVacation(keeper, ctx, 10)
+	for _, item := range items {
+		rst, found := keeper.GetVacation(ctx,
+			item.Index,
+		)
+		require.True(t, found)
+		require.Equal(t,
+			nullify.Fill(&item),
+			nullify.Fill(&rst),
+		)
+	}
+}
+func TestVacationRemove(t *testing.T) {
+	keeper, ctx := keepertest.CheckersKeeper(t)
+	items := createNVacation(keeper, ctx, 10)
+	for _, item := range items {
+		keeper.RemoveVacation(ctx,
+			item.Index,
+		)
+		_, found := keeper.GetVacation(ctx,
+			item.Index,
+		)
+		require.False(t, found)
+	}
+}
+
+func TestVacationGetAll(t *testing.T) {
+	keeper, ctx := keepertest.CheckersKeeper(t)
+	items := createNVacation(keeper, ctx, 10)
+	require.ElementsMatch(t,
+		nullify.Fill(items),
+		nullify.Fill(keeper.GetAllVacation(ctx)),
+	)
+}
@@ -5,31 +5,65 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/satya/checkers/x/checkers/rules"
 	"github.com/satya/checkers/x/checkers/types"
 )
 
-func (k *Keeper) CollectWager(ctx sdk.Context, storedGame *types.StoredGame) error {
-	if storedGame.MoveCount == 0 {
-		black, err := storedGame.GetBlackAddress()
-		if err != nil {
-			panic(err.Error())
+// AcceptWager records that player accepts the wager of the game. The second
+// acceptance escrows both stakes. When playing against oneself, one is enough.
+func (k *Keeper) AcceptWager(ctx sdk.Context, storedGame *types.StoredGame, player string) error {
+	if storedGame.Black != storedGame.Red {
+		if storedGame.AcceptedBy == player {
+			return sdkerrors.Wrapf(types.ErrAlreadyAccepted, "%s", player)
 		}
-		err = k.bank.SendCoinsFromAccountToModule(ctx, black, types.ModuleName, sdk.NewCoins(storedGame.GetWagerCoin()))
-		if err != nil {
-			return sdkerrors.Wrapf(err, types.ErrBlackCannotPay.Error())
+		if storedGame.AcceptedBy == "" {
+			storedGame.AcceptedBy = player
+			return nil
 		}
-	} else if storedGame.MoveCount == 1 {
-		red, err := storedGame.GetRedAddress()
-		if err != nil {
-			panic(err.Error())
-		}
-		err = k.bank.SendCoinsFromAccountToModule(ctx, red, types.ModuleName, sdk.NewCoins(storedGame.GetWagerCoin()))
+	}
+	err := k.EscrowWagers(ctx, storedGame)
+	if err != nil {
+		return err
+	}
+	storedGame.AcceptedBy = ""
+	return nil
+}
+
+// EscrowWagers makes both players pay their stake, black first, and records
+// the total held in escrow. It fails if either cannot pay, in which case
+// neither does, as the transaction is reverted.
+func (k *Keeper) EscrowWagers(ctx sdk.Context, storedGame *types.StoredGame) error {
+	for _, color := range []string{rules.PieceStrings[rules.BLACK_PLAYER], rules.PieceStrings[rules.RED_PLAYER]} {
+		err := k.collectFromColor(ctx, storedGame, color)
 		if err != nil {
-			return sdkerrors.Wrapf(err, types.ErrRedCannotPay.Error())
+			return err
 		}
 	}
+	storedGame.Escrowed = storedGame.Wager.Add(storedGame.Wager...)
 	return nil
 }
+
+// EscrowPrize makes the sponsor pay the prize of the game, if any, into
+// escrow.
+func (k *Keeper) EscrowPrize(ctx sdk.Context, storedGame *types.StoredGame) error {
+	if storedGame.Prize.IsZero() {
+		return nil
+	}
+	sponsor, err := storedGame.GetSponsorAddress()
+	if err != nil {
+		panic(err.Error())
+	}
+	err = k.collectFromPlayer(ctx, sponsor, storedGame.Prize)
+	if err != nil {
+		return sdkerrors.Wrapf(err, types.ErrSponsorCannotPay.Error())
+	}
+	return nil
+}
+
+// MustPayWinnings pays the escrow to the winner, less the platform rake and,
+// when the game has one, the organizer fee. The prize, if any, is paid in
+// full on top.
 func (k *Keeper) MustPayWinnings(ctx sdk.Context, storedGame *types.StoredGame) {
 	winnerAddress, found, err := storedGame.GetWinnerAddress()
 	if err != nil {
@@ -38,32 +72,173 @@ func (k *Keeper) MustPayWinnings(ctx sdk.Context, storedGame *types.StoredGame)
 	if !found {
 		panic(fmt.Sprintf(types.ErrCannotFindWinnerByColor.Error(), storedGame.Winner))
 	}
-	winnings := storedGame.GetWagerCoin()
-	if storedGame.MoveCount == 0 {
-		panic(types.ErrNothingToPay.Error())
-	} else if 1 < storedGame.MoveCount {
-		winnings = winnings.Add(winnings)
+	if storedGame.Escrowed.IsZero() && storedGame.Prize.IsZero() {
+		return
 	}
-
-	err = k.bank.SendCoinsFromModuleToAccount(ctx, types.ModuleName, winnerAddress, sdk.NewCoins(winnings))
+	params := k.GetParams(ctx)
+	winnings, rake, organizerFee := storedGame.GetWinSplit(params)
+	err = k.payRake(ctx, params.RakeDestination, rake)
 	if err != nil {
 		panic(fmt.Sprintf(types.ErrCannotPayWinnings.Error(), err.Error()))
 	}
+	if !organizerFee.IsZero() {
+		organizerAddress, err := storedGame.GetOrganizerAddress()
+		if err != nil {
+			panic(err.Error())
+		}
+		err = k.payToPlayer(ctx, organizerAddress, organizerFee)
+		if err != nil {
+			panic(fmt.Sprintf(types.ErrCannotPayWinnings.Error(), err.Error()))
+		}
+	}
+	if !winnings.IsZero() {
+		err = k.payTo(ctx, storedGame, winnerAddress, winnings)
+		if err != nil {
+			panic(fmt.Sprintf(types.ErrCannotPayWinnings.Error(), err.Error()))
+		}
+	}
+	prize := storedGame.Prize
+	storedGame.Escrowed = nil
+	storedGame.Prize = nil
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.WinningsPaidEventType,
+			sdk.NewAttribute(types.WinningsPaidEventGameIndex, storedGame.Index),
+			sdk.NewAttribute(types.WinningsPaidEventWinner, winnerAddress.String()),
+			sdk.NewAttribute(types.WinningsPaidEventWinnings, winnings.String()),
+			sdk.NewAttribute(types.WinningsPaidEventPrize, prize.String()),
+			sdk.NewAttribute(types.WinningsPaidEventRake, rake.String()),
+			sdk.NewAttribute(types.WinningsPaidEventOrganizer, storedGame.Organizer),
+			sdk.NewAttribute(types.WinningsPaidEventOrganizerFee, organizerFee.String()),
+		),
+	)
 }
+
+// payRake sends the platform rake out of escrow to its destination.
+func (k *Keeper) payRake(ctx sdk.Context, destination string, rake sdk.Coins) error {
+	if rake.IsZero() {
+		return nil
+	}
+	if destination == types.RakeToCommunityPool {
+		return k.distribution.FundCommunityPool(ctx, rake, types.GetHouseAddress())
+	}
+	return k.bank.SendCoinsFromModuleToModule(ctx, types.ModuleName, authtypes.FeeCollectorName, rake)
+}
+
+// MustRefundWager gives each player their stake back from the escrow, when
+// the game is dropped or drawn. The prize, if any, is split between the
+// players on a draw and returned to the sponsor otherwise.
 func (k *Keeper) MustRefundWager(ctx sdk.Context, storedGame *types.StoredGame) {
-	if storedGame.MoveCount == 1 {
-		black, err := storedGame.GetBlackAddress()
+	if !storedGame.Escrowed.IsZero() {
+		for _, color := range []string{rules.PieceStrings[rules.BLACK_PLAYER], rules.PieceStrings[rules.RED_PLAYER]} {
+			player, err := getColorAddress(storedGame, color)
+			if err != nil {
+				panic(err.Error())
+			}
+			err = k.payTo(ctx, storedGame, player, storedGame.Wager)
+			if err != nil {
+				panic(fmt.Sprintf(types.ErrCannotRefundWager.Error(), err.Error()))
+			}
+		}
+		storedGame.Escrowed = nil
+	}
+	k.mustReturnPrize(ctx, storedGame)
+}
+
+// mustReturnPrize splits the prize evenly between the players of a drawn game,
+// and gives the sponsor what cannot be split. The prize of a game without a
+// result goes back to the sponsor whole.
+func (k *Keeper) mustReturnPrize(ctx sdk.Context, storedGame *types.StoredGame) {
+	if storedGame.Prize.IsZero() {
+		return
+	}
+	leftover := storedGame.Prize
+	if storedGame.Winner == types.DrawWinner {
+		var half sdk.Coins
+		half, leftover = storedGame.GetDrawPrizeSplit()
+		if !half.IsZero() {
+			for _, color := range []string{rules.PieceStrings[rules.BLACK_PLAYER], rules.PieceStrings[rules.RED_PLAYER]} {
+				player, err := getColorAddress(storedGame, color)
+				if err != nil {
+					panic(err.Error())
+				}
+				err = k.payTo(ctx, storedGame, player, half)
+				if err != nil {
+					panic(fmt.Sprintf(types.ErrCannotRefundWager.Error(), err.Error()))
+				}
+			}
+		}
+	}
+	if !leftover.IsZero() {
+		sponsor, err := storedGame.GetSponsorAddress()
 		if err != nil {
 			panic(err.Error())
 		}
-		err = k.bank.SendCoinsFromModuleToAccount(ctx, types.ModuleName, black, sdk.NewCoins(storedGame.GetWagerCoin()))
+		err = k.payToPlayer(ctx, sponsor, leftover)
 		if err != nil {
 			panic(fmt.Sprintf(types.ErrCannotRefundWager.Error(), err.Error()))
 		}
-	} else if storedGame.MoveCount == 0 {
-		//do nothing
-	} else {
-		panic(fmt.Sprintf(types.ErrNotInRefundState.Error(), storedGame.MoveCount))
 	}
+	storedGame.Prize = nil
+}
+
+func getColorAddress(storedGame *types.StoredGame, color string) (sdk.AccAddress, error) {
+	if color == rules.PieceStrings[rules.RED_PLAYER] {
+		return storedGame.GetRedAddress()
+	}
+	return storedGame.GetBlackAddress()
+}
+
+func (k *Keeper) collectFromColor(ctx sdk.Context, storedGame *types.StoredGame, color string) error {
+	payer, err := getColorAddress(storedGame, color)
+	if err != nil {
+		panic(err.Error())
+	}
+	err = k.collectFrom(ctx, storedGame, payer, storedGame.Wager)
+	if err != nil {
+		if color == rules.PieceStrings[rules.RED_PLAYER] {
+			return sdkerrors.Wrapf(err, types.ErrRedCannotPay.Error())
+		}
+		return sdkerrors.Wrapf(err, types.ErrBlackCannotPay.Error())
+	}
+	return nil
+}
 
+// collectFrom moves a wager into escrow. The house pays from its bankroll,
+// which is already in the module account.
+func (k *Keeper) collectFrom(ctx sdk.Context, storedGame *types.StoredGame, payer sdk.AccAddress, amount sdk.Coins) error {
+	if storedGame.IsHouseAddress(payer) {
+		return k.DebitHouseBankroll(ctx, amount)
+	}
+	return k.collectFromPlayer(ctx, payer, amount)
+}
+
+// collectFromPlayer moves coins of a player, sponsor or bettor into escrow,
+// from their internal balance when it covers them, as it is already in the
+// module account.
+func (k *Keeper) collectFromPlayer(ctx sdk.Context, payer sdk.AccAddress, amount sdk.Coins) error {
+	if playerBalance, found := k.GetPlayerBalance(ctx, payer.String()); found && playerBalance.Funds.IsAllGTE(amount) {
+		_, err := k.DebitPlayerBalance(ctx, payer.String(), amount)
+		return err
+	}
+	return k.bank.SendCoinsFromAccountToModule(ctx, payer, types.ModuleName, amount)
+}
+
+// payTo moves coins out of escrow. What goes to the house returns to its
+// bankroll.
+func (k *Keeper) payTo(ctx sdk.Context, storedGame *types.StoredGame, payee sdk.AccAddress, amount sdk.Coins) error {
+	if storedGame.IsHouseAddress(payee) {
+		k.CreditHouseBankroll(ctx, amount)
+		return nil
+	}
+	return k.payToPlayer(ctx, payee, amount)
+}
+
+// payToPlayer moves coins out of escrow to a player, organizer, sponsor or
+// bettor, crediting their internal balance if they have one.
+func (k *Keeper) payToPlayer(ctx sdk.Context, payee sdk.AccAddress, amount sdk.Coins) error {
+	if _, found := k.GetPlayerBalance(ctx, payee.String()); found {
+		k.CreditPlayerBalance(ctx, payee.String(), amount)
+		return nil
+	}
+	return k.bank.SendCoinsFromModuleToAccount(ctx, types.ModuleName, payee, amount)
 }
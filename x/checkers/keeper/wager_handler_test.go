@@ -27,7 +27,7 @@ func setupKeeperForWagerHandler(t testing.TB) (keeper.Keeper, context.Context,
 	return *k, context, ctrl, bankMock
 }
 
-func TestWagerHandlerCollectWrongBlack(t *testing.T) {
+func TestWagerHandlerEscrowWrongBlack(t *testing.T) {
 	keeper, context, ctrl, _ := setupKeeperForWagerHandler(t)
 	ctx := sdk.UnwrapSDKContext(context)
 	defer ctrl.Finish()
@@ -36,12 +36,12 @@ func TestWagerHandlerCollectWrongBlack(t *testing.T) {
 		require.NotNil(t, r, "THe code did not panic")
 		require.Equal(t, "black address is invalid: : empty address string is not allowed", r)
 	}()
-	keeper.CollectWager(ctx, &types.StoredGame{
-		MoveCount: 0,
+	keeper.EscrowWagers(ctx, &types.StoredGame{
+		Wager: sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
 	})
 }
 
-func TestWagerHandlerCollectFailedNoMove(t *testing.T) {
+func TestWagerHandlerEscrowFailedBlack(t *testing.T) {
 	keeper, context, ctrl, escrow := setupKeeperForWagerHandler(t)
 	ctx := sdk.UnwrapSDKContext(context)
 	defer ctrl.Finish()
@@ -49,29 +49,71 @@ func TestWagerHandlerCollectFailedNoMove(t *testing.T) {
 
 	escrow.EXPECT().SendCoinsFromAccountToModule(ctx, black, types.ModuleName, gomock.Any()).Return(errors.New("oops"))
 
-	err := keeper.CollectWager(ctx, &types.StoredGame{
-		Black:     alice,
-		MoveCount: 0,
-		Wager:     45,
-		Denom:     "stake",
-	})
+	storedGame := types.StoredGame{
+		Black: alice,
+		Red:   bob,
+		Wager: sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+	}
+	err := keeper.EscrowWagers(ctx, &storedGame)
 
 	require.NotNil(t, err)
 	require.EqualError(t, err, "black cannot pay the wager: oops")
+	require.Empty(t, storedGame.Escrowed)
 }
 
-func TestWagerHandlerCollectNoMove(t *testing.T) {
+func TestWagerHandlerEscrowFailedRed(t *testing.T) {
 	keeper, context, ctrl, escrow := setupKeeperForWagerHandler(t)
 	ctx := sdk.UnwrapSDKContext(context)
 	defer ctrl.Finish()
-	escrow.ExpectPay(context, alice, 45)
-	err := keeper.CollectWager(ctx, &types.StoredGame{
-		Black:     alice,
-		MoveCount: 0,
-		Wager:     45,
-		Denom:     "stake",
+	red, _ := sdk.AccAddressFromBech32(bob)
+
+	payBlack := escrow.ExpectPay(context, alice, 45)
+	escrow.EXPECT().SendCoinsFromAccountToModule(ctx, red, types.ModuleName, gomock.Any()).Return(errors.New("oops")).After(payBlack)
+
+	err := keeper.EscrowWagers(ctx, &types.StoredGame{
+		Black: alice,
+		Red:   bob,
+		Wager: sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
 	})
+
+	require.EqualError(t, err, "red cannot pay the wager: oops")
+}
+
+func TestWagerHandlerEscrowBoth(t *testing.T) {
+	keeper, context, ctrl, escrow := setupKeeperForWagerHandler(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	payBlack := escrow.ExpectPay(context, alice, 45)
+	escrow.ExpectPay(context, bob, 45).After(payBlack)
+	storedGame := types.StoredGame{
+		Black: alice,
+		Red:   bob,
+		Wager: sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+	}
+	err := keeper.EscrowWagers(ctx, &storedGame)
 	require.Nil(t, err)
+	require.EqualValues(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 90)), storedGame.Escrowed)
+}
+
+func TestWagerHandlerAcceptTwice(t *testing.T) {
+	keeper, context, ctrl, escrow := setupKeeperForWagerHandler(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	storedGame := types.StoredGame{
+		Black: alice,
+		Red:   bob,
+		Wager: sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+	}
+	require.Nil(t, keeper.AcceptWager(ctx, &storedGame, bob))
+	require.EqualValues(t, bob, storedGame.AcceptedBy)
+	require.Empty(t, storedGame.Escrowed)
+	require.ErrorIs(t, keeper.AcceptWager(ctx, &storedGame, bob), types.ErrAlreadyAccepted)
+
+	escrow.ExpectPay(context, alice, 45)
+	escrow.ExpectPay(context, bob, 45)
+	require.Nil(t, keeper.AcceptWager(ctx, &storedGame, alice))
+	require.EqualValues(t, "", storedGame.AcceptedBy)
+	require.EqualValues(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 90)), storedGame.Escrowed)
 }
 
 func TestWagerHandlerPayWrongEscrowFailed(t *testing.T) {
@@ -86,41 +128,350 @@ func TestWagerHandlerPayWrongEscrowFailed(t *testing.T) {
 		require.Equal(t, r, "cannot pay winnings to winner : oops")
 	}()
 	keeper.MustPayWinnings(ctx, &types.StoredGame{
+		Black:    alice,
+		Red:      bob,
+		Winner:   "b",
+		Wager:    sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+		Escrowed: sdk.NewCoins(sdk.NewInt64Coin("stake", 90)),
+	})
+}
+
+func TestWagerHandlerPayEscrow(t *testing.T) {
+	keeper, context, ctrl, escrow := setupKeeperForWagerHandler(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	escrow.ExpectRefund(context, alice, 90)
+	storedGame := types.StoredGame{
+		Black:    alice,
+		Red:      bob,
+		Winner:   "b",
+		Wager:    sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+		Escrowed: sdk.NewCoins(sdk.NewInt64Coin("stake", 90)),
+	}
+	keeper.MustPayWinnings(ctx, &storedGame)
+	require.Empty(t, storedGame.Escrowed)
+}
+
+func TestWagerHandlerPayNothingEscrowed(t *testing.T) {
+	keeper, context, ctrl, _ := setupKeeperForWagerHandler(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	keeper.MustPayWinnings(ctx, &types.StoredGame{
+		Black:  alice,
+		Red:    bob,
+		Winner: "b",
+	})
+}
+
+func TestWagerHandlerRefundCalled(t *testing.T) {
+	keeper, context, ctrl, escrow := setupKeeperForWagerHandler(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	escrow.ExpectRefund(context, alice, 45)
+	escrow.ExpectRefund(context, bob, 45)
+	storedGame := types.StoredGame{
+		Black:    alice,
+		Red:      bob,
+		Wager:    sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+		Escrowed: sdk.NewCoins(sdk.NewInt64Coin("stake", 90)),
+	}
+	keeper.MustRefundWager(ctx, &storedGame)
+	require.Empty(t, storedGame.Escrowed)
+}
+
+func TestWagerHandlerRefundSeveralDenoms(t *testing.T) {
+	keeper, context, ctrl, escrow := setupKeeperForWagerHandler(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	wager := sdk.NewCoins(sdk.NewInt64Coin("token", 100), sdk.NewInt64Coin("stake", 5))
+	escrow.ExpectRefundCoins(context, alice, wager)
+	escrow.ExpectRefundCoins(context, bob, wager)
+	storedGame := types.StoredGame{
+		Black:    alice,
+		Red:      bob,
+		Wager:    wager,
+		Escrowed: wager.Add(wager...),
+	}
+	keeper.MustRefundWager(ctx, &storedGame)
+	require.Empty(t, storedGame.Escrowed)
+}
+
+func setFees(keeper keeper.Keeper, ctx sdk.Context, platformRakeBps uint64, rakeDestination string, organizerFeeBps uint64) {
+	params := keeper.GetParams(ctx)
+	params.PlatformRakeBps = platformRakeBps
+	params.RakeDestination = rakeDestination
+	params.OrganizerFeeBps = organizerFeeBps
+	keeper.SetParams(ctx, params)
+}
+
+func TestWagerHandlerPayRakeAndOrganizerFee(t *testing.T) {
+	keeper, context, ctrl, escrow := setupKeeperForWagerHandler(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	setFees(keeper, ctx, 250, types.RakeToFeeCollector, 100)
+	escrow.ExpectPayFee(context, 25)
+	escrow.ExpectRefund(context, carol, 10)
+	escrow.ExpectRefund(context, alice, 965)
+	storedGame := types.StoredGame{
+		Index:     "1",
 		Black:     alice,
 		Red:       bob,
 		Winner:    "b",
-		MoveCount: 1,
-		Wager:     45,
-		Denom:     "stake",
+		Wager:     sdk.NewCoins(sdk.NewInt64Coin("stake", 500)),
+		Escrowed:  sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)),
+		Organizer: carol,
+	}
+	keeper.MustPayWinnings(ctx, &storedGame)
+	require.Empty(t, storedGame.Escrowed)
+	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
+	require.Len(t, events, 1)
+	require.EqualValues(t, sdk.StringEvent{
+		Type: "winnings-paid",
+		Attributes: []sdk.Attribute{
+			{Key: "game-index", Value: "1"},
+			{Key: "winner", Value: alice},
+			{Key: "winnings", Value: "965stake"},
+			{Key: "prize", Value: ""},
+			{Key: "rake", Value: "25stake"},
+			{Key: "organizer", Value: carol},
+			{Key: "organizer-fee", Value: "10stake"},
+		},
+	}, events[0])
+}
+
+func TestWagerHandlerNoOrganizerFeeWithoutOrganizer(t *testing.T) {
+	keeper, context, ctrl, escrow := setupKeeperForWagerHandler(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	setFees(keeper, ctx, 250, types.RakeToFeeCollector, 100)
+	escrow.ExpectPayFee(context, 25)
+	escrow.ExpectRefund(context, bob, 975)
+	keeper.MustPayWinnings(ctx, &types.StoredGame{
+		Black:    alice,
+		Red:      bob,
+		Winner:   "r",
+		Wager:    sdk.NewCoins(sdk.NewInt64Coin("stake", 500)),
+		Escrowed: sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)),
 	})
 }
 
-func TestWagerHandlerPayEscrowCalledTwoMoves(t *testing.T) {
+func TestWagerHandlerFeesRoundDownPerDenom(t *testing.T) {
 	keeper, context, ctrl, escrow := setupKeeperForWagerHandler(t)
 	ctx := sdk.UnwrapSDKContext(context)
 	defer ctrl.Finish()
-	escrow.ExpectRefund(context, alice, 90)
+	setFees(keeper, ctx, 250, types.RakeToFeeCollector, 0)
+	escrow.ExpectPayFee(context, 2)
+	escrow.ExpectRefundCoins(context, alice, sdk.NewCoins(sdk.NewInt64Coin("stake", 88), sdk.NewInt64Coin("token", 30)))
+	wager := sdk.NewCoins(sdk.NewInt64Coin("stake", 45), sdk.NewInt64Coin("token", 15))
 	keeper.MustPayWinnings(ctx, &types.StoredGame{
 		Black:     alice,
 		Red:       bob,
 		Winner:    "b",
-		MoveCount: 2,
-		Wager:     45,
-		Denom:     "stake",
+		Wager:     wager,
+		Escrowed:  wager.Add(wager...),
+		Organizer: carol,
 	})
 }
 
-//more tests to go on refunds and other tests
+func TestWagerHandlerPayRakeToCommunityPool(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	escrow := testutil.NewMockBankEscrowKeeper(ctrl)
+	distribution := testutil.NewMockDistributionKeeper(ctrl)
+	keeper, ctx := keepertest.CheckersKeeperWithAllMocks(t, escrow, nil, nil, distribution)
+	context := sdk.WrapSDKContext(ctx)
+	setFees(*keeper, ctx, 1_000, types.RakeToCommunityPool, 0)
+	distribution.ExpectFundCommunityPool(context, 9)
+	escrow.ExpectRefund(context, alice, 81)
+	keeper.MustPayWinnings(ctx, &types.StoredGame{
+		Black:    alice,
+		Red:      bob,
+		Winner:   "b",
+		Wager:    sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+		Escrowed: sdk.NewCoins(sdk.NewInt64Coin("stake", 90)),
+	})
+}
 
-func TestWagerHandlerRefundCalled(t *testing.T) {
+func TestWagerHandlerPayPrizeWithoutWager(t *testing.T) {
+	keeper, context, ctrl, escrow := setupKeeperForWagerHandler(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	setFees(keeper, ctx, 250, types.RakeToFeeCollector, 100)
+	escrow.ExpectRefundWithDenom(context, bob, 1000, "token")
+	storedGame := types.StoredGame{
+		Black:   alice,
+		Red:     bob,
+		Winner:  "r",
+		Sponsor: carol,
+		Prize:   sdk.NewCoins(sdk.NewInt64Coin("token", 1000)),
+	}
+	keeper.MustPayWinnings(ctx, &storedGame)
+	require.Empty(t, storedGame.Prize)
+}
+
+func TestWagerHandlerPayPrizeOnTopOfWinnings(t *testing.T) {
+	keeper, context, ctrl, escrow := setupKeeperForWagerHandler(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	setFees(keeper, ctx, 1_000, types.RakeToFeeCollector, 0)
+	escrow.ExpectPayFee(context, 9)
+	escrow.ExpectRefundCoins(context, alice, sdk.NewCoins(sdk.NewInt64Coin("stake", 81), sdk.NewInt64Coin("token", 1000)))
+	keeper.MustPayWinnings(ctx, &types.StoredGame{
+		Black:    alice,
+		Red:      bob,
+		Winner:   "b",
+		Wager:    sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+		Escrowed: sdk.NewCoins(sdk.NewInt64Coin("stake", 90)),
+		Sponsor:  carol,
+		Prize:    sdk.NewCoins(sdk.NewInt64Coin("token", 1000)),
+	})
+}
+
+func TestWagerHandlerDrawSplitsPrize(t *testing.T) {
+	keeper, context, ctrl, escrow := setupKeeperForWagerHandler(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	escrow.ExpectRefundWithDenom(context, alice, 500, "token")
+	escrow.ExpectRefundWithDenom(context, bob, 500, "token")
+	escrow.ExpectRefundWithDenom(context, carol, 1, "token")
+	storedGame := types.StoredGame{
+		Black:   alice,
+		Red:     bob,
+		Winner:  types.DrawWinner,
+		Sponsor: carol,
+		Prize:   sdk.NewCoins(sdk.NewInt64Coin("token", 1001)),
+	}
+	keeper.MustRefundWager(ctx, &storedGame)
+	require.Empty(t, storedGame.Prize)
+}
+
+func TestWagerHandlerDroppedReturnsPrize(t *testing.T) {
 	keeper, context, ctrl, escrow := setupKeeperForWagerHandler(t)
 	ctx := sdk.UnwrapSDKContext(context)
 	defer ctrl.Finish()
 	escrow.ExpectRefund(context, alice, 45)
-	keeper.MustRefundWager(ctx, &types.StoredGame{
+	escrow.ExpectRefund(context, bob, 45)
+	escrow.ExpectRefundWithDenom(context, carol, 1000, "token")
+	storedGame := types.StoredGame{
+		Black:    alice,
+		Red:      bob,
+		Winner:   "*",
+		Wager:    sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+		Escrowed: sdk.NewCoins(sdk.NewInt64Coin("stake", 90)),
+		Sponsor:  carol,
+		Prize:    sdk.NewCoins(sdk.NewInt64Coin("token", 1000)),
+	}
+	keeper.MustRefundWager(ctx, &storedGame)
+	require.Empty(t, storedGame.Escrowed)
+	require.Empty(t, storedGame.Prize)
+}
+
+func TestWagerHandlerEscrowFromPlayerBalance(t *testing.T) {
+	keeper, context, ctrl, escrow := setupKeeperForWagerHandler(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	keeper.CreditPlayerBalance(ctx, alice, sdk.NewCoins(sdk.NewInt64Coin("stake", 50)))
+	keeper.CreditPlayerBalance(ctx, bob, sdk.NewCoins(sdk.NewInt64Coin("stake", 44)))
+	escrow.ExpectPay(context, bob, 45)
+	storedGame := types.StoredGame{
+		Black: alice,
+		Red:   bob,
+		Wager: sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+	}
+	err := keeper.EscrowWagers(ctx, &storedGame)
+	require.Nil(t, err)
+	require.EqualValues(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 90)), storedGame.Escrowed)
+	aliceBalance, _ := keeper.GetPlayerBalance(ctx, alice)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 5)), aliceBalance.Funds)
+	bobBalance, _ := keeper.GetPlayerBalance(ctx, bob)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 44)), bobBalance.Funds)
+}
+
+func TestWagerHandlerPayToPlayerBalance(t *testing.T) {
+	keeper, context, ctrl, _ := setupKeeperForWagerHandler(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	keeper.SetPlayerBalance(ctx, types.PlayerBalance{Index: alice})
+	storedGame := types.StoredGame{
+		Black:    alice,
+		Red:      bob,
+		Winner:   "b",
+		Wager:    sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+		Escrowed: sdk.NewCoins(sdk.NewInt64Coin("stake", 90)),
+	}
+	keeper.MustPayWinnings(ctx, &storedGame)
+	require.Empty(t, storedGame.Escrowed)
+	aliceBalance, _ := keeper.GetPlayerBalance(ctx, alice)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 90)), aliceBalance.Funds)
+}
+
+func TestWagerHandlerRefundToPlayerBalanceOrBank(t *testing.T) {
+	keeper, context, ctrl, escrow := setupKeeperForWagerHandler(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	keeper.CreditPlayerBalance(ctx, alice, sdk.NewCoins(sdk.NewInt64Coin("stake", 5)))
+	escrow.ExpectRefund(context, bob, 45)
+	storedGame := types.StoredGame{
+		Black:    alice,
+		Red:      bob,
+		Wager:    sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+		Escrowed: sdk.NewCoins(sdk.NewInt64Coin("stake", 90)),
+	}
+	keeper.MustRefundWager(ctx, &storedGame)
+	require.Empty(t, storedGame.Escrowed)
+	aliceBalance, _ := keeper.GetPlayerBalance(ctx, alice)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 50)), aliceBalance.Funds)
+	_, found := keeper.GetPlayerBalance(ctx, bob)
+	require.False(t, found)
+}
+
+func TestWagerHandlerPayOrganizerAndBettorBalances(t *testing.T) {
+	keeper, context, ctrl, escrow := setupKeeperForWagerHandler(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	setFees(keeper, ctx, 250, types.RakeToFeeCollector, 100)
+	keeper.SetPlayerBalance(ctx, types.PlayerBalance{Index: carol})
+	keeper.CreditPlayerBalance(ctx, dave, sdk.NewCoins(sdk.NewInt64Coin("stake", 5)))
+	keeper.SetBet(ctx, types.NewBet("1", dave, "b", sdk.NewCoins(sdk.NewInt64Coin("stake", 100))))
+	keeper.SetBet(ctx, types.NewBet("1", carol, "r", sdk.NewCoins(sdk.NewInt64Coin("stake", 50))))
+	escrow.ExpectPayFee(context, 25)
+	escrow.ExpectRefund(context, alice, 965)
+	storedGame := types.StoredGame{
+		Index:     "1",
 		Black:     alice,
-		MoveCount: 1,
-		Wager:     45,
-		Denom:     "stake",
-	})
+		Red:       bob,
+		Winner:    "b",
+		Wager:     sdk.NewCoins(sdk.NewInt64Coin("stake", 500)),
+		Escrowed:  sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)),
+		Organizer: carol,
+	}
+	keeper.MustPayWinnings(ctx, &storedGame)
+	keeper.MustSettleBets(ctx, &storedGame)
+	require.Empty(t, storedGame.Escrowed)
+	require.Empty(t, keeper.GetAllBet(ctx))
+	carolBalance, _ := keeper.GetPlayerBalance(ctx, carol)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), carolBalance.Funds)
+	daveBalance, _ := keeper.GetPlayerBalance(ctx, dave)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 155)), daveBalance.Funds)
+}
+
+func TestWagerHandlerPrizeFromAndToSponsorBalance(t *testing.T) {
+	keeper, context, ctrl, _ := setupKeeperForWagerHandler(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	keeper.CreditPlayerBalance(ctx, carol, sdk.NewCoins(sdk.NewInt64Coin("stake", 60)))
+	storedGame := types.StoredGame{
+		Index:   "1",
+		Black:   alice,
+		Red:     bob,
+		Sponsor: carol,
+		Prize:   sdk.NewCoins(sdk.NewInt64Coin("stake", 50)),
+	}
+	require.Nil(t, keeper.EscrowPrize(ctx, &storedGame))
+	carolBalance, _ := keeper.GetPlayerBalance(ctx, carol)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), carolBalance.Funds)
+	keeper.MustRefundWager(ctx, &storedGame)
+	require.Empty(t, storedGame.Prize)
+	carolBalance, _ = keeper.GetPlayerBalance(ctx, carol)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 60)), carolBalance.Funds)
 }
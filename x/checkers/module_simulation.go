@@ -32,6 +32,82 @@ const (
 	// TODO: Determine the simulation weight value
 	defaultWeightMsgPlayMove int = 100
 
+	opWeightMsgFundHouseBankroll = "op_weight_msg_fund_house_bankroll"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgFundHouseBankroll int = 100
+
+	opWeightMsgCommitColour = "op_weight_msg_commit_colour"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgCommitColour int = 100
+
+	opWeightMsgRevealColour = "op_weight_msg_reveal_colour"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgRevealColour int = 100
+
+	opWeightMsgSetPremove = "op_weight_msg_set_premove"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgSetPremove int = 100
+
+	opWeightMsgRequestTakeback = "op_weight_msg_request_takeback"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgRequestTakeback int = 100
+
+	opWeightMsgAcceptTakeback = "op_weight_msg_accept_takeback"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgAcceptTakeback int = 100
+
+	opWeightMsgStartVacation = "op_weight_msg_start_vacation"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgStartVacation int = 100
+
+	opWeightMsgEndVacation = "op_weight_msg_end_vacation"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgEndVacation int = 100
+
+	opWeightMsgProposeAdjourn = "op_weight_msg_propose_adjourn"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgProposeAdjourn int = 100
+
+	opWeightMsgAcceptAdjourn = "op_weight_msg_accept_adjourn"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgAcceptAdjourn int = 100
+
+	opWeightMsgResumeGame = "op_weight_msg_resume_game"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgResumeGame int = 100
+
+	opWeightMsgAcceptGame = "op_weight_msg_accept_game"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgAcceptGame int = 100
+
+	opWeightMsgPlaceBet = "op_weight_msg_place_bet"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgPlaceBet int = 100
+
+	opWeightMsgDeposit = "op_weight_msg_deposit"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgDeposit int = 100
+
+	opWeightMsgWithdraw = "op_weight_msg_withdraw"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgWithdraw int = 100
+
+	opWeightMsgCreateMatch = "op_weight_msg_create_match"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgCreateMatch int = 100
+
+	opWeightMsgAcceptMatch = "op_weight_msg_accept_match"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgAcceptMatch int = 100
+
+	opWeightMsgOfferRematch = "op_weight_msg_offer_rematch"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgOfferRematch int = 100
+
+	opWeightMsgAcceptRematch = "op_weight_msg_accept_rematch"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgAcceptRematch int = 100
+
 	// this line is used by starport scaffolding # simapp/module/const
 )
 
@@ -88,6 +164,215 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 		checkerssimulation.SimulateMsgPlayMove(am.accountKeeper, am.bankKeeper, am.keeper),
 	))
 
+	var weightMsgFundHouseBankroll int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgFundHouseBankroll, &weightMsgFundHouseBankroll, nil,
+		func(_ *rand.Rand) {
+			weightMsgFundHouseBankroll = defaultWeightMsgFundHouseBankroll
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgFundHouseBankroll,
+		checkerssimulation.SimulateMsgFundHouseBankroll(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgCommitColour int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgCommitColour, &weightMsgCommitColour, nil,
+		func(_ *rand.Rand) {
+			weightMsgCommitColour = defaultWeightMsgCommitColour
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgCommitColour,
+		checkerssimulation.SimulateMsgCommitColour(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgRevealColour int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgRevealColour, &weightMsgRevealColour, nil,
+		func(_ *rand.Rand) {
+			weightMsgRevealColour = defaultWeightMsgRevealColour
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgRevealColour,
+		checkerssimulation.SimulateMsgRevealColour(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgSetPremove int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgSetPremove, &weightMsgSetPremove, nil,
+		func(_ *rand.Rand) {
+			weightMsgSetPremove = defaultWeightMsgSetPremove
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgSetPremove,
+		checkerssimulation.SimulateMsgSetPremove(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgRequestTakeback int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgRequestTakeback, &weightMsgRequestTakeback, nil,
+		func(_ *rand.Rand) {
+			weightMsgRequestTakeback = defaultWeightMsgRequestTakeback
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgRequestTakeback,
+		checkerssimulation.SimulateMsgRequestTakeback(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgAcceptTakeback int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgAcceptTakeback, &weightMsgAcceptTakeback, nil,
+		func(_ *rand.Rand) {
+			weightMsgAcceptTakeback = defaultWeightMsgAcceptTakeback
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgAcceptTakeback,
+		checkerssimulation.SimulateMsgAcceptTakeback(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgStartVacation int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgStartVacation, &weightMsgStartVacation, nil,
+		func(_ *rand.Rand) {
+			weightMsgStartVacation = defaultWeightMsgStartVacation
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgStartVacation,
+		checkerssimulation.SimulateMsgStartVacation(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgEndVacation int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgEndVacation, &weightMsgEndVacation, nil,
+		func(_ *rand.Rand) {
+			weightMsgEndVacation = defaultWeightMsgEndVacation
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgEndVacation,
+		checkerssimulation.SimulateMsgEndVacation(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgProposeAdjourn int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgProposeAdjourn, &weightMsgProposeAdjourn, nil,
+		func(_ *rand.Rand) {
+			weightMsgProposeAdjourn = defaultWeightMsgProposeAdjourn
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgProposeAdjourn,
+		checkerssimulation.SimulateMsgProposeAdjourn(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgAcceptAdjourn int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgAcceptAdjourn, &weightMsgAcceptAdjourn, nil,
+		func(_ *rand.Rand) {
+			weightMsgAcceptAdjourn = defaultWeightMsgAcceptAdjourn
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgAcceptAdjourn,
+		checkerssimulation.SimulateMsgAcceptAdjourn(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgResumeGame int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgResumeGame, &weightMsgResumeGame, nil,
+		func(_ *rand.Rand) {
+			weightMsgResumeGame = defaultWeightMsgResumeGame
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgResumeGame,
+		checkerssimulation.SimulateMsgResumeGame(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgAcceptGame int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgAcceptGame, &weightMsgAcceptGame, nil,
+		func(_ *rand.Rand) {
+			weightMsgAcceptGame = defaultWeightMsgAcceptGame
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgAcceptGame,
+		checkerssimulation.SimulateMsgAcceptGame(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgPlaceBet int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgPlaceBet, &weightMsgPlaceBet, nil,
+		func(_ *rand.Rand) {
+			weightMsgPlaceBet = defaultWeightMsgPlaceBet
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgPlaceBet,
+		checkerssimulation.SimulateMsgPlaceBet(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgDeposit int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgDeposit, &weightMsgDeposit, nil,
+		func(_ *rand.Rand) {
+			weightMsgDeposit = defaultWeightMsgDeposit
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgDeposit,
+		checkerssimulation.SimulateMsgDeposit(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgWithdraw int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgWithdraw, &weightMsgWithdraw, nil,
+		func(_ *rand.Rand) {
+			weightMsgWithdraw = defaultWeightMsgWithdraw
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgWithdraw,
+		checkerssimulation.SimulateMsgWithdraw(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgCreateMatch int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgCreateMatch, &weightMsgCreateMatch, nil,
+		func(_ *rand.Rand) {
+			weightMsgCreateMatch = defaultWeightMsgCreateMatch
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgCreateMatch,
+		checkerssimulation.SimulateMsgCreateMatch(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgAcceptMatch int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgAcceptMatch, &weightMsgAcceptMatch, nil,
+		func(_ *rand.Rand) {
+			weightMsgAcceptMatch = defaultWeightMsgAcceptMatch
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgAcceptMatch,
+		checkerssimulation.SimulateMsgAcceptMatch(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgOfferRematch int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgOfferRematch, &weightMsgOfferRematch, nil,
+		func(_ *rand.Rand) {
+			weightMsgOfferRematch = defaultWeightMsgOfferRematch
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgOfferRematch,
+		checkerssimulation.SimulateMsgOfferRematch(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgAcceptRematch int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgAcceptRematch, &weightMsgAcceptRematch, nil,
+		func(_ *rand.Rand) {
+			weightMsgAcceptRematch = defaultWeightMsgAcceptRematch
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgAcceptRematch,
+		checkerssimulation.SimulateMsgAcceptRematch(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
 	// this line is used by starport scaffolding # simapp/module/operation
 
 	return operations
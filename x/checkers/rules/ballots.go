@@ -0,0 +1,72 @@
+package rules
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Ballots is the three-move opening deck of the American Checker Federation,
+// which draws the first three moves of championship games. Of the 216
+// positions three moves can reach, the 42 that drop a piece and the 18 that the
+// ACF bars as lost are left out, leaving its 156 ballots. A ballot that can be
+// reached in two move orders appears once, in square order, and is named by
+// its moves.
+var Ballots = []string{
+	"9-13 21-17 5-9", "9-13 21-17 6-9", "9-13 21-17 10-14", "9-13 21-17 10-15",
+	"9-13 21-17 11-15", "9-13 21-17 11-16", "9-13 21-17 12-16", "9-13 22-17 13x22",
+	"9-13 22-18 6-9", "9-13 22-18 10-14", "9-13 22-18 10-15", "9-13 22-18 11-15",
+	"9-13 22-18 11-16", "9-13 22-18 12-16", "9-13 22-18 13-17", "9-13 23-18 5-9",
+	"9-13 23-18 6-9", "9-13 23-18 10-14", "9-13 23-18 11-15", "9-13 23-18 11-16",
+	"9-13 23-18 12-16", "9-13 23-19 5-9", "9-13 23-19 6-9", "9-13 23-19 10-14",
+	"9-13 23-19 10-15", "9-13 23-19 11-15", "9-13 23-19 11-16", "9-13 24-19 5-9",
+	"9-13 24-19 6-9", "9-13 24-19 10-15", "9-13 24-19 11-15", "9-13 24-19 11-16",
+	"9-13 24-20 5-9", "9-13 24-20 6-9", "9-13 24-20 10-14", "9-13 24-20 10-15",
+	"9-13 24-20 11-15", "9-13 24-20 11-16", "9-13 24-20 12-16",
+	"9-14 22-17 5-9", "9-14 22-17 6-9", "9-14 22-17 10-15", "9-14 22-17 11-15",
+	"9-14 22-17 11-16", "9-14 22-18 5-9", "9-14 22-18 6-9", "9-14 22-18 10-15",
+	"9-14 22-18 11-15", "9-14 22-18 11-16", "9-14 22-18 12-16", "9-14 23-18 14x23",
+	"9-14 23-19 5-9", "9-14 23-19 6-9", "9-14 23-19 10-15", "9-14 23-19 11-15",
+	"9-14 23-19 11-16", "9-14 23-19 14-18", "9-14 24-19 5-9", "9-14 24-19 6-9",
+	"9-14 24-19 10-15", "9-14 24-19 11-15", "9-14 24-20 5-9", "9-14 24-20 6-9",
+	"9-14 24-20 10-15", "9-14 24-20 11-15", "9-14 24-20 11-16",
+	"10-14 22-17 7-10", "10-14 22-17 9-13", "10-14 22-17 11-15", "10-14 22-17 11-16",
+	"10-14 22-17 14-18", "10-14 22-18 7-10", "10-14 22-18 11-15", "10-14 23-18 14x23",
+	"10-14 23-19 7-10", "10-14 23-19 11-15", "10-14 24-19 7-10", "10-14 24-19 11-15",
+	"10-14 24-20 7-10", "10-14 24-20 11-16", "10-14 24-20 14-18",
+	"10-15 21-17 6-10", "10-15 21-17 7-10", "10-15 21-17 9-14", "10-15 21-17 15-18",
+	"10-15 22-17 6-10", "10-15 22-17 7-10", "10-15 22-17 9-13", "10-15 22-17 15-19",
+	"10-15 22-18 15x22", "10-15 23-18 6-10", "10-15 23-18 7-10", "10-15 23-18 9-14",
+	"10-15 23-18 11-16", "10-15 23-18 12-16", "10-15 23-19 6-10", "10-15 23-19 7-10",
+	"10-15 23-19 11-16", "10-15 24-19 15x24", "10-15 24-20 6-10", "10-15 24-20 7-10",
+	"10-15 24-20 11-16", "10-15 24-20 12-16",
+	"11-15 21-17 8-11", "11-15 21-17 9-14", "11-15 21-17 10-14", "11-15 22-17 8-11",
+	"11-15 22-17 9-13", "11-15 22-17 15-18", "11-15 22-17 15-19", "11-15 22-18 15x22",
+	"11-15 23-18 8-11", "11-15 23-18 9-14", "11-15 23-18 10-14", "11-15 23-18 12-16",
+	"11-15 23-18 15-19", "11-15 23-19 8-11", "11-15 24-19 15x24", "11-15 24-20 8-11",
+	"11-15 24-20 12-16", "11-15 24-20 15-18", "11-15 24-20 15-19",
+	"11-16 21-17 7-11", "11-16 21-17 8-11", "11-16 21-17 9-14", "11-16 21-17 10-14",
+	"11-16 21-17 16-20", "11-16 22-17 7-11", "11-16 22-17 8-11", "11-16 22-17 9-13",
+	"11-16 22-17 16-20", "11-16 22-18 7-11", "11-16 22-18 8-11", "11-16 22-18 10-15",
+	"11-16 22-18 16-20", "11-16 23-18 7-11", "11-16 23-18 8-11", "11-16 23-18 9-14",
+	"11-16 23-18 16-20", "11-16 23-19 16x23", "11-16 24-19 7-11", "11-16 24-19 8-11",
+	"11-16 24-19 10-15", "11-16 24-20 7-11", "11-16 24-20 8-11",
+	"12-16 21-17 9-14", "12-16 21-17 16-19", "12-16 21-17 16-20", "12-16 22-17 16-19",
+	"12-16 22-17 16-20", "12-16 22-18 16-20", "12-16 23-18 9-14", "12-16 23-18 16-19",
+	"12-16 23-18 16-20", "12-16 23-19 16x23", "12-16 24-19 16-20",
+}
+
+// PlayBallot plays the moves of a ballot from the standard start.
+func PlayBallot(ballot string) (*Game, error) {
+	moves := strings.Fields(ballot)
+	if len(moves) != 3 {
+		return nil, errors.New(fmt.Sprintf("ballot has %d moves, expected 3: %s", len(moves), ballot))
+	}
+	game := New()
+	for _, move := range moves {
+		if _, err := game.PlayNotation(move); err != nil {
+			return nil, err
+		}
+	}
+	return game, nil
+}
@@ -0,0 +1,32 @@
+package rules_test
+
+import (
+	"testing"
+
+	"github.com/satya/checkers/x/checkers/rules"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBallotsArePlayable(t *testing.T) {
+	require.Len(t, rules.Ballots, 156)
+	seen := map[string]bool{}
+	for _, ballot := range rules.Ballots {
+		game, err := rules.PlayBallot(ballot)
+		require.Nil(t, err, ballot)
+		require.Nil(t, game.ValidateSetup(), ballot)
+		require.False(t, seen[game.String()], ballot)
+		seen[game.String()] = true
+	}
+}
+
+func TestPlayBallot(t *testing.T) {
+	game, err := rules.PlayBallot("9-13 22-17 13x22")
+	require.Nil(t, err)
+	require.Equal(t, "*b*b*b*b|b*b*b*b*|***b*b*b|********|********|r*b*r*r*|*r*r*r*r|r*r*r*r*", game.String())
+	require.Equal(t, rules.RED_PLAYER, game.Turn)
+}
+
+func TestPlayBallotWrongLength(t *testing.T) {
+	_, err := rules.PlayBallot("9-13 22-17")
+	require.EqualError(t, err, "ballot has 2 moves, expected 3: 9-13 22-17")
+}
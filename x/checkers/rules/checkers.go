@@ -137,6 +137,14 @@ func (game *Game) addInitialPieces() {
 	}
 }
 
+func (game *Game) Copy() *Game {
+	pieces := make(map[Pos]Piece, len(game.Pieces))
+	for pos, piece := range game.Pieces {
+		pieces[pos] = piece
+	}
+	return &Game{pieces, game.Turn}
+}
+
 func (game *Game) PieceAt(pos Pos) bool {
 	_, ok := game.Pieces[pos]
 	return ok
@@ -0,0 +1,73 @@
+package rules
+
+import (
+	"sort"
+)
+
+// Candidate destinations per square, ordered by square number, so that move
+// generation does not depend on map iteration order.
+var manTargets = map[Player]map[Pos][]Pos{}
+var kingTargets = map[Pos][]Pos{}
+
+func init() {
+	for _, player := range Players {
+		manTargets[player] = map[Pos][]Pos{}
+	}
+	for pos := range Usable {
+		for _, player := range Players {
+			manTargets[player][pos] = sortedTargets(Moves[player][pos], Jumps[player][pos])
+		}
+		kingTargets[pos] = sortedTargets(KingMoves[pos], KingJumps[pos])
+	}
+}
+
+func sortedTargets(moves map[Pos]bool, jumps map[Pos]Pos) []Pos {
+	targets := make([]Pos, 0, len(moves)+len(jumps))
+	for dst := range moves {
+		targets = append(targets, dst)
+	}
+	for dst := range jumps {
+		targets = append(targets, dst)
+	}
+	sort.Slice(targets, func(i, j int) bool {
+		return targets[i].Y < targets[j].Y || (targets[i].Y == targets[j].Y && targets[i].X < targets[j].X)
+	})
+	return targets
+}
+
+// targetsFrom lists where the piece on src could step or jump to, on an empty
+// board.
+func (game *Game) targetsFrom(src Pos) []Pos {
+	piece := game.Pieces[src]
+	if piece.King {
+		return kingTargets[src]
+	}
+	return manTargets[piece.Player][src]
+}
+
+// LegalSteps lists the steps the player to move can take, in square order.
+// When a jump is available only jumps are listed, as ValidMove requires.
+func (game *Game) LegalSteps() []Step {
+	var moves, jumps []Step
+	for square := 1; square <= SQUARE_COUNT; square++ {
+		src, _ := SquareToPos(square)
+		piece, found := game.Pieces[src]
+		if !found || piece.Player != game.Turn {
+			continue
+		}
+		for _, dst := range game.targetsFrom(src) {
+			step := Step{src, dst}
+			if step.IsJump() {
+				if game.ValidJump(src, dst) {
+					jumps = append(jumps, step)
+				}
+			} else if len(jumps) == 0 && !game.PieceAt(dst) {
+				moves = append(moves, step)
+			}
+		}
+	}
+	if len(jumps) > 0 {
+		return jumps
+	}
+	return moves
+}
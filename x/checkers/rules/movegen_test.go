@@ -0,0 +1,27 @@
+package rules_test
+
+import (
+	"testing"
+
+	"github.com/satya/checkers/x/checkers/rules"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLegalStepsMatchValidMove(t *testing.T) {
+	for _, fen := range []string{initialFen, "B:W14,23:B9", "W:WK3,21,22:B1,K30", "B:W18,K6:B14,K1"} {
+		game, err := rules.ParseFEN(fen)
+		require.Nil(t, err)
+		legal := map[rules.Step]bool{}
+		for _, step := range game.LegalSteps() {
+			legal[step] = true
+		}
+		for src := range rules.Usable {
+			for dst := range rules.Usable {
+				step := rules.Step{Src: src, Dst: dst}
+				piece, found := game.Pieces[src]
+				valid := found && piece.Player == game.Turn && game.ValidMove(src, dst)
+				require.Equal(t, valid, legal[step], "%s %v", fen, step)
+			}
+		}
+	}
+}
@@ -0,0 +1,121 @@
+package rules
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Squares are numbered as in standard English draughts notation. Black starts
+// on squares 1 to 12 and red on squares 21 to 32. Square 1 is Pos{1, 0},
+// square 4 is Pos{7, 0} and square 5 is Pos{0, 1}.
+const (
+	SQUARE_COUNT = BOARD_DIM * BOARD_DIM / 2
+	MOVE_SEP     = "-"
+	JUMP_SEP     = "x"
+)
+
+type Step struct {
+	Src Pos
+	Dst Pos
+}
+
+func (step Step) IsJump() bool {
+	dx := step.Dst.X - step.Src.X
+	return dx == 2 || dx == -2
+}
+
+func PosToSquare(pos Pos) (int, error) {
+	if !Usable[pos] {
+		return 0, errors.New(fmt.Sprintf("not a playable square: %v", pos))
+	}
+	return pos.Y*BOARD_DIM/2 + pos.X/2 + 1, nil
+}
+
+func SquareToPos(square int) (Pos, error) {
+	if square < 1 || SQUARE_COUNT < square {
+		return NO_POS, errors.New(fmt.Sprintf("square out of range: %d", square))
+	}
+	index := square - 1
+	y := index / (BOARD_DIM / 2)
+	x := 2*(index%(BOARD_DIM/2)) + (y+1)%2
+	return Pos{X: x, Y: y}, nil
+}
+
+func FormatStep(step Step) (string, error) {
+	return FormatPath([]Pos{step.Src, step.Dst})
+}
+
+// FormatPath writes a move as numbered squares, "11-15" for a simple move and
+// "15x24" or "15x24x31" for captures.
+func FormatPath(path []Pos) (string, error) {
+	if len(path) < 2 {
+		return "", errors.New(fmt.Sprintf("path too short: %v", path))
+	}
+	sep := MOVE_SEP
+	if 2 < len(path) || (Step{path[0], path[1]}).IsJump() {
+		sep = JUMP_SEP
+	}
+	squares := make([]string, 0, len(path))
+	for _, pos := range path {
+		square, err := PosToSquare(pos)
+		if err != nil {
+			return "", err
+		}
+		squares = append(squares, strconv.Itoa(square))
+	}
+	return strings.Join(squares, sep), nil
+}
+
+// ParsePath reads a move written as numbered squares. It tells whether the
+// move was written as a capture, which is only a claim the caller has to
+// check against the board.
+func ParsePath(s string) (path []Pos, jump bool, err error) {
+	jump = strings.Contains(s, JUMP_SEP)
+	sep := MOVE_SEP
+	if jump {
+		if strings.Contains(s, MOVE_SEP) {
+			return nil, false, errors.New(fmt.Sprintf("mixed separators in move: %s", s))
+		}
+		sep = JUMP_SEP
+	}
+	parts := strings.Split(s, sep)
+	if len(parts) < 2 || (!jump && len(parts) != 2) {
+		return nil, false, errors.New(fmt.Sprintf("invalid move: %s", s))
+	}
+	path = make([]Pos, 0, len(parts))
+	for _, part := range parts {
+		square, err := strconv.Atoi(part)
+		if err != nil {
+			return nil, false, errors.New(fmt.Sprintf("invalid square in move: %s", s))
+		}
+		pos, err := SquareToPos(square)
+		if err != nil {
+			return nil, false, err
+		}
+		path = append(path, pos)
+	}
+	return path, jump, nil
+}
+
+func ParseStep(s string) (Step, error) {
+	path, _, err := ParsePath(s)
+	if err != nil {
+		return Step{}, err
+	}
+	if len(path) != 2 {
+		return Step{}, errors.New(fmt.Sprintf("not a single step: %s", s))
+	}
+	return Step{path[0], path[1]}, nil
+}
+
+// PlayNotation plays one whole move given in notation, such as "11-15" or the
+// multi-jump "9x18x27", and returns its steps.
+func (game *Game) PlayNotation(move string) (steps []Step, err error) {
+	path, jump, err := ParsePath(move)
+	if err != nil {
+		return nil, err
+	}
+	return game.playPath(path, jump)
+}
@@ -0,0 +1,461 @@
+package rules
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Portable Draughts Notation, restricted to English draughts. Red takes the
+// place of PDN's White.
+const (
+	PDN_GAME_TYPE    = "21"
+	PDN_LINE_WIDTH   = 79
+	RESULT_BLACK_WIN = "1-0"
+	RESULT_RED_WIN   = "0-1"
+	RESULT_DRAW      = "1/2-1/2"
+	RESULT_ONGOING   = "*"
+	FEN_BLACK        = "B"
+	FEN_RED          = "W"
+	FEN_KING         = "K"
+	TAG_GAME_TYPE    = "GameType"
+	TAG_FEN          = "FEN"
+	TAG_RESULT       = "Result"
+)
+
+var Results = map[string]bool{
+	RESULT_BLACK_WIN: true,
+	RESULT_RED_WIN:   true,
+	RESULT_DRAW:      true,
+	RESULT_ONGOING:   true,
+}
+
+var pdnTagRegexp = regexp.MustCompile(`^\[\s*(\w+)\s+"((?:[^"\\]|\\.)*)"\s*\]`)
+var pdnMoveNumberRegexp = regexp.MustCompile(`^\d+\.+`)
+
+type PdnTag struct {
+	Name  string
+	Value string
+}
+
+// PdnGame is a game read from or written to PDN. Steps holds every single move
+// or jump in the order played, so a multi-jump written 15x24x31 is two steps.
+type PdnGame struct {
+	Tags   []PdnTag
+	Start  *Game
+	Steps  []Step
+	Result string
+}
+
+type pdnMove struct {
+	player Player
+	path   []Pos
+}
+
+func (pdn *PdnGame) Tag(name string) (value string, found bool) {
+	for _, tag := range pdn.Tags {
+		if tag.Name == name {
+			return tag.Value, true
+		}
+	}
+	return "", false
+}
+
+func ResultFor(winner Player) string {
+	switch winner {
+	case BLACK_PLAYER:
+		return RESULT_BLACK_WIN
+	case RED_PLAYER:
+		return RESULT_RED_WIN
+	}
+	return RESULT_ONGOING
+}
+
+func (game *Game) IsInitial() bool {
+	return game.Turn == BLACK_PLAYER && game.String() == New().String()
+}
+
+func FormatFEN(game *Game) string {
+	turn := FEN_BLACK
+	if game.Turn == RED_PLAYER {
+		turn = FEN_RED
+	}
+	return fmt.Sprintf("%s:%s%s:%s%s",
+		turn,
+		FEN_RED, fenSquares(game, RED_PLAYER),
+		FEN_BLACK, fenSquares(game, BLACK_PLAYER))
+}
+
+func fenSquares(game *Game, player Player) string {
+	squares := []string{}
+	for square := 1; square <= SQUARE_COUNT; square++ {
+		pos, _ := SquareToPos(square)
+		piece, found := game.Pieces[pos]
+		if !found || piece.Player != player {
+			continue
+		}
+		value := strconv.Itoa(square)
+		if piece.King {
+			value = FEN_KING + value
+		}
+		squares = append(squares, value)
+	}
+	return strings.Join(squares, ",")
+}
+
+func ParseFEN(fen string) (*Game, error) {
+	fields := strings.Split(strings.TrimSuffix(strings.TrimSpace(fen), "."), ":")
+	if len(fields) != 3 {
+		return nil, errors.New(fmt.Sprintf("invalid FEN: %s", fen))
+	}
+	game := &Game{make(map[Pos]Piece), BLACK_PLAYER}
+	switch strings.ToUpper(fields[0]) {
+	case FEN_BLACK:
+		game.Turn = BLACK_PLAYER
+	case FEN_RED:
+		game.Turn = RED_PLAYER
+	default:
+		return nil, errors.New(fmt.Sprintf("invalid FEN, unknown side to move: %s", fields[0]))
+	}
+	seen := map[Player]bool{}
+	for _, field := range fields[1:] {
+		if field == "" {
+			return nil, errors.New(fmt.Sprintf("invalid FEN, empty field: %s", fen))
+		}
+		var player Player
+		switch strings.ToUpper(field[:1]) {
+		case FEN_BLACK:
+			player = BLACK_PLAYER
+		case FEN_RED:
+			player = RED_PLAYER
+		default:
+			return nil, errors.New(fmt.Sprintf("invalid FEN, unknown colour: %s", field[:1]))
+		}
+		if seen[player] {
+			return nil, errors.New(fmt.Sprintf("invalid FEN, colour listed twice: %s", field[:1]))
+		}
+		seen[player] = true
+		if len(field) == 1 {
+			continue
+		}
+		for _, item := range strings.Split(field[1:], ",") {
+			if err := addFenItem(game, player, strings.TrimSpace(item)); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return game, nil
+}
+
+func addFenItem(game *Game, player Player, item string) error {
+	king := strings.HasPrefix(strings.ToUpper(item), FEN_KING)
+	if king {
+		item = item[len(FEN_KING):]
+	}
+	bounds := strings.SplitN(item, "-", 2)
+	first, err := strconv.Atoi(bounds[0])
+	if err != nil {
+		return errors.New(fmt.Sprintf("invalid FEN square: %s", item))
+	}
+	last := first
+	if len(bounds) == 2 {
+		if last, err = strconv.Atoi(bounds[1]); err != nil || last < first {
+			return errors.New(fmt.Sprintf("invalid FEN square range: %s", item))
+		}
+	}
+	for square := first; square <= last; square++ {
+		pos, err := SquareToPos(square)
+		if err != nil {
+			return err
+		}
+		if game.PieceAt(pos) {
+			return errors.New(fmt.Sprintf("invalid FEN, square listed twice: %d", square))
+		}
+		game.Pieces[pos] = Piece{player, king}
+	}
+	return nil
+}
+
+// WritePDN replays the steps from the start position, grouping consecutive
+// jumps of a multi-jump into a single PDN move. The GameType, FEN and Result
+// tags are derived from the game and must not be passed in Tags.
+func WritePDN(pdn *PdnGame) (string, error) {
+	start := pdn.Start
+	if start == nil {
+		start = New()
+	}
+	result := pdn.Result
+	if result == "" {
+		result = RESULT_ONGOING
+	}
+	if !Results[result] {
+		return "", errors.New(fmt.Sprintf("invalid result: %s", result))
+	}
+	moves, err := groupSteps(start, pdn.Steps)
+	if err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	tags := append([]PdnTag{}, pdn.Tags...)
+	tags = append(tags, PdnTag{TAG_GAME_TYPE, PDN_GAME_TYPE})
+	if !start.IsInitial() {
+		tags = append(tags, PdnTag{TAG_FEN, FormatFEN(start)})
+	}
+	tags = append(tags, PdnTag{TAG_RESULT, result})
+	for _, tag := range tags {
+		value := strings.ReplaceAll(strings.ReplaceAll(tag.Value, `\`, `\\`), `"`, `\"`)
+		buf.WriteString(fmt.Sprintf("[%s \"%s\"]\n", tag.Name, value))
+	}
+	buf.WriteString("\n")
+
+	tokens := make([]string, 0, len(moves)+len(moves)/2+1)
+	number := 1
+	var previous Player
+	for i, move := range moves {
+		text, err := FormatPath(move.path)
+		if err != nil {
+			return "", err
+		}
+		if move.player == BLACK_PLAYER {
+			if i > 0 && previous == BLACK_PLAYER {
+				number++
+			}
+			tokens = append(tokens, fmt.Sprintf("%d.", number))
+		} else if i == 0 || previous != BLACK_PLAYER {
+			tokens = append(tokens, fmt.Sprintf("%d...", number))
+		}
+		tokens = append(tokens, text)
+		if move.player == RED_PLAYER {
+			number++
+		}
+		previous = move.player
+	}
+	tokens = append(tokens, result)
+
+	lineLength := 0
+	for _, token := range tokens {
+		if 0 < lineLength && PDN_LINE_WIDTH < lineLength+1+len(token) {
+			buf.WriteString("\n")
+			lineLength = 0
+		} else if 0 < lineLength {
+			buf.WriteString(" ")
+			lineLength++
+		}
+		buf.WriteString(token)
+		lineLength += len(token)
+	}
+	buf.WriteString("\n")
+	return buf.String(), nil
+}
+
+func groupSteps(start *Game, steps []Step) (moves []pdnMove, err error) {
+	game := start.Copy()
+	continuing := false
+	for i, step := range steps {
+		mover := game.Turn
+		captured, err := game.Move(step.Src, step.Dst)
+		if err != nil {
+			return nil, errors.New(fmt.Sprintf("step %d: %s", i+1, err.Error()))
+		}
+		last := len(moves) - 1
+		if continuing && captured != NO_POS && moves[last].player == mover &&
+			moves[last].path[len(moves[last].path)-1] == step.Src {
+			moves[last].path = append(moves[last].path, step.Dst)
+		} else {
+			moves = append(moves, pdnMove{mover, []Pos{step.Src, step.Dst}})
+		}
+		continuing = captured != NO_POS && game.Turn == mover && game.jumpPossibleFrom(step.Dst)
+	}
+	return moves, nil
+}
+
+// ParsePDN reads the first game of a PDN text and replays every move through
+// the rules, so that the returned game is known to be legal.
+func ParsePDN(text string) (*PdnGame, error) {
+	pdn := &PdnGame{Result: RESULT_ONGOING}
+	rest := strings.TrimSpace(text)
+	for strings.HasPrefix(rest, "[") {
+		match := pdnTagRegexp.FindStringSubmatch(rest)
+		if match == nil {
+			return nil, errors.New(fmt.Sprintf("invalid PDN tag: %s", strings.SplitN(rest, "\n", 2)[0]))
+		}
+		value := strings.ReplaceAll(strings.ReplaceAll(match[2], `\"`, `"`), `\\`, `\`)
+		pdn.Tags = append(pdn.Tags, PdnTag{match[1], value})
+		rest = strings.TrimSpace(rest[len(match[0]):])
+	}
+
+	if gameType, found := pdn.Tag(TAG_GAME_TYPE); found && strings.Split(gameType, ",")[0] != PDN_GAME_TYPE {
+		return nil, errors.New(fmt.Sprintf("unsupported PDN game type: %s", gameType))
+	}
+	pdn.Start = New()
+	if fen, found := pdn.Tag(TAG_FEN); found {
+		start, err := ParseFEN(fen)
+		if err != nil {
+			return nil, err
+		}
+		pdn.Start = start
+	}
+	tagResult, hasTagResult := pdn.Tag(TAG_RESULT)
+	if hasTagResult {
+		if !Results[tagResult] {
+			return nil, errors.New(fmt.Sprintf("invalid result tag: %s", tagResult))
+		}
+		pdn.Result = tagResult
+	}
+
+	movetext, err := stripPdnComments(rest)
+	if err != nil {
+		return nil, err
+	}
+	game := pdn.Start.Copy()
+	for _, token := range strings.Fields(movetext) {
+		token = strings.TrimRight(token, "!?")
+		if number := pdnMoveNumberRegexp.FindString(token); number != "" {
+			token = token[len(number):]
+		}
+		if strings.Trim(token, ".") == "" || strings.HasPrefix(token, "$") {
+			continue
+		}
+		if Results[token] {
+			if hasTagResult && token != tagResult {
+				return nil, errors.New(fmt.Sprintf("result tag %s does not match game result %s", tagResult, token))
+			}
+			pdn.Result = token
+			break
+		}
+		path, jump, err := ParsePath(token)
+		if err != nil {
+			return nil, err
+		}
+		steps, err := game.playPath(path, jump)
+		if err != nil {
+			return nil, errors.New(fmt.Sprintf("move %s: %s", token, err.Error()))
+		}
+		pdn.Steps = append(pdn.Steps, steps...)
+	}
+	return pdn, nil
+}
+
+func stripPdnComments(text string) (string, error) {
+	var buf bytes.Buffer
+	depth := 0
+	inComment := false
+	inLineComment := false
+	for _, c := range text {
+		switch {
+		case inLineComment:
+			if c == '\n' {
+				inLineComment = false
+				buf.WriteRune(c)
+			}
+		case inComment:
+			if c == '}' {
+				inComment = false
+				buf.WriteRune(' ')
+			}
+		case c == '{':
+			inComment = true
+		case c == '(':
+			depth++
+		case c == ')':
+			if depth == 0 {
+				return "", errors.New("unbalanced variation in PDN")
+			}
+			depth--
+			buf.WriteRune(' ')
+		case depth > 0:
+		case c == ';':
+			inLineComment = true
+		default:
+			buf.WriteRune(c)
+		}
+	}
+	if inComment || depth > 0 {
+		return "", errors.New("unterminated comment or variation in PDN")
+	}
+	return buf.String(), nil
+}
+
+func (game *Game) playPath(path []Pos, jump bool) (steps []Step, err error) {
+	if jump && len(path) == 2 && !(Step{path[0], path[1]}).IsJump() {
+		path, err = game.resolveCapture(path[0], path[1])
+		if err != nil {
+			return nil, err
+		}
+	}
+	mover := game.Turn
+	for i := 1; i < len(path); i++ {
+		// Whether a step captures is told by the board, not by the separator.
+		step := Step{path[i-1], path[i]}
+		if jump && !step.IsJump() {
+			return nil, errors.New(fmt.Sprintf("not a capture: %v to %v", step.Src, step.Dst))
+		}
+		if !jump && step.IsJump() {
+			return nil, errors.New(fmt.Sprintf("capture must be written with %s: %v to %v", JUMP_SEP, step.Src, step.Dst))
+		}
+		if 1 < i && game.Turn != mover {
+			return nil, errors.New("capture continues after the turn ended")
+		}
+		if _, err := game.Move(step.Src, step.Dst); err != nil {
+			return nil, err
+		}
+		steps = append(steps, step)
+	}
+	last := path[len(path)-1]
+	if game.Turn == mover && game.jumpPossibleFrom(last) {
+		return nil, errors.New(fmt.Sprintf("capture is incomplete at %v", last))
+	}
+	return steps, nil
+}
+
+// resolveCapture finds the only capture sequence from src to dst, for PDN
+// writers that give a multi-jump by its first and last squares.
+func (game *Game) resolveCapture(src, dst Pos) ([]Pos, error) {
+	var found []Pos
+	for _, path := range game.capturePaths([]Pos{src}) {
+		if path[len(path)-1] != dst {
+			continue
+		}
+		if found != nil {
+			return nil, errors.New(fmt.Sprintf("ambiguous capture: %v to %v", src, dst))
+		}
+		found = path
+	}
+	if found == nil {
+		return nil, errors.New(fmt.Sprintf("no capture from %v to %v", src, dst))
+	}
+	return found, nil
+}
+
+func (game *Game) capturePaths(path []Pos) (paths [][]Pos) {
+	src := path[len(path)-1]
+	piece, found := game.Pieces[src]
+	if !found {
+		return nil
+	}
+	targets := KingJumps[src]
+	if !piece.King {
+		targets = Jumps[piece.Player][src]
+	}
+	mover := game.Turn
+	for dst := range targets {
+		if !game.ValidJump(src, dst) {
+			continue
+		}
+		next := game.Copy()
+		if _, err := next.Move(src, dst); err != nil {
+			continue
+		}
+		extended := append(append([]Pos{}, path...), dst)
+		if next.Turn == mover && next.jumpPossibleFrom(dst) {
+			paths = append(paths, next.capturePaths(extended)...)
+		} else {
+			paths = append(paths, extended)
+		}
+	}
+	return paths
+}
@@ -0,0 +1,150 @@
+package rules_test
+
+import (
+	"testing"
+
+	"github.com/satya/checkers/x/checkers/rules"
+	"github.com/stretchr/testify/require"
+)
+
+const initialFen = "B:W21,22,23,24,25,26,27,28,29,30,31,32:B1,2,3,4,5,6,7,8,9,10,11,12"
+
+func TestSquareNumbering(t *testing.T) {
+	for square := 1; square <= rules.SQUARE_COUNT; square++ {
+		pos, err := rules.SquareToPos(square)
+		require.Nil(t, err)
+		require.True(t, rules.Usable[pos])
+		back, err := rules.PosToSquare(pos)
+		require.Nil(t, err)
+		require.Equal(t, square, back)
+	}
+	pos, _ := rules.SquareToPos(1)
+	require.Equal(t, rules.Pos{X: 1, Y: 0}, pos)
+	pos, _ = rules.SquareToPos(5)
+	require.Equal(t, rules.Pos{X: 0, Y: 1}, pos)
+	pos, _ = rules.SquareToPos(32)
+	require.Equal(t, rules.Pos{X: 6, Y: 7}, pos)
+}
+
+func TestSquareNumberingInvalid(t *testing.T) {
+	_, err := rules.SquareToPos(0)
+	require.EqualError(t, err, "square out of range: 0")
+	_, err = rules.SquareToPos(33)
+	require.EqualError(t, err, "square out of range: 33")
+	_, err = rules.PosToSquare(rules.Pos{X: 0, Y: 0})
+	require.EqualError(t, err, "not a playable square: {0 0}")
+}
+
+func TestFormatAndParsePath(t *testing.T) {
+	text, err := rules.FormatStep(rules.Step{Src: rules.Pos{X: 5, Y: 2}, Dst: rules.Pos{X: 4, Y: 3}})
+	require.Nil(t, err)
+	require.Equal(t, "11-15", text)
+
+	path, jump, err := rules.ParsePath("11x18x25")
+	require.Nil(t, err)
+	require.True(t, jump)
+	text, err = rules.FormatPath(path)
+	require.Nil(t, err)
+	require.Equal(t, "11x18x25", text)
+
+	_, _, err = rules.ParsePath("11-15-18")
+	require.EqualError(t, err, "invalid move: 11-15-18")
+	_, _, err = rules.ParsePath("11-15x18")
+	require.EqualError(t, err, "mixed separators in move: 11-15x18")
+}
+
+func TestFenInitial(t *testing.T) {
+	require.Equal(t, initialFen, rules.FormatFEN(rules.New()))
+	game, err := rules.ParseFEN(initialFen)
+	require.Nil(t, err)
+	require.True(t, game.IsInitial())
+}
+
+func TestFenKingsAndRanges(t *testing.T) {
+	game, err := rules.ParseFEN("W:WK3,21-22:B1,K30.")
+	require.Nil(t, err)
+	require.Equal(t, rules.RED_PLAYER, game.Turn)
+	require.Equal(t, "W:WK3,21,22:B1,K30", rules.FormatFEN(game))
+
+	_, err = rules.ParseFEN("B:W1:B1")
+	require.EqualError(t, err, "invalid FEN, square listed twice: 1")
+	_, err = rules.ParseFEN("X:W1:B2")
+	require.EqualError(t, err, "invalid FEN, unknown side to move: X")
+}
+
+func TestWritePdnGroupsMultiJumps(t *testing.T) {
+	start, err := rules.ParseFEN("B:W14,23:B9")
+	require.Nil(t, err)
+	pdn, err := rules.WritePDN(&rules.PdnGame{
+		Tags:  []rules.PdnTag{{Name: "Event", Value: "multi"}},
+		Start: start,
+		Steps: []rules.Step{
+			{Src: rules.Pos{X: 1, Y: 2}, Dst: rules.Pos{X: 3, Y: 4}},
+			{Src: rules.Pos{X: 3, Y: 4}, Dst: rules.Pos{X: 5, Y: 6}},
+		},
+		Result: rules.RESULT_BLACK_WIN,
+	})
+	require.Nil(t, err)
+	require.Equal(t, "[Event \"multi\"]\n"+
+		"[GameType \"21\"]\n"+
+		"[FEN \"B:W14,23:B9\"]\n"+
+		"[Result \"1-0\"]\n"+
+		"\n"+
+		"1. 9x18x27 1-0\n", pdn)
+}
+
+func TestWritePdnRejectsIllegalStep(t *testing.T) {
+	_, err := rules.WritePDN(&rules.PdnGame{
+		Steps: []rules.Step{{Src: rules.Pos{X: 1, Y: 0}, Dst: rules.Pos{X: 2, Y: 1}}},
+	})
+	require.EqualError(t, err, "step 1: Already piece at destination position: {2 1}")
+}
+
+func TestParsePdnRoundTrip(t *testing.T) {
+	text := "[Event \"test\"]\n[Result \"*\"]\n\n" +
+		"1. 11-15 {a comment} 22-18 2. 15x22 (2. 9-13) 25x18 *\n"
+	pdn, err := rules.ParsePDN(text)
+	require.Nil(t, err)
+	require.Equal(t, rules.RESULT_ONGOING, pdn.Result)
+	require.Len(t, pdn.Steps, 4)
+	value, found := pdn.Tag("Event")
+	require.True(t, found)
+	require.Equal(t, "test", value)
+
+	pdn.Tags = pdn.Tags[:1]
+	written, err := rules.WritePDN(pdn)
+	require.Nil(t, err)
+	require.Equal(t, "[Event \"test\"]\n[GameType \"21\"]\n[Result \"*\"]\n\n"+
+		"1. 11-15 22-18 2. 15x22 25x18 *\n", written)
+}
+
+func TestParsePdnShortCapture(t *testing.T) {
+	pdn, err := rules.ParsePDN("[FEN \"B:W14,23:B9\"]\n1. 9x27 1-0")
+	require.Nil(t, err)
+	require.Len(t, pdn.Steps, 2)
+	require.Equal(t, rules.RESULT_BLACK_WIN, pdn.Result)
+}
+
+func TestParsePdnIllegalMove(t *testing.T) {
+	_, err := rules.ParsePDN("1. 11-14 *")
+	require.EqualError(t, err, "move 11-14: Invalid move: {5 2} to {2 3}")
+}
+
+func TestParsePdnIncompleteCapture(t *testing.T) {
+	_, err := rules.ParsePDN("[FEN \"B:W14,23:B9\"]\n1. 9x18 *")
+	require.EqualError(t, err, "move 9x18: capture is incomplete at {3 4}")
+}
+
+func TestParsePdnCaptureWithMoveSeparator(t *testing.T) {
+	_, err := rules.ParsePDN("[FEN \"B:W14,23:B9\"]\n1. 9-18 *")
+	require.EqualError(t, err, "move 9-18: capture must be written with x: {1 2} to {3 4}")
+	game, err := rules.ParseFEN("B:W14,23,30,31:B9")
+	require.Nil(t, err)
+	_, err = game.PlayNotation("9-18")
+	require.EqualError(t, err, "capture must be written with x: {1 2} to {3 4}")
+}
+
+func TestParsePdnResultMismatch(t *testing.T) {
+	_, err := rules.ParsePDN("[Result \"1-0\"]\n1. 11-15 0-1")
+	require.EqualError(t, err, "result tag 1-0 does not match game result 0-1")
+}
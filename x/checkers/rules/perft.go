@@ -0,0 +1,88 @@
+package rules
+
+// PerftEntry is the node count below one root move, as listed by the divide
+// mode of reference engines.
+type PerftEntry struct {
+	Move  string
+	Nodes uint64
+}
+
+type fullMove struct {
+	path []Pos
+	next *Game
+	// over is set when the opponent has no move left, so Move kept the turn.
+	over bool
+}
+
+func (move fullMove) perft(depth uint) uint64 {
+	if move.over && depth > 0 {
+		return 0
+	}
+	return Perft(move.next, depth)
+}
+
+// Perft counts the positions reached after depth full moves. A multi-jump
+// counts as one move, and a side without a move ends the line, so the counts
+// can be compared with published English checkers figures.
+func Perft(game *Game, depth uint) uint64 {
+	if depth == 0 {
+		return 1
+	}
+	var nodes uint64
+	for _, move := range game.fullMoves() {
+		nodes += move.perft(depth - 1)
+	}
+	return nodes
+}
+
+// PerftDivide gives the Perft count below each move of the player to move, in
+// square order.
+func PerftDivide(game *Game, depth uint) (entries []PerftEntry, err error) {
+	if depth == 0 {
+		return nil, nil
+	}
+	for _, move := range game.fullMoves() {
+		notation, err := FormatPath(move.path)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, PerftEntry{
+			Move:  notation,
+			Nodes: move.perft(depth - 1),
+		})
+	}
+	return entries, nil
+}
+
+// fullMoves lists the moves of the player to move. Each step is played with
+// Move on a copy of the game, and the line is followed for as long as Move
+// leaves the turn with the same player. When the turn stays because the
+// opponent has no move left, the move ends the game.
+func (game *Game) fullMoves() (moves []fullMove) {
+	for _, step := range game.LegalSteps() {
+		moves = game.extendMove(moves, []Pos{step.Src}, step)
+	}
+	return moves
+}
+
+func (game *Game) extendMove(moves []fullMove, path []Pos, step Step) []fullMove {
+	next := game.Copy()
+	if _, err := next.Move(step.Src, step.Dst); err != nil {
+		panic(err.Error())
+	}
+	path = append(append([]Pos{}, path...), step.Dst)
+	turnKept := next.Turn == game.Turn
+	if turnKept && step.IsJump() {
+		continued := false
+		for _, jump := range next.LegalSteps() {
+			if jump.Src == step.Dst && jump.IsJump() {
+				moves = next.extendMove(moves, path, jump)
+				continued = true
+			}
+		}
+		if continued {
+			return moves
+		}
+	}
+	return append(moves, fullMove{path: path, next: next, over: turnKept})
+}
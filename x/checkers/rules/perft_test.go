@@ -0,0 +1,50 @@
+package rules_test
+
+import (
+	"testing"
+
+	"github.com/satya/checkers/x/checkers/rules"
+	"github.com/stretchr/testify/require"
+)
+
+// Published perft figures for English checkers from the initial position.
+var initialPerft = []uint64{1, 7, 49, 302, 1469, 7361, 36768}
+
+func TestPerftInitial(t *testing.T) {
+	for depth, expected := range initialPerft {
+		require.Equal(t, expected, rules.Perft(rules.New(), uint(depth)), "depth %d", depth)
+	}
+}
+
+func TestPerftDivideInitial(t *testing.T) {
+	entries, err := rules.PerftDivide(rules.New(), 3)
+	require.Nil(t, err)
+	require.Len(t, entries, 7)
+	require.Equal(t, "9-13", entries[0].Move)
+	require.Equal(t, "12-16", entries[6].Move)
+	var total uint64
+	for _, entry := range entries {
+		total += entry.Nodes
+	}
+	require.Equal(t, initialPerft[3], total)
+}
+
+func TestPerftMultiJumpIsOneMove(t *testing.T) {
+	game, err := rules.ParseFEN("B:W14,23:B9")
+	require.Nil(t, err)
+	entries, err := rules.PerftDivide(game, 1)
+	require.Nil(t, err)
+	require.Equal(t, []rules.PerftEntry{{Move: "9x18x27", Nodes: 1}}, entries)
+	require.EqualValues(t, 0, rules.Perft(game, 2))
+}
+
+func TestPerftJumpBranches(t *testing.T) {
+	game, err := rules.ParseFEN("B:W14,15,22,23:B10")
+	require.Nil(t, err)
+	entries, err := rules.PerftDivide(game, 1)
+	require.Nil(t, err)
+	require.Equal(t, []rules.PerftEntry{
+		{Move: "10x17x26", Nodes: 1},
+		{Move: "10x19x26", Nodes: 1},
+	}, entries)
+}
@@ -0,0 +1,36 @@
+package rules
+
+import (
+	"errors"
+	"fmt"
+)
+
+const MAX_PIECES = 12
+
+// ValidateSetup checks that a position could start a game: pieces are on
+// playable squares, no man waits on its promotion row, each side has between
+// 1 and MAX_PIECES pieces, and the player to move has a move.
+func (game *Game) ValidateSetup() error {
+	counts := map[Player]int{}
+	for pos, piece := range game.Pieces {
+		if !Usable[pos] {
+			return errors.New(fmt.Sprintf("piece on unplayable square: %v", pos))
+		}
+		if !piece.King {
+			if (piece.Player == BLACK_PLAYER && pos.Y == BOARD_DIM-1) ||
+				(piece.Player == RED_PLAYER && pos.Y == 0) {
+				return errors.New(fmt.Sprintf("man on its promotion row: %v", pos))
+			}
+		}
+		counts[piece.Player]++
+	}
+	for _, player := range []Player{BLACK_PLAYER, RED_PLAYER} {
+		if counts[player] < 1 || MAX_PIECES < counts[player] {
+			return errors.New(fmt.Sprintf("%s has %d pieces, expected 1 to %d", player.Color, counts[player], MAX_PIECES))
+		}
+	}
+	if len(game.LegalSteps()) == 0 {
+		return errors.New(fmt.Sprintf("%s to move has no move", game.Turn.Color))
+	}
+	return nil
+}
@@ -0,0 +1,36 @@
+package rules_test
+
+import (
+	"testing"
+
+	"github.com/satya/checkers/x/checkers/rules"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateSetupInitial(t *testing.T) {
+	require.Nil(t, rules.New().ValidateSetup())
+}
+
+func TestValidateSetupInvalid(t *testing.T) {
+	for board, expected := range map[string]string{
+		"b*******|********|********|********|********|********|********|r*******": "piece on unplayable square: {0 0}",
+		"********|********|********|********|********|********|********|b*r*****": "man on its promotion row: {0 7}",
+		"r*******|********|********|********|********|********|********|*B******": "piece on unplayable square: {0 0}",
+		"*r******|********|********|********|********|********|********|B*******": "man on its promotion row: {1 0}",
+		"*b*b*b*b|b*b*b*b*|*b*b*b*b|b*******|********|r*r*r*r*|*r*r*r*r|r*r*r*r*": "black has 13 pieces, expected 1 to 12",
+		"*b******|********|********|********|********|********|********|********": "red has 0 pieces, expected 1 to 12",
+	} {
+		game, err := rules.Parse(board)
+		require.Nil(t, err)
+		require.EqualError(t, game.ValidateSetup(), expected, board)
+	}
+}
+
+func TestValidateSetupNoMove(t *testing.T) {
+	game, err := rules.ParseFEN("B:W14,18:B10")
+	require.Nil(t, err)
+	require.Nil(t, game.ValidateSetup())
+	game, err = rules.ParseFEN("B:W9,14:B5")
+	require.Nil(t, err)
+	require.EqualError(t, game.ValidateSetup(), "black to move has no move")
+}
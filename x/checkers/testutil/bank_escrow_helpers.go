@@ -4,6 +4,7 @@ import (
 	"context"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/golang/mock/gomock"
 	"github.com/satya/checkers/x/checkers/types"
 )
@@ -27,11 +28,15 @@ func (escrow *MockBankEscrowKeeper) ExpectPay(context context.Context, who strin
 }
 
 func (escrow *MockBankEscrowKeeper) ExpectPayWithDenom(context context.Context, who string, amount uint64, denom string) *gomock.Call {
+	return escrow.ExpectPayCoins(context, who, coinsOf(amount, denom))
+}
+
+func (escrow *MockBankEscrowKeeper) ExpectPayCoins(context context.Context, who string, coins sdk.Coins) *gomock.Call {
 	whoAddr, err := sdk.AccAddressFromBech32(who)
 	if err != nil {
 		panic(err)
 	}
-	return escrow.EXPECT().SendCoinsFromAccountToModule(sdk.UnwrapSDKContext(context), whoAddr, types.ModuleName, coinsOf(amount, denom))
+	return escrow.EXPECT().SendCoinsFromAccountToModule(sdk.UnwrapSDKContext(context), whoAddr, types.ModuleName, coins)
 }
 
 func (escrow *MockBankEscrowKeeper) ExpectRefund(context context.Context, who string, amount uint64) *gomock.Call {
@@ -39,9 +44,17 @@ func (escrow *MockBankEscrowKeeper) ExpectRefund(context context.Context, who st
 }
 
 func (escrow *MockBankEscrowKeeper) ExpectRefundWithDenom(context context.Context, who string, amount uint64, denom string) *gomock.Call {
+	return escrow.ExpectRefundCoins(context, who, coinsOf(amount, denom))
+}
+
+func (escrow *MockBankEscrowKeeper) ExpectRefundCoins(context context.Context, who string, coins sdk.Coins) *gomock.Call {
 	whoAddr, err := sdk.AccAddressFromBech32(who)
 	if err != nil {
 		panic(err)
 	}
-	return escrow.EXPECT().SendCoinsFromModuleToAccount(sdk.UnwrapSDKContext(context), types.ModuleName, whoAddr, coinsOf(amount, denom))
+	return escrow.EXPECT().SendCoinsFromModuleToAccount(sdk.UnwrapSDKContext(context), types.ModuleName, whoAddr, coins)
+}
+
+func (escrow *MockBankEscrowKeeper) ExpectPayFee(context context.Context, amount uint64) *gomock.Call {
+	return escrow.EXPECT().SendCoinsFromModuleToModule(sdk.UnwrapSDKContext(context), types.ModuleName, authtypes.FeeCollectorName, coinsOf(amount, sdk.DefaultBondDenom))
 }
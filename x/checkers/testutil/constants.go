@@ -4,4 +4,5 @@ const (
 	Alice = "cosmos1n67mm98uyz2qnzu7hrlgk68w7uvdyw62kzgtgc"
 	Bob   = "cosmos10rsrdjqap9ynuhy5y05mzxffkh52gaqnsz7yx0"
 	Carol = "cosmos1e0w5t53nrq7p66fye6c8p0ynyhf6y24l4yuxd7"
+	Dave  = "cosmos1v84qsqlcs56j8dmh6s22eccnpn2d87fdtd7p4g"
 )
@@ -0,0 +1,13 @@
+package testutil
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+	"github.com/satya/checkers/x/checkers/types"
+)
+
+func (distribution *MockDistributionKeeper) ExpectFundCommunityPool(context context.Context, amount uint64) *gomock.Call {
+	return distribution.EXPECT().FundCommunityPool(sdk.UnwrapSDKContext(context), coinsOf(amount, sdk.DefaultBondDenom), types.GetHouseAddress())
+}
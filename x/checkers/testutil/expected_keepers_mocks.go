@@ -9,8 +9,10 @@ import (
 
 	types "github.com/cosmos/cosmos-sdk/types"
 	types0 "github.com/cosmos/cosmos-sdk/x/auth/types"
+	types1 "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
 	gomock "github.com/golang/mock/gomock"
-	types1 "github.com/satya/checkers/x/leaderboard/types"
+	types2 "github.com/satya/checkers/x/leaderboard/types"
+	bytes "github.com/tendermint/tendermint/libs/bytes"
 )
 
 // MockAccountKeeper is a mock of AccountKeeper interface.
@@ -110,6 +112,20 @@ func (m *MockBankEscrowKeeper) EXPECT() *MockBankEscrowKeeperMockRecorder {
 	return m.recorder
 }
 
+// GetAllBalances mocks base method.
+func (m *MockBankEscrowKeeper) GetAllBalances(ctx types.Context, addr types.AccAddress) types.Coins {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllBalances", ctx, addr)
+	ret0, _ := ret[0].(types.Coins)
+	return ret0
+}
+
+// GetAllBalances indicates an expected call of GetAllBalances.
+func (mr *MockBankEscrowKeeperMockRecorder) GetAllBalances(ctx, addr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllBalances", reflect.TypeOf((*MockBankEscrowKeeper)(nil).GetAllBalances), ctx, addr)
+}
+
 // SendCoinsFromAccountToModule mocks base method.
 func (m *MockBankEscrowKeeper) SendCoinsFromAccountToModule(ctx types.Context, senderAddr types.AccAddress, recipientModule string, amt types.Coins) error {
 	m.ctrl.T.Helper()
@@ -138,6 +154,95 @@ func (mr *MockBankEscrowKeeperMockRecorder) SendCoinsFromModuleToAccount(ctx, se
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendCoinsFromModuleToAccount", reflect.TypeOf((*MockBankEscrowKeeper)(nil).SendCoinsFromModuleToAccount), ctx, senderModule, recipientAddr, amt)
 }
 
+// SendCoinsFromModuleToModule mocks base method.
+func (m *MockBankEscrowKeeper) SendCoinsFromModuleToModule(ctx types.Context, senderModule, recipientModule string, amt types.Coins) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendCoinsFromModuleToModule", ctx, senderModule, recipientModule, amt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendCoinsFromModuleToModule indicates an expected call of SendCoinsFromModuleToModule.
+func (mr *MockBankEscrowKeeperMockRecorder) SendCoinsFromModuleToModule(ctx, senderModule, recipientModule, amt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendCoinsFromModuleToModule", reflect.TypeOf((*MockBankEscrowKeeper)(nil).SendCoinsFromModuleToModule), ctx, senderModule, recipientModule, amt)
+}
+
+// MockDenomTraceKeeper is a mock of DenomTraceKeeper interface.
+type MockDenomTraceKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockDenomTraceKeeperMockRecorder
+}
+
+// MockDenomTraceKeeperMockRecorder is the mock recorder for MockDenomTraceKeeper.
+type MockDenomTraceKeeperMockRecorder struct {
+	mock *MockDenomTraceKeeper
+}
+
+// NewMockDenomTraceKeeper creates a new mock instance.
+func NewMockDenomTraceKeeper(ctrl *gomock.Controller) *MockDenomTraceKeeper {
+	mock := &MockDenomTraceKeeper{ctrl: ctrl}
+	mock.recorder = &MockDenomTraceKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDenomTraceKeeper) EXPECT() *MockDenomTraceKeeperMockRecorder {
+	return m.recorder
+}
+
+// GetDenomTrace mocks base method.
+func (m *MockDenomTraceKeeper) GetDenomTrace(ctx types.Context, denomTraceHash bytes.HexBytes) (types1.DenomTrace, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDenomTrace", ctx, denomTraceHash)
+	ret0, _ := ret[0].(types1.DenomTrace)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// GetDenomTrace indicates an expected call of GetDenomTrace.
+func (mr *MockDenomTraceKeeperMockRecorder) GetDenomTrace(ctx, denomTraceHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDenomTrace", reflect.TypeOf((*MockDenomTraceKeeper)(nil).GetDenomTrace), ctx, denomTraceHash)
+}
+
+// MockDistributionKeeper is a mock of DistributionKeeper interface.
+type MockDistributionKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockDistributionKeeperMockRecorder
+}
+
+// MockDistributionKeeperMockRecorder is the mock recorder for MockDistributionKeeper.
+type MockDistributionKeeperMockRecorder struct {
+	mock *MockDistributionKeeper
+}
+
+// NewMockDistributionKeeper creates a new mock instance.
+func NewMockDistributionKeeper(ctrl *gomock.Controller) *MockDistributionKeeper {
+	mock := &MockDistributionKeeper{ctrl: ctrl}
+	mock.recorder = &MockDistributionKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDistributionKeeper) EXPECT() *MockDistributionKeeperMockRecorder {
+	return m.recorder
+}
+
+// FundCommunityPool mocks base method.
+func (m *MockDistributionKeeper) FundCommunityPool(ctx types.Context, amount types.Coins, sender types.AccAddress) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FundCommunityPool", ctx, amount, sender)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FundCommunityPool indicates an expected call of FundCommunityPool.
+func (mr *MockDistributionKeeperMockRecorder) FundCommunityPool(ctx, amount, sender interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FundCommunityPool", reflect.TypeOf((*MockDistributionKeeper)(nil).FundCommunityPool), ctx, amount, sender)
+}
+
 // MockCheckersLeaderboardKeeper is a mock of CheckersLeaderboardKeeper interface.
 type MockCheckersLeaderboardKeeper struct {
 	ctrl     *gomock.Controller
@@ -162,10 +267,10 @@ func (m *MockCheckersLeaderboardKeeper) EXPECT() *MockCheckersLeaderboardKeeperM
 }
 
 // MustAddForfeitedGameResultToPlayer mocks base method.
-func (m *MockCheckersLeaderboardKeeper) MustAddForfeitedGameResultToPlayer(ctx types.Context, player types.AccAddress) types1.PlayerInfo {
+func (m *MockCheckersLeaderboardKeeper) MustAddForfeitedGameResultToPlayer(ctx types.Context, player types.AccAddress) types2.PlayerInfo {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "MustAddForfeitedGameResultToPlayer", ctx, player)
-	ret0, _ := ret[0].(types1.PlayerInfo)
+	ret0, _ := ret[0].(types2.PlayerInfo)
 	return ret0
 }
 
@@ -176,10 +281,10 @@ func (mr *MockCheckersLeaderboardKeeperMockRecorder) MustAddForfeitedGameResultT
 }
 
 // MustAddLostGameResultToPlayer mocks base method.
-func (m *MockCheckersLeaderboardKeeper) MustAddLostGameResultToPlayer(ctx types.Context, player types.AccAddress) types1.PlayerInfo {
+func (m *MockCheckersLeaderboardKeeper) MustAddLostGameResultToPlayer(ctx types.Context, player types.AccAddress) types2.PlayerInfo {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "MustAddLostGameResultToPlayer", ctx, player)
-	ret0, _ := ret[0].(types1.PlayerInfo)
+	ret0, _ := ret[0].(types2.PlayerInfo)
 	return ret0
 }
 
@@ -190,10 +295,10 @@ func (mr *MockCheckersLeaderboardKeeperMockRecorder) MustAddLostGameResultToPlay
 }
 
 // MustAddWonGameResultToPlayer mocks base method.
-func (m *MockCheckersLeaderboardKeeper) MustAddWonGameResultToPlayer(ctx types.Context, player types.AccAddress) types1.PlayerInfo {
+func (m *MockCheckersLeaderboardKeeper) MustAddWonGameResultToPlayer(ctx types.Context, player types.AccAddress) types2.PlayerInfo {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "MustAddWonGameResultToPlayer", ctx, player)
-	ret0, _ := ret[0].(types1.PlayerInfo)
+	ret0, _ := ret[0].(types2.PlayerInfo)
 	return ret0
 }
 
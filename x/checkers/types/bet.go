@@ -0,0 +1,71 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/satya/checkers/x/checkers/rules"
+)
+
+func NewBet(gameIndex string, bettor string, side string, amount sdk.Coins) Bet {
+	return Bet{
+		GameIndex: gameIndex,
+		Bettor:    bettor,
+		Side:      side,
+		Amount:    amount,
+	}
+}
+
+// IsValidSide tells whether a bet can back this colour.
+func IsValidSide(side string) bool {
+	return side == rules.PieceStrings[rules.BLACK_PLAYER] ||
+		side == rules.PieceStrings[rules.RED_PLAYER]
+}
+
+// GetBetPools adds up the bets backing black and those backing red.
+func GetBetPools(bets []Bet) (blackPool sdk.Coins, redPool sdk.Coins) {
+	blackPool, redPool = sdk.NewCoins(), sdk.NewCoins()
+	for _, bet := range bets {
+		if bet.Side == rules.PieceStrings[rules.BLACK_PLAYER] {
+			blackPool = blackPool.Add(bet.Amount...)
+		} else {
+			redPool = redPool.Add(bet.Amount...)
+		}
+	}
+	return blackPool, redPool
+}
+
+// GetBetPayouts shares the pool of a game among the bets, in the same order.
+// When a side won, each denom of the pool goes to the bets on that side in
+// proportion to their stake in the denom, rounded down. The rounding leftover
+// is returned apart. A denom in which no one backed the winner is refunded to
+// those who bet it. Any other result refunds every bet.
+func GetBetPayouts(bets []Bet, winner string) (payouts []sdk.Coins, leftover sdk.Coins) {
+	payouts = make([]sdk.Coins, len(bets))
+	if !IsValidSide(winner) {
+		for i, bet := range bets {
+			payouts[i] = bet.Amount
+		}
+		return payouts, sdk.NewCoins()
+	}
+	blackPool, redPool := GetBetPools(bets)
+	pool := blackPool.Add(redPool...)
+	winningPool := blackPool
+	if winner == rules.PieceStrings[rules.RED_PLAYER] {
+		winningPool = redPool
+	}
+	paid := sdk.NewCoins()
+	for i, bet := range bets {
+		payout := sdk.NewCoins()
+		for _, coin := range bet.Amount {
+			winningAmount := winningPool.AmountOf(coin.Denom)
+			if winningAmount.IsZero() {
+				payout = payout.Add(coin)
+			} else if bet.Side == winner {
+				share := coin.Amount.Mul(pool.AmountOf(coin.Denom)).Quo(winningAmount)
+				payout = payout.Add(sdk.NewCoin(coin.Denom, share))
+			}
+		}
+		payouts[i] = payout
+		paid = paid.Add(payout...)
+	}
+	return payouts, pool.Sub(paid)
+}
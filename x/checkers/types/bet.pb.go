@@ -0,0 +1,491 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: checkers/bet.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Bet is the stake of a spectator on one side of a stored game. It is held in
+// the prediction pool of the game until the game ends.
+type Bet struct {
+	GameIndex string `protobuf:"bytes,1,opt,name=gameIndex,proto3" json:"gameIndex,omitempty"`
+	Bettor    string `protobuf:"bytes,2,opt,name=bettor,proto3" json:"bettor,omitempty"`
+	// The colour backed, "b" or "r".
+	Side   string                                   `protobuf:"bytes,3,opt,name=side,proto3" json:"side,omitempty"`
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *Bet) Reset()         { *m = Bet{} }
+func (m *Bet) String() string { return proto.CompactTextString(m) }
+func (*Bet) ProtoMessage()    {}
+func (*Bet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8895137a4209f4a, []int{0}
+}
+func (m *Bet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Bet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Bet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Bet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Bet.Merge(m, src)
+}
+func (m *Bet) XXX_Size() int {
+	return m.Size()
+}
+func (m *Bet) XXX_DiscardUnknown() {
+	xxx_messageInfo_Bet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Bet proto.InternalMessageInfo
+
+func (m *Bet) GetGameIndex() string {
+	if m != nil {
+		return m.GameIndex
+	}
+	return ""
+}
+
+func (m *Bet) GetBettor() string {
+	if m != nil {
+		return m.Bettor
+	}
+	return ""
+}
+
+func (m *Bet) GetSide() string {
+	if m != nil {
+		return m.Side
+	}
+	return ""
+}
+
+func (m *Bet) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Bet)(nil), "satya.checkers.checkers.Bet")
+}
+
+func init() { proto.RegisterFile("checkers/bet.proto", fileDescriptor_b8895137a4209f4a) }
+
+var fileDescriptor_b8895137a4209f4a = []byte{
+	// 271 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x90, 0x31, 0x4e, 0xc3, 0x30,
+	0x14, 0x86, 0x63, 0x52, 0x45, 0xaa, 0xd9, 0x2c, 0x04, 0xa1, 0x42, 0x6e, 0xc5, 0x14, 0x21, 0x61,
+	0x53, 0xb8, 0x41, 0x60, 0x61, 0xed, 0xc8, 0x66, 0x3b, 0x4f, 0x69, 0x54, 0x25, 0xaf, 0x8a, 0x5d,
+	0xd4, 0xde, 0x82, 0x73, 0x30, 0x73, 0x88, 0x8e, 0x1d, 0x99, 0x00, 0x25, 0x17, 0x41, 0x75, 0x42,
+	0xcb, 0xe4, 0xdf, 0xef, 0x7f, 0xef, 0xd7, 0xa7, 0x9f, 0x32, 0x33, 0x07, 0xb3, 0x80, 0xda, 0x4a,
+	0x0d, 0x4e, 0x2c, 0x6b, 0x74, 0xc8, 0x2e, 0xac, 0x72, 0x1b, 0x25, 0xfe, 0x9c, 0x83, 0x18, 0x9d,
+	0xe5, 0x98, 0xa3, 0xdf, 0x91, 0x7b, 0xd5, 0xad, 0x8f, 0xb8, 0x41, 0x5b, 0xa2, 0x95, 0x5a, 0x59,
+	0x90, 0xaf, 0x53, 0x0d, 0x4e, 0x4d, 0xa5, 0xc1, 0xa2, 0xea, 0xfc, 0xeb, 0x0f, 0x42, 0xc3, 0x14,
+	0x1c, 0xbb, 0xa2, 0xc3, 0x5c, 0x95, 0xf0, 0x5c, 0x65, 0xb0, 0x8e, 0xc9, 0x84, 0x24, 0xc3, 0xd9,
+	0x71, 0xc0, 0xce, 0x69, 0xa4, 0xc1, 0x39, 0xac, 0xe3, 0x13, 0x6f, 0xf5, 0x3f, 0xc6, 0xe8, 0xc0,
+	0x16, 0x19, 0xc4, 0xa1, 0x9f, 0x7a, 0xcd, 0x0c, 0x8d, 0x54, 0x89, 0xab, 0xca, 0xc5, 0x83, 0x49,
+	0x98, 0x9c, 0xde, 0x5f, 0x8a, 0x0e, 0x41, 0xec, 0x11, 0x44, 0x8f, 0x20, 0x1e, 0xb1, 0xa8, 0xd2,
+	0xbb, 0xed, 0xd7, 0x38, 0x78, 0xff, 0x1e, 0x27, 0x79, 0xe1, 0xe6, 0x2b, 0x2d, 0x0c, 0x96, 0xb2,
+	0xe7, 0xed, 0x9e, 0x5b, 0x9b, 0x2d, 0xa4, 0xdb, 0x2c, 0xc1, 0xfa, 0x03, 0x3b, 0xeb, 0xa3, 0xd3,
+	0xa7, 0x6d, 0xc3, 0xc9, 0xae, 0xe1, 0xe4, 0xa7, 0xe1, 0xe4, 0xad, 0xe5, 0xc1, 0xae, 0xe5, 0xc1,
+	0x67, 0xcb, 0x83, 0x97, 0x9b, 0x7f, 0x59, 0xbe, 0x2a, 0x79, 0x28, 0x71, 0x7d, 0x94, 0x3e, 0x53,
+	0x47, 0xbe, 0x83, 0x87, 0xdf, 0x01, 0x00, 0xc1, 0x18, 0xeb, 0x77, 0x68, 0x01, 0x00, 0x00,
+}
+
+func (m *Bet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Bet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Bet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBet(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Side) > 0 {
+		i -= len(m.Side)
+		copy(dAtA[i:], m.Side)
+		i = encodeVarintBet(dAtA, i, uint64(len(m.Side)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Bettor) > 0 {
+		i -= len(m.Bettor)
+		copy(dAtA[i:], m.Bettor)
+		i = encodeVarintBet(dAtA, i, uint64(len(m.Bettor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.GameIndex) > 0 {
+		i -= len(m.GameIndex)
+		copy(dAtA[i:], m.GameIndex)
+		i = encodeVarintBet(dAtA, i, uint64(len(m.GameIndex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintBet(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBet(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Bet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GameIndex)
+	if l > 0 {
+		n += 1 + l + sovBet(uint64(l))
+	}
+	l = len(m.Bettor)
+	if l > 0 {
+		n += 1 + l + sovBet(uint64(l))
+	}
+	l = len(m.Side)
+	if l > 0 {
+		n += 1 + l + sovBet(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovBet(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovBet(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozBet(x uint64) (n int) {
+	return sovBet(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Bet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBet
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Bet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Bet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GameIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBet
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBet
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GameIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bettor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBet
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBet
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bettor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Side", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBet
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBet
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Side = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBet
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBet
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBet(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBet
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipBet(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowBet
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBet
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBet
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthBet
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupBet
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthBet
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthBet        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowBet          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupBet = fmt.Errorf("proto: unexpected end of group")
+)
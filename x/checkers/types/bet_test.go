@@ -0,0 +1,57 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/satya/checkers/x/checkers/testutil"
+	"github.com/satya/checkers/x/checkers/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetBetPayoutsWinnersShareThePool(t *testing.T) {
+	bets := []types.Bet{
+		types.NewBet("1", testutil.Alice, "b", sdk.NewCoins(sdk.NewInt64Coin("stake", 100))),
+		types.NewBet("1", testutil.Bob, "b", sdk.NewCoins(sdk.NewInt64Coin("stake", 50))),
+		types.NewBet("1", testutil.Carol, "r", sdk.NewCoins(sdk.NewInt64Coin("stake", 101))),
+	}
+	payouts, leftover := types.GetBetPayouts(bets, "b")
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 167)), payouts[0])
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 83)), payouts[1])
+	require.True(t, payouts[2].IsZero())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 1)), leftover)
+}
+
+func TestGetBetPayoutsDenomWithoutWinnerRefunded(t *testing.T) {
+	bets := []types.Bet{
+		types.NewBet("1", testutil.Alice, "r", sdk.NewCoins(sdk.NewInt64Coin("stake", 100))),
+		types.NewBet("1", testutil.Bob, "b", sdk.NewCoins(sdk.NewInt64Coin("stake", 60), sdk.NewInt64Coin("token", 30))),
+	}
+	payouts, leftover := types.GetBetPayouts(bets, "r")
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 160)), payouts[0])
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("token", 30)), payouts[1])
+	require.True(t, leftover.IsZero())
+}
+
+func TestGetBetPayoutsNoWinnerRefunds(t *testing.T) {
+	bets := []types.Bet{
+		types.NewBet("1", testutil.Alice, "b", sdk.NewCoins(sdk.NewInt64Coin("stake", 100))),
+		types.NewBet("1", testutil.Bob, "r", sdk.NewCoins(sdk.NewInt64Coin("token", 30))),
+	}
+	for _, winner := range []string{types.DrawWinner, "*"} {
+		payouts, leftover := types.GetBetPayouts(bets, winner)
+		require.Equal(t, bets[0].Amount, payouts[0])
+		require.Equal(t, bets[1].Amount, payouts[1])
+		require.True(t, leftover.IsZero())
+	}
+}
+
+func TestGetBetPools(t *testing.T) {
+	blackPool, redPool := types.GetBetPools([]types.Bet{
+		types.NewBet("1", testutil.Alice, "b", sdk.NewCoins(sdk.NewInt64Coin("stake", 100))),
+		types.NewBet("1", testutil.Bob, "r", sdk.NewCoins(sdk.NewInt64Coin("token", 30))),
+		types.NewBet("1", testutil.Carol, "b", sdk.NewCoins(sdk.NewInt64Coin("stake", 5))),
+	})
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 105)), blackPool)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("token", 30)), redPool)
+}
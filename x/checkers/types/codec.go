@@ -10,6 +10,25 @@ import (
 func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgCreateGame{}, "checkers/CreateGame", nil)
 	cdc.RegisterConcrete(&MsgPlayMove{}, "checkers/PlayMove", nil)
+	cdc.RegisterConcrete(&MsgFundHouseBankroll{}, "checkers/FundHouseBankroll", nil)
+	cdc.RegisterConcrete(&MsgCommitColour{}, "checkers/CommitColour", nil)
+	cdc.RegisterConcrete(&MsgRevealColour{}, "checkers/RevealColour", nil)
+	cdc.RegisterConcrete(&MsgSetPremove{}, "checkers/SetPremove", nil)
+	cdc.RegisterConcrete(&MsgRequestTakeback{}, "checkers/RequestTakeback", nil)
+	cdc.RegisterConcrete(&MsgAcceptTakeback{}, "checkers/AcceptTakeback", nil)
+	cdc.RegisterConcrete(&MsgStartVacation{}, "checkers/StartVacation", nil)
+	cdc.RegisterConcrete(&MsgEndVacation{}, "checkers/EndVacation", nil)
+	cdc.RegisterConcrete(&MsgProposeAdjourn{}, "checkers/ProposeAdjourn", nil)
+	cdc.RegisterConcrete(&MsgAcceptAdjourn{}, "checkers/AcceptAdjourn", nil)
+	cdc.RegisterConcrete(&MsgResumeGame{}, "checkers/ResumeGame", nil)
+	cdc.RegisterConcrete(&MsgAcceptGame{}, "checkers/AcceptGame", nil)
+	cdc.RegisterConcrete(&MsgPlaceBet{}, "checkers/PlaceBet", nil)
+	cdc.RegisterConcrete(&MsgDeposit{}, "checkers/Deposit", nil)
+	cdc.RegisterConcrete(&MsgWithdraw{}, "checkers/Withdraw", nil)
+	cdc.RegisterConcrete(&MsgCreateMatch{}, "checkers/CreateMatch", nil)
+	cdc.RegisterConcrete(&MsgAcceptMatch{}, "checkers/AcceptMatch", nil)
+	cdc.RegisterConcrete(&MsgOfferRematch{}, "checkers/OfferRematch", nil)
+	cdc.RegisterConcrete(&MsgAcceptRematch{}, "checkers/AcceptRematch", nil)
 	// this line is used by starport scaffolding # 2
 }
 
@@ -20,6 +39,53 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgPlayMove{},
 	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgFundHouseBankroll{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgCommitColour{},
+		&MsgRevealColour{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSetPremove{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgRequestTakeback{},
+		&MsgAcceptTakeback{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgStartVacation{},
+		&MsgEndVacation{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgProposeAdjourn{},
+		&MsgAcceptAdjourn{},
+		&MsgResumeGame{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgAcceptGame{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgPlaceBet{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgDeposit{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgWithdraw{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgCreateMatch{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgAcceptMatch{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgOfferRematch{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgAcceptRematch{},
+	)
 	// this line is used by starport scaffolding # 3
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -0,0 +1,102 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const CommitmentLength = sha256.Size
+
+// ColourCommitment is what a player commits to before revealing secret. The
+// address is hashed in so that a player cannot copy the other's commitment.
+func ColourCommitment(player string, secret string) string {
+	hash := sha256.Sum256([]byte(player + secret))
+	return hex.EncodeToString(hash[:])
+}
+
+func NewColourDraw(index string, first string, second string) ColourDraw {
+	return ColourDraw{
+		Index:  index,
+		First:  first,
+		Second: second,
+	}
+}
+
+func (draw ColourDraw) IsCommitted() bool {
+	return draw.FirstCommit != "" && draw.SecondCommit != ""
+}
+
+func (draw ColourDraw) IsRevealed() bool {
+	return draw.FirstSecret != "" && draw.SecondSecret != ""
+}
+
+// Commit records the commitment of player, in the commit phase only.
+func (draw *ColourDraw) Commit(player string, commitment string) error {
+	var commit *string
+	switch player {
+	case draw.First:
+		commit = &draw.FirstCommit
+	case draw.Second:
+		commit = &draw.SecondCommit
+	default:
+		return sdkerrors.Wrapf(ErrCreatorNotPlayer, "%s", player)
+	}
+	if *commit != "" {
+		return ErrAlreadyCommitted
+	}
+	*commit = commitment
+	return nil
+}
+
+// Reveal records the secret of player once both have committed. The secret
+// must match the commitment.
+func (draw *ColourDraw) Reveal(player string, secret string) error {
+	var commit string
+	var revealed *string
+	switch player {
+	case draw.First:
+		commit, revealed = draw.FirstCommit, &draw.FirstSecret
+	case draw.Second:
+		commit, revealed = draw.SecondCommit, &draw.SecondSecret
+	default:
+		return sdkerrors.Wrapf(ErrCreatorNotPlayer, "%s", player)
+	}
+	if !draw.IsCommitted() {
+		return ErrNotAllCommitted
+	}
+	if *revealed != "" {
+		return ErrAlreadyRevealed
+	}
+	if secret == "" || ColourCommitment(player, secret) != commit {
+		return ErrSecretMismatch
+	}
+	*revealed = secret
+	return nil
+}
+
+// FirstIsBlack combines both secrets into the draw. As long as one of the
+// secrets is random, neither player can choose the outcome.
+func (draw ColourDraw) FirstIsBlack() bool {
+	first := sha256.Sum256([]byte(draw.FirstSecret))
+	second := sha256.Sum256([]byte(draw.SecondSecret))
+	return (first[0]^second[0])&1 == 0
+}
+
+// GetDefaulter returns the player who failed to play their part in the
+// current phase while the other did. It is not found when both or neither
+// did.
+func (draw ColourDraw) GetDefaulter() (defaulter string, found bool) {
+	firstDone, secondDone := draw.FirstCommit != "", draw.SecondCommit != ""
+	if draw.IsCommitted() {
+		firstDone, secondDone = draw.FirstSecret != "", draw.SecondSecret != ""
+	}
+	if firstDone == secondDone {
+		return "", false
+	}
+	if firstDone {
+		return draw.Second, true
+	}
+	return draw.First, true
+}
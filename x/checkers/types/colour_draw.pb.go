@@ -0,0 +1,629 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: checkers/colour_draw.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ColourDraw is a pending commit-reveal draw of colours for the stored game of
+// the same index. The players are given in the order of the black and red
+// fields of MsgCreateGame.
+type ColourDraw struct {
+	Index  string `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+	First  string `protobuf:"bytes,2,opt,name=first,proto3" json:"first,omitempty"`
+	Second string `protobuf:"bytes,3,opt,name=second,proto3" json:"second,omitempty"`
+	// Hex SHA-256 of the player address followed by the secret.
+	FirstCommit  string `protobuf:"bytes,4,opt,name=firstCommit,proto3" json:"firstCommit,omitempty"`
+	SecondCommit string `protobuf:"bytes,5,opt,name=secondCommit,proto3" json:"secondCommit,omitempty"`
+	FirstSecret  string `protobuf:"bytes,6,opt,name=firstSecret,proto3" json:"firstSecret,omitempty"`
+	SecondSecret string `protobuf:"bytes,7,opt,name=secondSecret,proto3" json:"secondSecret,omitempty"`
+}
+
+func (m *ColourDraw) Reset()         { *m = ColourDraw{} }
+func (m *ColourDraw) String() string { return proto.CompactTextString(m) }
+func (*ColourDraw) ProtoMessage()    {}
+func (*ColourDraw) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb8918fa566523b0, []int{0}
+}
+func (m *ColourDraw) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ColourDraw) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ColourDraw.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ColourDraw) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ColourDraw.Merge(m, src)
+}
+func (m *ColourDraw) XXX_Size() int {
+	return m.Size()
+}
+func (m *ColourDraw) XXX_DiscardUnknown() {
+	xxx_messageInfo_ColourDraw.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ColourDraw proto.InternalMessageInfo
+
+func (m *ColourDraw) GetIndex() string {
+	if m != nil {
+		return m.Index
+	}
+	return ""
+}
+
+func (m *ColourDraw) GetFirst() string {
+	if m != nil {
+		return m.First
+	}
+	return ""
+}
+
+func (m *ColourDraw) GetSecond() string {
+	if m != nil {
+		return m.Second
+	}
+	return ""
+}
+
+func (m *ColourDraw) GetFirstCommit() string {
+	if m != nil {
+		return m.FirstCommit
+	}
+	return ""
+}
+
+func (m *ColourDraw) GetSecondCommit() string {
+	if m != nil {
+		return m.SecondCommit
+	}
+	return ""
+}
+
+func (m *ColourDraw) GetFirstSecret() string {
+	if m != nil {
+		return m.FirstSecret
+	}
+	return ""
+}
+
+func (m *ColourDraw) GetSecondSecret() string {
+	if m != nil {
+		return m.SecondSecret
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*ColourDraw)(nil), "satya.checkers.checkers.ColourDraw")
+}
+
+func init() { proto.RegisterFile("checkers/colour_draw.proto", fileDescriptor_eb8918fa566523b0) }
+
+var fileDescriptor_eb8918fa566523b0 = []byte{
+	// 232 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4a, 0xce, 0x48, 0x4d,
+	0xce, 0x4e, 0x2d, 0x2a, 0xd6, 0x4f, 0xce, 0xcf, 0xc9, 0x2f, 0x2d, 0x8a, 0x4f, 0x29, 0x4a, 0x2c,
+	0xd7, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x2f, 0x4e, 0x2c, 0xa9, 0x4c, 0xd4, 0x83, 0xa9,
+	0x80, 0x33, 0x94, 0xee, 0x30, 0x72, 0x71, 0x39, 0x83, 0x95, 0xbb, 0x14, 0x25, 0x96, 0x0b, 0x89,
+	0x70, 0xb1, 0x66, 0xe6, 0xa5, 0xa4, 0x56, 0x48, 0x30, 0x2a, 0x30, 0x6a, 0x70, 0x06, 0x41, 0x38,
+	0x20, 0xd1, 0xb4, 0xcc, 0xa2, 0xe2, 0x12, 0x09, 0x26, 0x88, 0x28, 0x98, 0x23, 0x24, 0xc6, 0xc5,
+	0x56, 0x9c, 0x9a, 0x9c, 0x9f, 0x97, 0x22, 0xc1, 0x0c, 0x16, 0x86, 0xf2, 0x84, 0x14, 0xb8, 0xb8,
+	0xc1, 0x0a, 0x9c, 0xf3, 0x73, 0x73, 0x33, 0x4b, 0x24, 0x58, 0xc0, 0x92, 0xc8, 0x42, 0x42, 0x4a,
+	0x5c, 0x3c, 0x10, 0xb5, 0x50, 0x25, 0xac, 0x60, 0x25, 0x28, 0x62, 0x70, 0x53, 0x82, 0x53, 0x93,
+	0x8b, 0x52, 0x4b, 0x24, 0xd8, 0x90, 0x4c, 0x81, 0x08, 0x21, 0x4c, 0x81, 0x2a, 0x61, 0x47, 0x36,
+	0x05, 0x22, 0xe6, 0xe4, 0x72, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9,
+	0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x5a,
+	0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0xe0, 0xc0, 0xd1, 0x87, 0x07,
+	0x5f, 0x05, 0x82, 0x59, 0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0x0e, 0x44, 0x63, 0xc0, 0x00,
+	0xe3, 0x5e, 0x13, 0xcc, 0x62, 0x01, 0x00, 0x00,
+}
+
+func (m *ColourDraw) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ColourDraw) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ColourDraw) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SecondSecret) > 0 {
+		i -= len(m.SecondSecret)
+		copy(dAtA[i:], m.SecondSecret)
+		i = encodeVarintColourDraw(dAtA, i, uint64(len(m.SecondSecret)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.FirstSecret) > 0 {
+		i -= len(m.FirstSecret)
+		copy(dAtA[i:], m.FirstSecret)
+		i = encodeVarintColourDraw(dAtA, i, uint64(len(m.FirstSecret)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.SecondCommit) > 0 {
+		i -= len(m.SecondCommit)
+		copy(dAtA[i:], m.SecondCommit)
+		i = encodeVarintColourDraw(dAtA, i, uint64(len(m.SecondCommit)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.FirstCommit) > 0 {
+		i -= len(m.FirstCommit)
+		copy(dAtA[i:], m.FirstCommit)
+		i = encodeVarintColourDraw(dAtA, i, uint64(len(m.FirstCommit)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Second) > 0 {
+		i -= len(m.Second)
+		copy(dAtA[i:], m.Second)
+		i = encodeVarintColourDraw(dAtA, i, uint64(len(m.Second)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.First) > 0 {
+		i -= len(m.First)
+		copy(dAtA[i:], m.First)
+		i = encodeVarintColourDraw(dAtA, i, uint64(len(m.First)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintColourDraw(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintColourDraw(dAtA []byte, offset int, v uint64) int {
+	offset -= sovColourDraw(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ColourDraw) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovColourDraw(uint64(l))
+	}
+	l = len(m.First)
+	if l > 0 {
+		n += 1 + l + sovColourDraw(uint64(l))
+	}
+	l = len(m.Second)
+	if l > 0 {
+		n += 1 + l + sovColourDraw(uint64(l))
+	}
+	l = len(m.FirstCommit)
+	if l > 0 {
+		n += 1 + l + sovColourDraw(uint64(l))
+	}
+	l = len(m.SecondCommit)
+	if l > 0 {
+		n += 1 + l + sovColourDraw(uint64(l))
+	}
+	l = len(m.FirstSecret)
+	if l > 0 {
+		n += 1 + l + sovColourDraw(uint64(l))
+	}
+	l = len(m.SecondSecret)
+	if l > 0 {
+		n += 1 + l + sovColourDraw(uint64(l))
+	}
+	return n
+}
+
+func sovColourDraw(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozColourDraw(x uint64) (n int) {
+	return sovColourDraw(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ColourDraw) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowColourDraw
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ColourDraw: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ColourDraw: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowColourDraw
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthColourDraw
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthColourDraw
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field First", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowColourDraw
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthColourDraw
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthColourDraw
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.First = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Second", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowColourDraw
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthColourDraw
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthColourDraw
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Second = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstCommit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowColourDraw
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthColourDraw
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthColourDraw
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FirstCommit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondCommit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowColourDraw
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthColourDraw
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthColourDraw
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SecondCommit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstSecret", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowColourDraw
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthColourDraw
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthColourDraw
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FirstSecret = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondSecret", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowColourDraw
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthColourDraw
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthColourDraw
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SecondSecret = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipColourDraw(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthColourDraw
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipColourDraw(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowColourDraw
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowColourDraw
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowColourDraw
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthColourDraw
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupColourDraw
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthColourDraw
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthColourDraw        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowColourDraw          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupColourDraw = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,64 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/satya/checkers/x/checkers/testutil"
+	"github.com/satya/checkers/x/checkers/types"
+	"github.com/stretchr/testify/require"
+)
+
+func committedDraw(t *testing.T, firstSecret string, secondSecret string) types.ColourDraw {
+	draw := types.NewColourDraw("1", testutil.Bob, testutil.Carol)
+	require.Nil(t, draw.Commit(testutil.Bob, types.ColourCommitment(testutil.Bob, firstSecret)))
+	require.Nil(t, draw.Commit(testutil.Carol, types.ColourCommitment(testutil.Carol, secondSecret)))
+	return draw
+}
+
+func TestColourDrawBothOutcomes(t *testing.T) {
+	draw := committedDraw(t, "alpha", "epsilon")
+	require.Nil(t, draw.Reveal(testutil.Bob, "alpha"))
+	require.Nil(t, draw.Reveal(testutil.Carol, "epsilon"))
+	require.True(t, draw.IsRevealed())
+	require.True(t, draw.FirstIsBlack())
+
+	draw = committedDraw(t, "alpha", "delta")
+	require.Nil(t, draw.Reveal(testutil.Carol, "delta"))
+	require.Nil(t, draw.Reveal(testutil.Bob, "alpha"))
+	require.False(t, draw.FirstIsBlack())
+}
+
+func TestColourDrawCommitErrors(t *testing.T) {
+	draw := types.NewColourDraw("1", testutil.Bob, testutil.Carol)
+	require.ErrorIs(t, draw.Commit(testutil.Alice, "00"), types.ErrCreatorNotPlayer)
+	require.Nil(t, draw.Commit(testutil.Bob, types.ColourCommitment(testutil.Bob, "alpha")))
+	require.ErrorIs(t, draw.Commit(testutil.Bob, types.ColourCommitment(testutil.Bob, "beta")), types.ErrAlreadyCommitted)
+	require.ErrorIs(t, draw.Reveal(testutil.Bob, "alpha"), types.ErrNotAllCommitted)
+}
+
+func TestColourDrawRevealErrors(t *testing.T) {
+	draw := committedDraw(t, "alpha", "delta")
+	require.ErrorIs(t, draw.Reveal(testutil.Bob, "beta"), types.ErrSecretMismatch)
+	// A copied commitment does not match under the copier's address.
+	require.ErrorIs(t, draw.Reveal(testutil.Carol, "alpha"), types.ErrSecretMismatch)
+	require.Nil(t, draw.Reveal(testutil.Bob, "alpha"))
+	require.ErrorIs(t, draw.Reveal(testutil.Bob, "alpha"), types.ErrAlreadyRevealed)
+}
+
+func TestColourDrawDefaulter(t *testing.T) {
+	draw := types.NewColourDraw("1", testutil.Bob, testutil.Carol)
+	_, found := draw.GetDefaulter()
+	require.False(t, found)
+	require.Nil(t, draw.Commit(testutil.Carol, types.ColourCommitment(testutil.Carol, "delta")))
+	defaulter, found := draw.GetDefaulter()
+	require.True(t, found)
+	require.Equal(t, testutil.Bob, defaulter)
+
+	draw = committedDraw(t, "alpha", "delta")
+	_, found = draw.GetDefaulter()
+	require.False(t, found)
+	require.Nil(t, draw.Reveal(testutil.Bob, "alpha"))
+	defaulter, found = draw.GetDefaulter()
+	require.True(t, found)
+	require.Equal(t, testutil.Carol, defaulter)
+}
@@ -32,4 +32,60 @@ var (
 	ErrThereIsNoWinner         = sdkerrors.Register(ModuleName, 1120, "there is no winner")
 	ErrInvalidDateAdded        = sdkerrors.Register(ModuleName, 1121, "cannot add to leaderboard: %s")
 	ErrCannotAddToLeaderboard  = sdkerrors.Register(ModuleName, 1122, "cannot add to leaderboard: %s")
+	ErrInvalidMoveHistory      = sdkerrors.Register(ModuleName, 1123, "move history is invalid")
+	ErrInvalidMoveNotation     = sdkerrors.Register(ModuleName, 1124, "move notation is invalid")
+	ErrSearchTooDeep           = sdkerrors.Register(ModuleName, 1125, "search depth is too deep")
+	ErrInvalidHouse            = sdkerrors.Register(ModuleName, 1126, "house colour is invalid: %s")
+	ErrHouseCannotPay          = sdkerrors.Register(ModuleName, 1127, "house bankroll cannot pay the wager")
+	ErrFunderCannotPay         = sdkerrors.Register(ModuleName, 1128, "funder cannot pay the house bankroll")
+	ErrHeldFundsNotBacked      = sdkerrors.Register(ModuleName, 1129, "module account balance does not cover the held funds")
+	ErrInvalidStartPosition    = sdkerrors.Register(ModuleName, 1130, "starting position is invalid: %s")
+	ErrInvalidColourDraw       = sdkerrors.Register(ModuleName, 1131, "colour draw is invalid: %s")
+	ErrColourDrawPending       = sdkerrors.Register(ModuleName, 1132, "colours are not drawn yet")
+	ErrColourDrawNotFound      = sdkerrors.Register(ModuleName, 1133, "colour draw by id not found")
+	ErrInvalidCommitment       = sdkerrors.Register(ModuleName, 1134, "commitment is not a hex SHA-256: %s")
+	ErrAlreadyCommitted        = sdkerrors.Register(ModuleName, 1135, "player has already committed")
+	ErrNotAllCommitted         = sdkerrors.Register(ModuleName, 1136, "both players must commit before revealing")
+	ErrAlreadyRevealed         = sdkerrors.Register(ModuleName, 1137, "player has already revealed")
+	ErrSecretMismatch          = sdkerrors.Register(ModuleName, 1138, "secret does not match the commitment")
+	ErrMoveCountMismatch       = sdkerrors.Register(ModuleName, 1139, "game is not at the expected move count")
+	ErrInvalidPremove          = sdkerrors.Register(ModuleName, 1140, "premove is invalid: %s")
+	ErrPremoveNotFound         = sdkerrors.Register(ModuleName, 1141, "premove by id not found")
+	ErrCannotPayPremove        = sdkerrors.Register(ModuleName, 1142, "cannot pay the premove deposit")
+	ErrTakebackNotAllowed      = sdkerrors.Register(ModuleName, 1143, "takeback is not allowed: %s")
+	ErrNoTakebackRequest       = sdkerrors.Register(ModuleName, 1144, "no takeback was requested")
+	ErrOnVacation              = sdkerrors.Register(ModuleName, 1145, "player is already on vacation")
+	ErrNotOnVacation           = sdkerrors.Register(ModuleName, 1146, "player is not on vacation")
+	ErrVacationBudgetSpent     = sdkerrors.Register(ModuleName, 1147, "vacation budget of the year is spent")
+	ErrAdjournNotAllowed       = sdkerrors.Register(ModuleName, 1148, "adjournment is not allowed: %s")
+	ErrNoAdjournProposal       = sdkerrors.Register(ModuleName, 1149, "no adjournment was proposed")
+	ErrGameAdjourned           = sdkerrors.Register(ModuleName, 1150, "game is adjourned")
+	ErrGameNotAdjourned        = sdkerrors.Register(ModuleName, 1151, "game is not adjourned")
+	ErrGameNotAccepted         = sdkerrors.Register(ModuleName, 1152, "game is waiting for the players to accept the wager")
+	ErrAlreadyAccepted         = sdkerrors.Register(ModuleName, 1153, "player has already accepted the game")
+	ErrDenomNotAllowed         = sdkerrors.Register(ModuleName, 1154, "denom is not allowed for wagers: %s")
+	ErrUnknownVoucher          = sdkerrors.Register(ModuleName, 1155, "voucher has no denom trace: %s")
+	ErrInvalidOrganizer        = sdkerrors.Register(ModuleName, 1156, "organizer address is invalid: %s")
+	ErrInvalidSponsor          = sdkerrors.Register(ModuleName, 1157, "sponsor address is invalid: %s")
+	ErrSponsorCannotPay        = sdkerrors.Register(ModuleName, 1158, "sponsor cannot pay the prize")
+	ErrInvalidSide             = sdkerrors.Register(ModuleName, 1159, "side must be b or r: %s")
+	ErrBetsClosed              = sdkerrors.Register(ModuleName, 1160, "bets are closed from move %d")
+	ErrPlayerCannotBet         = sdkerrors.Register(ModuleName, 1161, "players cannot bet on their game: %s")
+	ErrBetOnOtherSide          = sdkerrors.Register(ModuleName, 1162, "bettor already backs the other side: %s")
+	ErrBettorCannotPay         = sdkerrors.Register(ModuleName, 1163, "bettor cannot pay the bet")
+	ErrCannotSettleBets        = sdkerrors.Register(ModuleName, 1164, "cannot settle bets: %s")
+	ErrDepositorCannotPay      = sdkerrors.Register(ModuleName, 1165, "depositor cannot pay the deposit")
+	ErrBalanceTooLow           = sdkerrors.Register(ModuleName, 1166, "internal balance is too low: %s")
+	ErrInvalidNumberOfGames    = sdkerrors.Register(ModuleName, 1167, "number of games must be from 1 to %d: %d")
+	ErrMatchAgainstSelf        = sdkerrors.Register(ModuleName, 1168, "match players must differ: %s")
+	ErrMatchNotFound           = sdkerrors.Register(ModuleName, 1169, "match by id not found")
+	ErrMatchFinished           = sdkerrors.Register(ModuleName, 1170, "match is already finished")
+	ErrInvalidMatchIndex       = sdkerrors.Register(ModuleName, 1171, "match index is invalid")
+	ErrMatchPlayerCannotPay    = sdkerrors.Register(ModuleName, 1172, "player cannot pay the match wager")
+	ErrGameNotFinished         = sdkerrors.Register(ModuleName, 1173, "game is not finished")
+	ErrRematchNotAllowed       = sdkerrors.Register(ModuleName, 1174, "rematch is not allowed: %s")
+	ErrNoRematchOffer          = sdkerrors.Register(ModuleName, 1175, "no rematch was offered")
+	ErrAlreadyRematched        = sdkerrors.Register(ModuleName, 1176, "game already has a rematch: %s")
+	ErrBetTooSmall             = sdkerrors.Register(ModuleName, 1177, "bet is below %d in a denom: %s")
+	ErrTooManyBettors          = sdkerrors.Register(ModuleName, 1178, "game already has %d bettors")
 )
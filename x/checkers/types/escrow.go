@@ -0,0 +1,84 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/satya/checkers/x/checkers/rules"
+)
+
+// GetContribution returns the stake each player has paid into escrow, which
+// is nothing until the wagers are collected.
+func (storedGame StoredGame) GetContribution() sdk.Coins {
+	if storedGame.Escrowed.IsZero() {
+		return sdk.NewCoins()
+	}
+	return storedGame.Wager
+}
+
+// GetWinSplit splits the escrow of a won game into the platform rake, the
+// organizer fee when the game has an organizer, and the winnings, which
+// include the whole prize.
+func (storedGame StoredGame) GetWinSplit(params Params) (winnings sdk.Coins, rake sdk.Coins, organizerFee sdk.Coins) {
+	rake = GetShareOf(storedGame.Escrowed, params.PlatformRakeBps)
+	organizerFee = sdk.NewCoins()
+	if storedGame.Organizer != "" {
+		organizerFee = GetShareOf(storedGame.Escrowed, params.OrganizerFeeBps)
+	}
+	winnings = storedGame.Escrowed.Sub(rake).Sub(organizerFee).Add(storedGame.Prize...)
+	return winnings, rake, organizerFee
+}
+
+// GetDrawPrizeSplit splits the prize of a drawn game into the half each
+// player gets, and what cannot be split, which goes back to the sponsor.
+func (storedGame StoredGame) GetDrawPrizeSplit() (half sdk.Coins, leftover sdk.Coins) {
+	half = GetShareOf(storedGame.Prize, BpsDenominator/2)
+	return half, storedGame.Prize.Sub(half).Sub(half)
+}
+
+// GetWinOutcome tells who would get what if winner, "b" or "r", won the game
+// now.
+func (storedGame StoredGame) GetWinOutcome(winner string, params Params) EscrowOutcome {
+	outcome := newEscrowOutcome()
+	if storedGame.Escrowed.IsZero() && storedGame.Prize.IsZero() {
+		return outcome
+	}
+	winnings, rake, organizerFee := storedGame.GetWinSplit(params)
+	if winner == rules.PieceStrings[rules.RED_PLAYER] {
+		outcome.Red = winnings
+	} else {
+		outcome.Black = winnings
+	}
+	outcome.Rake = rake
+	outcome.OrganizerFee = organizerFee
+	return outcome
+}
+
+// GetRefundOutcome tells who would get what if the game were drawn, or
+// dropped without a result, now.
+func (storedGame StoredGame) GetRefundOutcome(drawn bool) EscrowOutcome {
+	outcome := newEscrowOutcome()
+	outcome.Black = storedGame.GetContribution()
+	outcome.Red = storedGame.GetContribution()
+	outcome.Sponsor = storedGame.Prize
+	if drawn {
+		half, leftover := storedGame.GetDrawPrizeSplit()
+		outcome.Black = outcome.Black.Add(half...)
+		outcome.Red = outcome.Red.Add(half...)
+		outcome.Sponsor = leftover
+	}
+	return outcome
+}
+
+func newEscrowOutcome() EscrowOutcome {
+	return EscrowOutcome{
+		Black:        sdk.NewCoins(),
+		Red:          sdk.NewCoins(),
+		Sponsor:      sdk.NewCoins(),
+		Rake:         sdk.NewCoins(),
+		OrganizerFee: sdk.NewCoins(),
+	}
+}
+
+// GetTotal returns all that an address has locked in the game.
+func (escrow PlayerGameEscrow) GetTotal() sdk.Coins {
+	return sdk.NewCoins().Add(escrow.Wager...).Add(escrow.Prize...).Add(escrow.Bet...).Add(escrow.PremoveDeposit...)
+}
@@ -0,0 +1,81 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/satya/checkers/x/checkers/types"
+	"github.com/stretchr/testify/require"
+)
+
+func stake(amount int64) sdk.Coins {
+	return sdk.NewCoins(sdk.NewInt64Coin("stake", amount))
+}
+
+func feeParams() types.Params {
+	return types.NewParams(types.DefaultAllowedDenoms, nil, 250, types.RakeToFeeCollector, 100, types.DefaultBetCutoffMove)
+}
+
+func TestGetContributionBeforeAndAfterEscrow(t *testing.T) {
+	storedGame := types.StoredGame{Wager: stake(45)}
+	require.True(t, storedGame.GetContribution().IsZero())
+	storedGame.Escrowed = stake(90)
+	require.Equal(t, stake(45), storedGame.GetContribution())
+}
+
+func TestGetWinSplitWithOrganizer(t *testing.T) {
+	storedGame := types.StoredGame{
+		Organizer: "cosmos1jmjfq0tplp9tmx4v9uemw72y4d2wa5nr3xn9d3",
+		Escrowed:  stake(1_000),
+		Prize:     stake(7),
+	}
+	winnings, rake, organizerFee := storedGame.GetWinSplit(feeParams())
+	require.Equal(t, stake(25), rake)
+	require.Equal(t, stake(10), organizerFee)
+	require.Equal(t, stake(972), winnings)
+}
+
+func TestGetWinSplitWithoutOrganizer(t *testing.T) {
+	storedGame := types.StoredGame{Escrowed: stake(1_000)}
+	winnings, rake, organizerFee := storedGame.GetWinSplit(feeParams())
+	require.Equal(t, stake(25), rake)
+	require.True(t, organizerFee.IsZero())
+	require.Equal(t, stake(975), winnings)
+}
+
+func TestGetDrawPrizeSplitOdd(t *testing.T) {
+	storedGame := types.StoredGame{Prize: stake(7)}
+	half, leftover := storedGame.GetDrawPrizeSplit()
+	require.Equal(t, stake(3), half)
+	require.Equal(t, stake(1), leftover)
+}
+
+func TestGetWinOutcomeRed(t *testing.T) {
+	storedGame := types.StoredGame{Wager: stake(500), Escrowed: stake(1_000)}
+	outcome := storedGame.GetWinOutcome("r", feeParams())
+	require.True(t, outcome.Black.IsZero())
+	require.Equal(t, stake(975), outcome.Red)
+	require.True(t, outcome.Sponsor.IsZero())
+	require.Equal(t, stake(25), outcome.Rake)
+	require.True(t, outcome.OrganizerFee.IsZero())
+}
+
+func TestGetWinOutcomeNothingEscrowed(t *testing.T) {
+	storedGame := types.StoredGame{Wager: stake(500)}
+	outcome := storedGame.GetWinOutcome("b", feeParams())
+	require.True(t, outcome.Black.IsZero())
+	require.True(t, outcome.Rake.IsZero())
+}
+
+func TestGetRefundOutcomeDrawnAndDropped(t *testing.T) {
+	storedGame := types.StoredGame{Wager: stake(45), Escrowed: stake(90), Prize: stake(7)}
+	drawn := storedGame.GetRefundOutcome(true)
+	require.Equal(t, stake(48), drawn.Black)
+	require.Equal(t, stake(48), drawn.Red)
+	require.Equal(t, stake(1), drawn.Sponsor)
+	require.True(t, drawn.Rake.IsZero())
+	dropped := storedGame.GetRefundOutcome(false)
+	require.Equal(t, stake(45), dropped.Black)
+	require.Equal(t, stake(45), dropped.Red)
+	require.Equal(t, stake(7), dropped.Sponsor)
+}
@@ -19,6 +19,16 @@ func (storedGame StoredGame) GetRedAddress() (red sdk.AccAddress, err error) {
 	return red, sdkerrors.Wrapf(errRed, ErrInvalidRed.Error(), storedGame.Red)
 }
 
+func (storedGame StoredGame) GetOrganizerAddress() (organizer sdk.AccAddress, err error) {
+	organizer, errOrganizer := sdk.AccAddressFromBech32(storedGame.Organizer)
+	return organizer, sdkerrors.Wrapf(errOrganizer, ErrInvalidOrganizer.Error(), storedGame.Organizer)
+}
+
+func (storedGame StoredGame) GetSponsorAddress() (sponsor sdk.AccAddress, err error) {
+	sponsor, errSponsor := sdk.AccAddressFromBech32(storedGame.Sponsor)
+	return sponsor, sdkerrors.Wrapf(errSponsor, ErrInvalidSponsor.Error(), storedGame.Sponsor)
+}
+
 func (storedGame StoredGame) ParseGame() (game *rules.Game, err error) {
 	board, errBoard := rules.Parse(storedGame.Board)
 	if errBoard != nil {
@@ -56,6 +66,15 @@ func (storedGame StoredGame) GetDeadlineAsTime() (deadline time.Time, err error)
 	return deadline, sdkerrors.Wrapf(errDeadline, ErrInvalidDeadline.Error(), storedGame.Deadline)
 }
 
+func (storedGame StoredGame) IsAdjourned() bool {
+	return storedGame.AdjournedUntil != ""
+}
+
+func (storedGame StoredGame) GetAdjournedUntilAsTime() (until time.Time, err error) {
+	until, errUntil := time.Parse(DeadlineLayout, storedGame.AdjournedUntil)
+	return until, sdkerrors.Wrapf(errUntil, ErrInvalidDeadline.Error(), storedGame.AdjournedUntil)
+}
+
 func FormatDeadline(deadline time.Time) string {
 	return deadline.UTC().Format(DeadlineLayout)
 }
@@ -64,8 +83,149 @@ func GetNextDeadline(ctx sdk.Context) time.Time {
 	return ctx.BlockTime().Add(MaxTurnDuration)
 }
 
-func (storedGame *StoredGame) GetWagerCoin() (wager sdk.Coin) {
-	return sdk.NewCoin(storedGame.Denom, sdk.NewInt(int64(storedGame.Wager)))
+// IsFunded tells whether play can start in a game in progress, that is
+// whether it has no wager or both stakes are in escrow.
+func (storedGame StoredGame) IsFunded() bool {
+	return storedGame.Wager.IsZero() || !storedGame.Escrowed.IsZero()
+}
+
+func (storedGame StoredGame) GetMoveHistorySteps() (steps []rules.Step, err error) {
+	for _, notation := range storedGame.MoveHistory {
+		step, errStep := rules.ParseStep(notation)
+		if errStep != nil {
+			return nil, sdkerrors.Wrapf(errStep, ErrInvalidMoveHistory.Error())
+		}
+		steps = append(steps, step)
+	}
+	return steps, nil
+}
+
+// ExportPDN replays the move history from the start and writes it as PDN, with
+// red as PDN's White.
+func (storedGame StoredGame) ExportPDN() (pdn string, err error) {
+	steps, err := storedGame.GetMoveHistorySteps()
+	if err != nil {
+		return "", err
+	}
+	start, err := storedGame.GetStartGame()
+	if err != nil {
+		return "", err
+	}
+	result := rules.ResultFor(rules.NO_PLAYER)
+	if storedGame.Winner == DrawWinner {
+		result = rules.RESULT_DRAW
+	} else if storedGame.Winner != rules.PieceStrings[rules.NO_PLAYER] {
+		result = rules.ResultFor(rules.StringPieces[storedGame.Winner].Player)
+	}
+	pdn, err = rules.WritePDN(&rules.PdnGame{
+		Tags: []rules.PdnTag{
+			{Name: "Event", Value: fmt.Sprintf("%s game %s", ModuleName, storedGame.Index)},
+			{Name: "Black", Value: storedGame.Black},
+			{Name: "White", Value: storedGame.Red},
+		},
+		Start:  start,
+		Steps:  steps,
+		Result: result,
+	})
+	if err != nil {
+		return "", sdkerrors.Wrapf(err, ErrInvalidMoveHistory.Error())
+	}
+	return pdn, nil
+}
+
+// ParseStartPosition parses and checks a custom starting position.
+func ParseStartPosition(board string, turn string) (*rules.Game, error) {
+	game, err := StoredGame{Board: board, Turn: turn}.ParseGame()
+	if err != nil {
+		return nil, sdkerrors.Wrapf(ErrInvalidStartPosition, "%s", err.Error())
+	}
+	err = game.ValidateSetup()
+	if err != nil {
+		return nil, sdkerrors.Wrapf(ErrInvalidStartPosition, "%s", err.Error())
+	}
+	return game, nil
+}
+
+// GetStartGame returns the position the game started from.
+func (storedGame StoredGame) GetStartGame() (*rules.Game, error) {
+	if storedGame.StartBoard == "" {
+		return rules.New(), nil
+	}
+	return StoredGame{Board: storedGame.StartBoard, Turn: storedGame.StartTurn}.ParseGame()
+}
+
+// GetFirstMover returns the colour that moved first.
+func (storedGame StoredGame) GetFirstMover() string {
+	if storedGame.StartTurn == "" {
+		return rules.PieceStrings[rules.BLACK_PLAYER]
+	}
+	return storedGame.StartTurn
+}
+
+// HasSecondMoverPlayed tells whether both players have moved. A game that
+// ends before that is dropped rather than won. From the standard start, the
+// first move cannot be a jump, so the move count tells. From a custom start,
+// the first move may be a multi-jump, that is a chain of jumps by the same
+// piece.
+func (storedGame StoredGame) HasSecondMoverPlayed() bool {
+	if storedGame.StartBoard == "" {
+		return 1 < storedGame.MoveCount
+	}
+	steps, err := storedGame.GetMoveHistorySteps()
+	if err != nil {
+		panic(err.Error())
+	}
+	for i := 1; i < len(steps); i++ {
+		if !steps[i-1].IsJump() || steps[i-1].Dst != steps[i].Src {
+			return true
+		}
+	}
+	return false
+}
+
+// GetTakeback replays the move history without the last move of player, that
+// is without the steps player played at the end of the history. It returns
+// that earlier position and how many steps it drops.
+func (storedGame StoredGame) GetTakeback(player rules.Player) (game *rules.Game, undone int, err error) {
+	steps, err := storedGame.GetMoveHistorySteps()
+	if err != nil {
+		return nil, 0, err
+	}
+	game, err = storedGame.GetStartGame()
+	if err != nil {
+		return nil, 0, err
+	}
+	positions := make([]*rules.Game, 0, len(steps))
+	for _, step := range steps {
+		positions = append(positions, game.Copy())
+		if _, err := game.Move(step.Src, step.Dst); err != nil {
+			return nil, 0, sdkerrors.Wrapf(err, ErrInvalidMoveHistory.Error())
+		}
+	}
+	for undone < len(positions) && positions[len(positions)-1-undone].Turn == player {
+		undone++
+	}
+	if undone == 0 {
+		return nil, 0, sdkerrors.Wrapf(ErrTakebackNotAllowed, "%s", "the player did not play the last move")
+	}
+	return positions[len(positions)-undone], undone, nil
+}
+
+// TakeBack restores the game to before the last move of player. The escrow
+// does not change.
+func (storedGame *StoredGame) TakeBack(player rules.Player) (undone int, err error) {
+	game, undone, err := storedGame.GetTakeback(player)
+	if err != nil {
+		return 0, err
+	}
+	restored := *storedGame
+	restored.Board = game.String()
+	restored.Turn = rules.PieceStrings[game.Turn]
+	restored.MoveCount -= uint64(undone)
+	restored.MoveHistory = storedGame.MoveHistory[:len(storedGame.MoveHistory)-undone]
+	restored.TakebackRequester = ""
+	*storedGame = restored
+	return undone, nil
 }
 
 func (storedGame StoredGame) Validate() (err error) {
@@ -77,6 +237,18 @@ func (storedGame StoredGame) Validate() (err error) {
 	if err != nil {
 		return err
 	}
+	if storedGame.Organizer != "" {
+		_, err = storedGame.GetOrganizerAddress()
+		if err != nil {
+			return err
+		}
+	}
+	if storedGame.Sponsor != "" {
+		_, err = storedGame.GetSponsorAddress()
+		if err != nil {
+			return err
+		}
+	}
 	_, err = storedGame.ParseGame()
 	if err != nil {
 		return err
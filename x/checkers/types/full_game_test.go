@@ -115,3 +115,87 @@ func TestGameValidateOk(t *testing.T) {
 	storedGame := GetStoredGame1()
 	require.NoError(t, storedGame.Validate())
 }
+
+func TestExportPdn(t *testing.T) {
+	storedGame := GetStoredGame1()
+	storedGame.Winner = "*"
+	storedGame.MoveHistory = []string{"11-15", "22-18", "15x22", "25x18"}
+	pdn, err := storedGame.ExportPDN()
+	require.Nil(t, err)
+	require.Equal(t, "[Event \"checkers game 1\"]\n"+
+		"[Black \""+alice+"\"]\n"+
+		"[White \""+bob+"\"]\n"+
+		"[GameType \"21\"]\n"+
+		"[Result \"*\"]\n"+
+		"\n"+
+		"1. 11-15 22-18 2. 15x22 25x18 *\n", pdn)
+
+	imported, err := rules.ParsePDN(pdn)
+	require.Nil(t, err)
+	require.Len(t, imported.Steps, 4)
+}
+
+func TestExportPdnWinner(t *testing.T) {
+	storedGame := GetStoredGame1()
+	storedGame.Winner = "r"
+	pdn, err := storedGame.ExportPDN()
+	require.Nil(t, err)
+	require.True(t, strings.HasSuffix(pdn, "[Result \"0-1\"]\n\n0-1\n"))
+}
+
+func TestExportPdnDraw(t *testing.T) {
+	storedGame := GetStoredGame1()
+	storedGame.Winner = types.DrawWinner
+	pdn, err := storedGame.ExportPDN()
+	require.Nil(t, err)
+	require.True(t, strings.HasSuffix(pdn, "[Result \"1/2-1/2\"]\n\n1/2-1/2\n"))
+}
+
+func TestExportPdnWrongHistory(t *testing.T) {
+	storedGame := GetStoredGame1()
+	storedGame.MoveHistory = []string{"11-15", "11-15"}
+	_, err := storedGame.ExportPDN()
+	require.EqualError(t, err, "move history is invalid: step 2: No piece at source position: {5 2}")
+}
+
+func playedStoredGame(t *testing.T, moves ...string) types.StoredGame {
+	storedGame := GetStoredGame1()
+	game := rules.New()
+	for _, move := range moves {
+		_, err := game.PlayNotation(move)
+		require.Nil(t, err)
+		storedGame.MoveHistory = append(storedGame.MoveHistory, move)
+		storedGame.MoveCount++
+	}
+	storedGame.Board = game.String()
+	storedGame.Turn = rules.PieceStrings[game.Turn]
+	return storedGame
+}
+
+func TestTakeBackLastMove(t *testing.T) {
+	storedGame := playedStoredGame(t, "11-15", "22-18", "15x22")
+	storedGame.TakebackRequester = alice
+	undone, err := storedGame.TakeBack(rules.BLACK_PLAYER)
+	require.Nil(t, err)
+	require.EqualValues(t, 1, undone)
+	expected := playedStoredGame(t, "11-15", "22-18")
+	require.EqualValues(t, expected, storedGame)
+}
+
+func TestTakeBackNotLastMover(t *testing.T) {
+	storedGame := playedStoredGame(t, "11-15", "22-18", "15x22")
+	_, err := storedGame.TakeBack(rules.RED_PLAYER)
+	require.EqualError(t, err, "the player did not play the last move: takeback is not allowed: %s")
+	require.EqualValues(t, 3, storedGame.MoveCount)
+}
+
+func TestTakeBackFirstMoveWithWager(t *testing.T) {
+	storedGame := playedStoredGame(t, "11-15")
+	storedGame.Wager = sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+	storedGame.Escrowed = sdk.NewCoins(sdk.NewInt64Coin("stake", 20))
+	undone, err := storedGame.TakeBack(rules.BLACK_PLAYER)
+	require.Nil(t, err)
+	require.EqualValues(t, 1, undone)
+	require.Empty(t, storedGame.MoveHistory)
+	require.EqualValues(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 20)), storedGame.Escrowed)
+}
@@ -14,8 +14,16 @@ func DefaultGenesis() *GenesisState {
 			NextId:        uint64(DefaultIndex),
 			FifoHeadIndex: NoFifoIndex,
 			FifoTailIndex: NoFifoIndex,
+			NextMatchId:   DefaultIndex,
 		},
-		StoredGameList: []StoredGame{},
+		StoredGameList:    []StoredGame{},
+		HouseBankroll:     HouseBankroll{},
+		ColourDrawList:    []ColourDraw{},
+		PremoveList:       []Premove{},
+		VacationList:      []Vacation{},
+		BetList:           []Bet{},
+		PlayerBalanceList: []PlayerBalance{},
+		MatchList:         []Match{},
 		// this line is used by starport scaffolding # genesis/types/default
 		Params: DefaultParams(),
 	}
@@ -34,6 +42,72 @@ func (gs GenesisState) Validate() error {
 		}
 		storedGameIndexMap[index] = struct{}{}
 	}
+	// Check for duplicated index in colourDraw
+	colourDrawIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.ColourDrawList {
+		index := string(ColourDrawKey(elem.Index))
+		if _, ok := colourDrawIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for colourDraw")
+		}
+		colourDrawIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated index in premove
+	premoveIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.PremoveList {
+		index := string(PremoveKey(elem.Index))
+		if _, ok := premoveIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for premove")
+		}
+		premoveIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated index in vacation
+	vacationIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.VacationList {
+		index := string(VacationKey(elem.Index))
+		if _, ok := vacationIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for vacation")
+		}
+		vacationIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated index in bet
+	betIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.BetList {
+		index := string(BetKey(elem.GameIndex, elem.Bettor))
+		if _, ok := betIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for bet")
+		}
+		betIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated index in playerBalance
+	playerBalanceIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.PlayerBalanceList {
+		index := string(PlayerBalanceKey(elem.Index))
+		if _, ok := playerBalanceIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for playerBalance")
+		}
+		playerBalanceIndexMap[index] = struct{}{}
+		if err := elem.Funds.Validate(); err != nil {
+			return fmt.Errorf("invalid player balance: %w", err)
+		}
+	}
+	// Check for duplicated index in match
+	matchIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.MatchList {
+		index := string(MatchKey(elem.Index))
+		if _, ok := matchIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for match")
+		}
+		matchIndexMap[index] = struct{}{}
+	}
+	if err := gs.HouseBankroll.Funds.Validate(); err != nil {
+		return fmt.Errorf("invalid house bankroll: %w", err)
+	}
 	// this line is used by starport scaffolding # genesis/types/validate
 
 	return gs.Params.Validate()
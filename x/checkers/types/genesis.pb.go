@@ -25,9 +25,16 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the checkers module's genesis state.
 type GenesisState struct {
-	Params         Params       `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	SystemInfo     SystemInfo   `protobuf:"bytes,2,opt,name=systemInfo,proto3" json:"systemInfo"`
-	StoredGameList []StoredGame `protobuf:"bytes,3,rep,name=storedGameList,proto3" json:"storedGameList"`
+	Params            Params          `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	SystemInfo        SystemInfo      `protobuf:"bytes,2,opt,name=systemInfo,proto3" json:"systemInfo"`
+	StoredGameList    []StoredGame    `protobuf:"bytes,3,rep,name=storedGameList,proto3" json:"storedGameList"`
+	HouseBankroll     HouseBankroll   `protobuf:"bytes,4,opt,name=houseBankroll,proto3" json:"houseBankroll"`
+	ColourDrawList    []ColourDraw    `protobuf:"bytes,5,rep,name=colourDrawList,proto3" json:"colourDrawList"`
+	PremoveList       []Premove       `protobuf:"bytes,6,rep,name=premoveList,proto3" json:"premoveList"`
+	VacationList      []Vacation      `protobuf:"bytes,7,rep,name=vacationList,proto3" json:"vacationList"`
+	BetList           []Bet           `protobuf:"bytes,8,rep,name=betList,proto3" json:"betList"`
+	PlayerBalanceList []PlayerBalance `protobuf:"bytes,9,rep,name=playerBalanceList,proto3" json:"playerBalanceList"`
+	MatchList         []Match         `protobuf:"bytes,10,rep,name=matchList,proto3" json:"matchList"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -84,6 +91,55 @@ func (m *GenesisState) GetStoredGameList() []StoredGame {
 	return nil
 }
 
+func (m *GenesisState) GetHouseBankroll() HouseBankroll {
+	if m != nil {
+		return m.HouseBankroll
+	}
+	return HouseBankroll{}
+}
+
+func (m *GenesisState) GetColourDrawList() []ColourDraw {
+	if m != nil {
+		return m.ColourDrawList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPremoveList() []Premove {
+	if m != nil {
+		return m.PremoveList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetVacationList() []Vacation {
+	if m != nil {
+		return m.VacationList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBetList() []Bet {
+	if m != nil {
+		return m.BetList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPlayerBalanceList() []PlayerBalance {
+	if m != nil {
+		return m.PlayerBalanceList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMatchList() []Match {
+	if m != nil {
+		return m.MatchList
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "satya.checkers.checkers.GenesisState")
 }
@@ -91,24 +147,38 @@ func init() {
 func init() { proto.RegisterFile("checkers/genesis.proto", fileDescriptor_6e928243c164a8dc) }
 
 var fileDescriptor_6e928243c164a8dc = []byte{
-	// 268 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x4b, 0xce, 0x48, 0x4d,
-	0xce, 0x4e, 0x2d, 0x2a, 0xd6, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca,
-	0x2f, 0xc9, 0x17, 0x12, 0x2f, 0x4e, 0x2c, 0xa9, 0x4c, 0xd4, 0x83, 0xc9, 0xc2, 0x19, 0x52, 0x22,
-	0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0x35, 0xfa, 0x20, 0x16, 0x44, 0xb9, 0x94, 0x28, 0xdc, 0x98, 0x82,
-	0xc4, 0xa2, 0xc4, 0x5c, 0xa8, 0x29, 0x52, 0x52, 0x70, 0xe1, 0xe2, 0xca, 0xe2, 0x92, 0xd4, 0xdc,
-	0xf8, 0xcc, 0xbc, 0xb4, 0x7c, 0x4c, 0xb9, 0x92, 0xfc, 0xa2, 0xd4, 0x94, 0xf8, 0xf4, 0xc4, 0xdc,
-	0x54, 0x88, 0x9c, 0xd2, 0x6b, 0x46, 0x2e, 0x1e, 0x77, 0x88, 0x7b, 0x82, 0x4b, 0x12, 0x4b, 0x52,
-	0x85, 0x6c, 0xb9, 0xd8, 0x20, 0x06, 0x4b, 0x30, 0x2a, 0x30, 0x6a, 0x70, 0x1b, 0xc9, 0xeb, 0xe1,
-	0x70, 0x9f, 0x5e, 0x00, 0x58, 0x99, 0x13, 0xcb, 0x89, 0x7b, 0xf2, 0x0c, 0x41, 0x50, 0x4d, 0x42,
-	0x9e, 0x5c, 0x5c, 0x10, 0x07, 0x78, 0xe6, 0xa5, 0xe5, 0x4b, 0x30, 0x81, 0x8d, 0x50, 0xc6, 0x69,
-	0x44, 0x30, 0x5c, 0x29, 0xd4, 0x18, 0x24, 0xcd, 0x42, 0x81, 0x5c, 0x7c, 0x10, 0xf7, 0xba, 0x27,
-	0xe6, 0xa6, 0xfa, 0x64, 0x16, 0x97, 0x48, 0x30, 0x2b, 0x30, 0xe3, 0x37, 0x0e, 0xae, 0x1c, 0x6a,
-	0x1c, 0x9a, 0x01, 0x4e, 0x2e, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91,
-	0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0xa5,
-	0x95, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0x0f, 0x36, 0x5e, 0x1f, 0x1e,
-	0x68, 0x15, 0x08, 0x66, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0x38, 0xe8, 0x8c, 0x01, 0x01,
-	0x00, 0x00, 0xff, 0xff, 0xac, 0x61, 0xe6, 0x79, 0xd2, 0x01, 0x00, 0x00,
+	// 486 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0x86, 0x63, 0x52, 0x52, 0xba, 0x2d, 0x48, 0xac, 0x0a, 0x8d, 0x22, 0x70, 0x03, 0x48, 0x08,
+	0x71, 0x70, 0x24, 0xb8, 0xc2, 0xc5, 0x54, 0x6a, 0x2b, 0x40, 0x2a, 0xad, 0xc4, 0xa1, 0x17, 0x6b,
+	0xed, 0x4e, 0x1d, 0xab, 0xb6, 0xd7, 0xda, 0xdd, 0xb4, 0xe4, 0x09, 0xb8, 0xf2, 0x58, 0x3d, 0xf6,
+	0xc8, 0x09, 0xa1, 0xe4, 0x45, 0x50, 0x66, 0xc7, 0x9b, 0x84, 0xc8, 0xed, 0x6d, 0xb5, 0xff, 0xff,
+	0x7f, 0x1e, 0xcf, 0xcc, 0xb2, 0xa7, 0xc9, 0x10, 0x92, 0x0b, 0x50, 0x7a, 0x90, 0x42, 0x09, 0x3a,
+	0xd3, 0x41, 0xa5, 0xa4, 0x91, 0x7c, 0x47, 0x0b, 0x33, 0x16, 0x41, 0xad, 0xba, 0x43, 0x6f, 0x3b,
+	0x95, 0xa9, 0x44, 0xcf, 0x60, 0x76, 0xb2, 0xf6, 0xde, 0x13, 0x87, 0xa9, 0x84, 0x12, 0x05, 0x51,
+	0x7a, 0x3d, 0x77, 0xad, 0xc7, 0xda, 0x40, 0x11, 0x65, 0xe5, 0xb9, 0x5c, 0xd5, 0x8c, 0x54, 0x70,
+	0x16, 0xa5, 0xa2, 0x00, 0xd2, 0x9e, 0x3b, 0x6d, 0x28, 0x47, 0x1a, 0xa2, 0x58, 0x94, 0x17, 0x4a,
+	0xe6, 0xf9, 0x4a, 0x34, 0x91, 0xb9, 0x1c, 0xa9, 0xe8, 0x4c, 0x89, 0x2b, 0xd2, 0xe6, 0x3f, 0x54,
+	0x29, 0x28, 0xe4, 0x65, 0x8d, 0xdc, 0x71, 0xf7, 0x97, 0x22, 0x11, 0x26, 0x93, 0x25, 0x09, 0xdc,
+	0x09, 0x31, 0x98, 0x95, 0xef, 0x57, 0xb9, 0x18, 0x83, 0x8a, 0x62, 0x91, 0x8b, 0x32, 0xa9, 0x59,
+	0xdb, 0x4e, 0x2e, 0x84, 0x49, 0x86, 0xf6, 0xf6, 0xe5, 0xcf, 0x0e, 0xdb, 0xda, 0xb7, 0x4d, 0x3c,
+	0x31, 0xc2, 0x00, 0xff, 0xc8, 0x3a, 0xb6, 0x1b, 0x5d, 0xaf, 0xef, 0xbd, 0xd9, 0x7c, 0xb7, 0x1b,
+	0x34, 0x34, 0x35, 0x38, 0x42, 0x5b, 0xb8, 0x76, 0xfd, 0x67, 0xb7, 0x75, 0x4c, 0x21, 0x7e, 0xc8,
+	0x98, 0xed, 0xda, 0x61, 0x79, 0x2e, 0xbb, 0xf7, 0x10, 0xf1, 0xaa, 0x11, 0x71, 0xe2, 0xac, 0x84,
+	0x59, 0x08, 0xf3, 0x6f, 0xec, 0x91, 0x6d, 0xf2, 0xbe, 0x28, 0xe0, 0x4b, 0xa6, 0x4d, 0xb7, 0xdd,
+	0x6f, 0xdf, 0x8e, 0x73, 0x76, 0xc2, 0xfd, 0x07, 0xe0, 0xc7, 0xec, 0x21, 0xce, 0x26, 0xa4, 0xd1,
+	0x74, 0xd7, 0xb0, 0xc0, 0xd7, 0x8d, 0xc4, 0x83, 0x45, 0x37, 0x41, 0x97, 0x11, 0xb3, 0x32, 0xed,
+	0x40, 0xf7, 0x94, 0xb8, 0xc2, 0x32, 0xef, 0xdf, 0x51, 0xe6, 0x27, 0x67, 0xaf, 0xcb, 0x5c, 0x06,
+	0xf0, 0x03, 0xb6, 0x49, 0x7b, 0x80, 0xbc, 0x0e, 0xf2, 0xfa, 0xcd, 0x83, 0xb0, 0x5e, 0x82, 0x2d,
+	0x46, 0xf9, 0x67, 0xb6, 0x55, 0x6f, 0x0e, 0xa2, 0xd6, 0x11, 0xf5, 0xa2, 0x11, 0xf5, 0x9d, 0xcc,
+	0xc4, 0x5a, 0x0a, 0xf3, 0x0f, 0x6c, 0x3d, 0x06, 0x83, 0x9c, 0x07, 0xc8, 0x79, 0xd6, 0xc8, 0x09,
+	0xc1, 0x10, 0xa2, 0x8e, 0xf0, 0x53, 0xf6, 0xd8, 0xee, 0x65, 0x68, 0xd7, 0x12, 0x39, 0x1b, 0xfd,
+	0xf6, 0xad, 0xfd, 0x3f, 0x5a, 0x4c, 0x10, 0x71, 0x15, 0xc3, 0x43, 0xb6, 0x81, 0x4b, 0x8d, 0x4c,
+	0x86, 0x4c, 0xbf, 0x91, 0xf9, 0x75, 0xe6, 0x24, 0xd6, 0x3c, 0x16, 0xee, 0x5d, 0x4f, 0x7c, 0xef,
+	0x66, 0xe2, 0x7b, 0x7f, 0x27, 0xbe, 0xf7, 0x6b, 0xea, 0xb7, 0x6e, 0xa6, 0x7e, 0xeb, 0xf7, 0xd4,
+	0x6f, 0x9d, 0xbe, 0x4d, 0x33, 0x33, 0x1c, 0xc5, 0x41, 0x22, 0x8b, 0x01, 0x42, 0x07, 0xee, 0x29,
+	0xfd, 0x98, 0x1f, 0xcd, 0xb8, 0x02, 0x1d, 0x77, 0xf0, 0x59, 0xbd, 0xff, 0x37, 0x00, 0xb6, 0x10,
+	0x87, 0x41, 0xa3, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -131,6 +201,100 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MatchList) > 0 {
+		for iNdEx := len(m.MatchList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MatchList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.PlayerBalanceList) > 0 {
+		for iNdEx := len(m.PlayerBalanceList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PlayerBalanceList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.BetList) > 0 {
+		for iNdEx := len(m.BetList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BetList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.VacationList) > 0 {
+		for iNdEx := len(m.VacationList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VacationList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.PremoveList) > 0 {
+		for iNdEx := len(m.PremoveList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PremoveList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.ColourDrawList) > 0 {
+		for iNdEx := len(m.ColourDrawList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ColourDrawList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size, err := m.HouseBankroll.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
 	if len(m.StoredGameList) > 0 {
 		for iNdEx := len(m.StoredGameList) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -195,6 +359,44 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	l = m.HouseBankroll.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ColourDrawList) > 0 {
+		for _, e := range m.ColourDrawList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PremoveList) > 0 {
+		for _, e := range m.PremoveList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.VacationList) > 0 {
+		for _, e := range m.VacationList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BetList) > 0 {
+		for _, e := range m.BetList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PlayerBalanceList) > 0 {
+		for _, e := range m.PlayerBalanceList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MatchList) > 0 {
+		for _, e := range m.MatchList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -333,6 +535,243 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HouseBankroll", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.HouseBankroll.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ColourDrawList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ColourDrawList = append(m.ColourDrawList, ColourDraw{})
+			if err := m.ColourDrawList[len(m.ColourDrawList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PremoveList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PremoveList = append(m.PremoveList, Premove{})
+			if err := m.PremoveList[len(m.PremoveList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VacationList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VacationList = append(m.VacationList, Vacation{})
+			if err := m.VacationList[len(m.VacationList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BetList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BetList = append(m.BetList, Bet{})
+			if err := m.BetList[len(m.BetList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlayerBalanceList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlayerBalanceList = append(m.PlayerBalanceList, PlayerBalance{})
+			if err := m.PlayerBalanceList[len(m.PlayerBalanceList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MatchList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MatchList = append(m.MatchList, Match{})
+			if err := m.MatchList[len(m.MatchList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -3,6 +3,7 @@ package types_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/satya/checkers/x/checkers/types"
 	"github.com/stretchr/testify/require"
 )
@@ -21,6 +22,7 @@ func TestGenesisState_Validate(t *testing.T) {
 		{
 			desc: "valid genesis state",
 			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
 
 				SystemInfo: types.SystemInfo{
 					NextId: 100,
@@ -51,6 +53,177 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "duplicated colourDraw",
+			genState: &types.GenesisState{
+				ColourDrawList: []types.ColourDraw{
+					{
+						Index: "1",
+					},
+					{
+						Index: "1",
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated premove",
+			genState: &types.GenesisState{
+				PremoveList: []types.Premove{
+					{
+						Index: "1",
+					},
+					{
+						Index: "1",
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated vacation",
+			genState: &types.GenesisState{
+				VacationList: []types.Vacation{
+					{
+						Index: "0",
+					},
+					{
+						Index: "0",
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated allowed denom",
+			genState: &types.GenesisState{
+				Params: types.NewParams([]string{"stake", "token", "stake"}, nil, 0, types.RakeToFeeCollector, 0, types.DefaultBetCutoffMove),
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid allowed denom",
+			genState: &types.GenesisState{
+				Params: types.NewParams([]string{"1token"}, nil, 0, types.RakeToFeeCollector, 0, types.DefaultBetCutoffMove),
+			},
+			valid: false,
+		},
+		{
+			desc: "voucher allowed as a native denom",
+			genState: &types.GenesisState{
+				Params: types.NewParams([]string{"ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"}, nil, 0, types.RakeToFeeCollector, 0, types.DefaultBetCutoffMove),
+			},
+			valid: false,
+		},
+		{
+			desc: "valid allowed voucher",
+			genState: &types.GenesisState{
+				Params: types.NewParams(nil, []types.AllowedVoucher{{BaseDenom: "uatom", Channel: "channel-0"}}, 0, types.RakeToFeeCollector, 0, types.DefaultBetCutoffMove),
+			},
+			valid: true,
+		},
+		{
+			desc: "invalid voucher channel",
+			genState: &types.GenesisState{
+				Params: types.NewParams(nil, []types.AllowedVoucher{{BaseDenom: "uatom", Channel: "chan/0"}}, 0, types.RakeToFeeCollector, 0, types.DefaultBetCutoffMove),
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated allowed voucher",
+			genState: &types.GenesisState{
+				Params: types.NewParams(nil, []types.AllowedVoucher{
+					{BaseDenom: "uatom", Channel: "channel-0"},
+					{BaseDenom: "uatom", Channel: "channel-0"},
+				}, 0, types.RakeToFeeCollector, 0, types.DefaultBetCutoffMove),
+			},
+			valid: false,
+		},
+		{
+			desc: "valid fees",
+			genState: &types.GenesisState{
+				Params: types.NewParams(types.DefaultAllowedDenoms, nil, 250, types.RakeToCommunityPool, 100, types.DefaultBetCutoffMove),
+			},
+			valid: true,
+		},
+		{
+			desc: "platform rake above cap",
+			genState: &types.GenesisState{
+				Params: types.NewParams(types.DefaultAllowedDenoms, nil, 1_001, types.RakeToFeeCollector, 0, types.DefaultBetCutoffMove),
+			},
+			valid: false,
+		},
+		{
+			desc: "unknown rake destination",
+			genState: &types.GenesisState{
+				Params: types.NewParams(types.DefaultAllowedDenoms, nil, 0, "burn", 0, types.DefaultBetCutoffMove),
+			},
+			valid: false,
+		},
+		{
+			desc: "organizer fee above cap",
+			genState: &types.GenesisState{
+				Params: types.NewParams(types.DefaultAllowedDenoms, nil, 0, types.RakeToFeeCollector, 1_001, types.DefaultBetCutoffMove),
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated bet",
+			genState: &types.GenesisState{
+				BetList: []types.Bet{
+					{
+						GameIndex: "1",
+						Bettor:    "cosmos1jmjfq0tplp9tmx4v9uemw72y4d2wa5nr3xn9d3",
+					},
+					{
+						GameIndex: "1",
+						Bettor:    "cosmos1jmjfq0tplp9tmx4v9uemw72y4d2wa5nr3xn9d3",
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated playerBalance",
+			genState: &types.GenesisState{
+				PlayerBalanceList: []types.PlayerBalance{
+					{
+						Index: "cosmos1jmjfq0tplp9tmx4v9uemw72y4d2wa5nr3xn9d3",
+					},
+					{
+						Index: "cosmos1jmjfq0tplp9tmx4v9uemw72y4d2wa5nr3xn9d3",
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid playerBalance funds",
+			genState: &types.GenesisState{
+				PlayerBalanceList: []types.PlayerBalance{
+					{
+						Index: "cosmos1jmjfq0tplp9tmx4v9uemw72y4d2wa5nr3xn9d3",
+						Funds: sdk.Coins{sdk.Coin{Denom: "stake", Amount: sdk.NewInt(-1)}},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated match",
+			genState: &types.GenesisState{
+				MatchList: []types.Match{
+					{
+						Index: "1",
+					},
+					{
+						Index: "1",
+					},
+				},
+			},
+			valid: false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
@@ -67,12 +240,20 @@ func TestGenesisState_Validate(t *testing.T) {
 func TestDafaultGenesisState_ExpectedInitialNextId(t *testing.T) {
 	require.EqualValues(t,
 		&types.GenesisState{
+			Params:         types.DefaultParams(),
 			StoredGameList: []types.StoredGame{},
 			SystemInfo: types.SystemInfo{
 				NextId:        uint64(1),
 				FifoHeadIndex: "-1",
 				FifoTailIndex: "-1",
+				NextMatchId:   1,
 			},
+			ColourDrawList:    []types.ColourDraw{},
+			PremoveList:       []types.Premove{},
+			VacationList:      []types.Vacation{},
+			BetList:           []types.Bet{},
+			PlayerBalanceList: []types.PlayerBalance{},
+			MatchList:         []types.Match{},
 		},
 		types.DefaultGenesis())
 }
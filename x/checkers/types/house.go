@@ -0,0 +1,26 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/satya/checkers/x/checkers/rules"
+)
+
+// GetHouseAddress returns the module account, which plays for the house.
+func GetHouseAddress() sdk.AccAddress {
+	return authtypes.NewModuleAddress(ModuleName)
+}
+
+func IsValidHouse(house string) bool {
+	return house == "" ||
+		house == rules.PieceStrings[rules.BLACK_PLAYER] ||
+		house == rules.PieceStrings[rules.RED_PLAYER]
+}
+
+func (storedGame StoredGame) IsHouseTurn() bool {
+	return storedGame.House != "" && storedGame.House == storedGame.Turn
+}
+
+func (storedGame StoredGame) IsHouseAddress(address sdk.AccAddress) bool {
+	return storedGame.House != "" && GetHouseAddress().Equals(address)
+}
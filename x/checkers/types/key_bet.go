@@ -0,0 +1,69 @@
+package types
+
+import "encoding/binary"
+
+var _ binary.ByteOrder
+
+const (
+	// BetKeyPrefix is the prefix to retrieve all Bet
+	BetKeyPrefix = "Bet/value/"
+	// BetByBettorKeyPrefix is the prefix of the index of bets by bettor
+	BetByBettorKeyPrefix = "Bet/bettor/"
+)
+
+// BetKey returns the store key to retrieve a Bet from the index fields
+func BetKey(
+	gameIndex string,
+	bettor string,
+) []byte {
+	var key []byte
+
+	key = append(key, BetGameKey(gameIndex)...)
+	bettorBytes := []byte(bettor)
+	key = append(key, bettorBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// BetGameKey returns the prefix under which all the bets on a game are stored
+func BetGameKey(
+	gameIndex string,
+) []byte {
+	var key []byte
+
+	gameIndexBytes := []byte(gameIndex)
+	key = append(key, gameIndexBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// BetBettorKey returns the prefix under which all the bets of a bettor are
+// indexed
+func BetBettorKey(
+	bettor string,
+) []byte {
+	var key []byte
+
+	bettorBytes := []byte(bettor)
+	key = append(key, bettorBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// BetByBettorKey returns the store key of a Bet in the index by bettor
+func BetByBettorKey(
+	bettor string,
+	gameIndex string,
+) []byte {
+	var key []byte
+
+	key = append(key, BetBettorKey(bettor)...)
+	gameIndexBytes := []byte(gameIndex)
+	key = append(key, gameIndexBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
@@ -0,0 +1,23 @@
+package types
+
+import "encoding/binary"
+
+var _ binary.ByteOrder
+
+const (
+	// ColourDrawKeyPrefix is the prefix to retrieve all ColourDraw
+	ColourDrawKeyPrefix = "ColourDraw/value/"
+)
+
+// ColourDrawKey returns the store key to retrieve a ColourDraw from the index fields
+func ColourDrawKey(
+	index string,
+) []byte {
+	var key []byte
+
+	indexBytes := []byte(index)
+	key = append(key, indexBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
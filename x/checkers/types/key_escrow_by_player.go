@@ -0,0 +1,49 @@
+package types
+
+import "encoding/binary"
+
+var _ binary.ByteOrder
+
+const (
+	// EscrowByPlayerKeyPrefix is the prefix of the index of the games and
+	// matches in which each address has something locked
+	EscrowByPlayerKeyPrefix = "Escrow/player/"
+	// EscrowInGame marks a game in the index of escrows by player
+	EscrowInGame = "game"
+	// EscrowInMatch marks a match in the index of escrows by player
+	EscrowInMatch = "match"
+)
+
+// EscrowPlayerKey returns the prefix under which all the escrows of an
+// address are indexed
+func EscrowPlayerKey(
+	player string,
+) []byte {
+	var key []byte
+
+	playerBytes := []byte(player)
+	key = append(key, playerBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// EscrowByPlayerKey returns the store key of a game or a match in the index of
+// escrows by player
+func EscrowByPlayerKey(
+	player string,
+	kind string,
+	index string,
+) []byte {
+	var key []byte
+
+	key = append(key, EscrowPlayerKey(player)...)
+	kindBytes := []byte(kind)
+	key = append(key, kindBytes...)
+	key = append(key, []byte("/")...)
+	indexBytes := []byte(index)
+	key = append(key, indexBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
@@ -0,0 +1,23 @@
+package types
+
+import "encoding/binary"
+
+var _ binary.ByteOrder
+
+const (
+	// PlayerBalanceKeyPrefix is the prefix to retrieve all PlayerBalance
+	PlayerBalanceKeyPrefix = "PlayerBalance/value/"
+)
+
+// PlayerBalanceKey returns the store key to retrieve a PlayerBalance from the index fields
+func PlayerBalanceKey(
+	index string,
+) []byte {
+	var key []byte
+
+	indexBytes := []byte(index)
+	key = append(key, indexBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
@@ -0,0 +1,23 @@
+package types
+
+import "encoding/binary"
+
+var _ binary.ByteOrder
+
+const (
+	// PremoveKeyPrefix is the prefix to retrieve all Premove
+	PremoveKeyPrefix = "Premove/value/"
+)
+
+// PremoveKey returns the store key to retrieve a Premove from the index fields
+func PremoveKey(
+	index string,
+) []byte {
+	var key []byte
+
+	indexBytes := []byte(index)
+	key = append(key, indexBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
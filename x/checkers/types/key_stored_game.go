@@ -7,6 +7,8 @@ var _ binary.ByteOrder
 const (
 	// StoredGameKeyPrefix is the prefix to retrieve all StoredGame
 	StoredGameKeyPrefix = "StoredGame/value/"
+	// StoredGameAdjournedKeyPrefix is the prefix to retrieve the adjourned games
+	StoredGameAdjournedKeyPrefix = "StoredGame/adjourned/"
 )
 
 // StoredGameKey returns the store key to retrieve a StoredGame from the index fields
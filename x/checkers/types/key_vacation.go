@@ -0,0 +1,25 @@
+package types
+
+import "encoding/binary"
+
+var _ binary.ByteOrder
+
+const (
+	// VacationKeyPrefix is the prefix to retrieve all Vacation
+	VacationKeyPrefix = "Vacation/value/"
+	// VacationActiveKeyPrefix is the prefix to retrieve the players on vacation
+	VacationActiveKeyPrefix = "Vacation/active/"
+)
+
+// VacationKey returns the store key to retrieve a Vacation from the index fields
+func VacationKey(
+	index string,
+) []byte {
+	var key []byte
+
+	indexBytes := []byte(index)
+	key = append(key, indexBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
@@ -24,7 +24,8 @@ func KeyPrefix(p string) []byte {
 }
 
 const (
-	SystemInfoKey = "SystemInfo-value-"
+	SystemInfoKey    = "SystemInfo-value-"
+	HouseBankrollKey = "HouseBankroll-value-"
 )
 
 const (
@@ -34,7 +35,9 @@ const (
 	GameCreatedEventBlack     = "black"
 	GameCreatedEventRed       = "red"
 	GameCreatedEventWager     = "wager"
-	GameCreatedEventDenom     = "denom"
+	// The wager with IBC vouchers shown by their full denom path.
+	GameCreatedEventWagerTraced = "wager-traced"
+	GameCreatedEventPrize       = "prize"
 )
 
 const (
@@ -45,18 +48,62 @@ const (
 	MovePlayedEventCapturedY = "captured-y"
 	MovePlayedEventWinner    = "winner"
 	MovePlayedEventBoard     = "board"
+	MovePlayedEventMove      = "move"
 )
 
 const (
 	//MaxTurnDuration = time.Duration(24 * 3600 * 1000_000_000)
 	MaxTurnDuration = time.Duration(5 * 60 * 1000_000_000)
 	DeadlineLayout  = "2006-01-02 15:04:05.999999999 +0000 UTC"
+	// Each player can freeze their deadlines for this long per calendar year.
+	VacationBudget = time.Duration(30 * 24 * 3600 * 1000_000_000)
+	// An adjourned game that is not resumed within this time ends, refunded
+	// or drawn.
+	MaxAdjournDuration = time.Duration(14 * 24 * 3600 * 1000_000_000)
+	// The winner of a game that ended in a draw.
+	DrawWinner = "="
+	// The longest match, in games.
+	MaxGamesPerMatch = uint64(25)
+	// The smallest bet, in each of its denoms.
+	MinBetAmount = int64(10)
+	// The most bettors on one game, which bounds the settlement when the game
+	// ends.
+	MaxBettorsPerGame = 100
 )
 
 const (
 	NoFifoIndex = "-1"
 )
 
+const (
+	// Engine searches run inside queries, so they are kept bounded.
+	DefaultSearchDepth = uint64(4)
+	MaxSearchDepth     = uint64(8)
+	SearchNodeBudget   = uint64(200_000)
+	// The house searches within the transaction of the move it answers.
+	HouseSearchDepth = uint64(4)
+	HouseNodeBudget  = uint64(20_000)
+)
+
+const (
+	ColourCommittedEventType      = "colour-committed"
+	ColourCommittedEventPlayer    = "player"
+	ColourCommittedEventGameIndex = "game-index"
+)
+
+const (
+	ColourRevealedEventType      = "colour-revealed"
+	ColourRevealedEventPlayer    = "player"
+	ColourRevealedEventGameIndex = "game-index"
+)
+
+const (
+	ColoursDrawnEventType      = "colours-drawn"
+	ColoursDrawnEventGameIndex = "game-index"
+	ColoursDrawnEventBlack     = "black"
+	ColoursDrawnEventRed       = "red"
+)
+
 const (
 	GameForfeitedEventType      = "game-forfeited"
 	GameForfeitedEventGameIndex = "game-index"
@@ -64,7 +111,207 @@ const (
 	GameForfeitedEventBoard     = "board"
 )
 
+const (
+	PremoveSetEventType      = "premove-set"
+	PremoveSetEventPlayer    = "player"
+	PremoveSetEventGameIndex = "game-index"
+	PremoveSetEventIfMove    = "if-move"
+	PremoveSetEventDeposit   = "deposit"
+)
+
+const (
+	PremovePlayedEventType      = "premove-played"
+	PremovePlayedEventPlayer    = "player"
+	PremovePlayedEventGameIndex = "game-index"
+	PremovePlayedEventGasUsed   = "gas-used"
+	PremovePlayedEventFee       = "fee"
+)
+
+const (
+	PremoveClearedEventType      = "premove-cleared"
+	PremoveClearedEventPlayer    = "player"
+	PremoveClearedEventGameIndex = "game-index"
+)
+
+const (
+	TakebackRequestedEventType      = "takeback-requested"
+	TakebackRequestedEventPlayer    = "player"
+	TakebackRequestedEventGameIndex = "game-index"
+)
+
+const (
+	TakebackAcceptedEventType      = "takeback-accepted"
+	TakebackAcceptedEventPlayer    = "player"
+	TakebackAcceptedEventGameIndex = "game-index"
+	TakebackAcceptedEventBoard     = "board"
+	TakebackAcceptedEventUndone    = "undone"
+)
+
+const (
+	VacationStartedEventType      = "vacation-started"
+	VacationStartedEventPlayer    = "player"
+	VacationStartedEventRemaining = "remaining-seconds"
+)
+
+const (
+	VacationEndedEventType    = "vacation-ended"
+	VacationEndedEventPlayer  = "player"
+	VacationEndedEventUsed    = "used-seconds"
+	VacationEndedEventResumed = "resumed-games"
+)
+
+const (
+	AdjournProposedEventType      = "adjourn-proposed"
+	AdjournProposedEventPlayer    = "player"
+	AdjournProposedEventGameIndex = "game-index"
+)
+
+const (
+	GameAdjournedEventType      = "game-adjourned"
+	GameAdjournedEventGameIndex = "game-index"
+	GameAdjournedEventUntil     = "adjourned-until"
+)
+
+const (
+	GameResumedEventType      = "game-resumed"
+	GameResumedEventPlayer    = "player"
+	GameResumedEventGameIndex = "game-index"
+	GameResumedEventDeadline  = "deadline"
+)
+
+const (
+	AdjournExpiredEventType      = "adjourn-expired"
+	AdjournExpiredEventGameIndex = "game-index"
+	AdjournExpiredEventWinner    = "winner"
+	AdjournExpiredEventBoard     = "board"
+)
+
+const (
+	GameAcceptedEventType      = "game-accepted"
+	GameAcceptedEventPlayer    = "player"
+	GameAcceptedEventGameIndex = "game-index"
+	GameAcceptedEventEscrowed  = "escrowed"
+)
+
+const (
+	WinningsPaidEventType         = "winnings-paid"
+	WinningsPaidEventGameIndex    = "game-index"
+	WinningsPaidEventWinner       = "winner"
+	WinningsPaidEventWinnings     = "winnings"
+	WinningsPaidEventPrize        = "prize"
+	WinningsPaidEventRake         = "rake"
+	WinningsPaidEventOrganizer    = "organizer"
+	WinningsPaidEventOrganizerFee = "organizer-fee"
+)
+
+const (
+	BetPlacedEventType      = "bet-placed"
+	BetPlacedEventBettor    = "bettor"
+	BetPlacedEventGameIndex = "game-index"
+	BetPlacedEventSide      = "side"
+	BetPlacedEventAmount    = "amount"
+)
+
+const (
+	BetsSettledEventType      = "bets-settled"
+	BetsSettledEventGameIndex = "game-index"
+	BetsSettledEventWinner    = "winner"
+	BetsSettledEventBlackPool = "black-pool"
+	BetsSettledEventRedPool   = "red-pool"
+	BetsSettledEventLeftover  = "leftover"
+)
+
+const (
+	BalanceDepositedEventType    = "balance-deposited"
+	BalanceDepositedEventPlayer  = "player"
+	BalanceDepositedEventAmount  = "amount"
+	BalanceDepositedEventBalance = "balance"
+)
+
+const (
+	BalanceWithdrawnEventType    = "balance-withdrawn"
+	BalanceWithdrawnEventPlayer  = "player"
+	BalanceWithdrawnEventAmount  = "amount"
+	BalanceWithdrawnEventBalance = "balance"
+)
+
+const (
+	MatchCreatedEventType          = "match-created"
+	MatchCreatedEventCreator       = "creator"
+	MatchCreatedEventMatchIndex    = "match-index"
+	MatchCreatedEventPlayerA       = "player-a"
+	MatchCreatedEventPlayerB       = "player-b"
+	MatchCreatedEventNumberOfGames = "number-of-games"
+	MatchCreatedEventWager         = "wager"
+)
+
+const (
+	MatchAcceptedEventType       = "match-accepted"
+	MatchAcceptedEventPlayer     = "player"
+	MatchAcceptedEventMatchIndex = "match-index"
+	MatchAcceptedEventEscrowed   = "escrowed"
+)
+
+const (
+	MatchGameEndedEventType       = "match-game-ended"
+	MatchGameEndedEventMatchIndex = "match-index"
+	MatchGameEndedEventGameIndex  = "game-index"
+	MatchGameEndedEventWinner     = "winner"
+	MatchGameEndedEventScoreA     = "score-a"
+	MatchGameEndedEventScoreB     = "score-b"
+	MatchGameEndedEventNextGame   = "next-game-index"
+)
+
+const (
+	MatchEndedEventType       = "match-ended"
+	MatchEndedEventMatchIndex = "match-index"
+	MatchEndedEventWinner     = "winner"
+	MatchEndedEventWinnings   = "winnings"
+	MatchEndedEventRake       = "rake"
+)
+
+const (
+	RematchOfferedEventType      = "rematch-offered"
+	RematchOfferedEventPlayer    = "player"
+	RematchOfferedEventGameIndex = "game-index"
+)
+
+const (
+	RematchAcceptedEventType         = "rematch-accepted"
+	RematchAcceptedEventPlayer       = "player"
+	RematchAcceptedEventGameIndex    = "game-index"
+	RematchAcceptedEventRematchIndex = "rematch-index"
+)
+
+const (
+	HouseBankrollFundedEventType     = "house-bankroll-funded"
+	HouseBankrollFundedEventFunder   = "funder"
+	HouseBankrollFundedEventAmount   = "amount"
+	HouseBankrollFundedEventBankroll = "bankroll"
+)
+
 const (
 	CreateGameGas = 15000
 	PlayMoveGas   = 1000
+	HouseMoveGas  = 20000
+	// Funding the house bankroll is a deposit to the house.
+	FundHouseBankrollGas = 1000
+	ColourDrawGas        = 1000
+	PremoveGas           = 1000
+	TakebackGas          = 1000
+	VacationGas          = 1000
+	AdjournGas           = 1000
+	AcceptGameGas        = 1000
+	PlaceBetGas          = 1000
+	DepositGas           = 1000
+	WithdrawGas          = 1000
+	// A match creates its first game when it starts.
+	CreateMatchGas = 15000
+	AcceptMatchGas = 1000
+	// Accepting a rematch creates the new game.
+	OfferRematchGas  = 1000
+	AcceptRematchGas = 15000
+	// The reply of a premove runs on its own gas meter, in the transaction of
+	// the opponent's move. Its gas is paid from the deposit at this rate.
+	PremoveGasPerToken = 1000
 )
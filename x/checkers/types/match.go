@@ -0,0 +1,89 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/satya/checkers/x/checkers/rules"
+)
+
+func NewMatch(index string, creator string, playerA string, playerB string, numberOfGames uint64, wager sdk.Coins) Match {
+	return Match{
+		Index:         index,
+		Creator:       creator,
+		PlayerA:       playerA,
+		PlayerB:       playerB,
+		NumberOfGames: numberOfGames,
+		Wager:         wager,
+		GameIndices:   []string{},
+	}
+}
+
+func (match Match) IsPlayer(address string) bool {
+	return match.PlayerA == address || match.PlayerB == address
+}
+
+// IsFunded tells whether the games can start, that is whether the stakes, if
+// any, are in escrow.
+func (match Match) IsFunded() bool {
+	return match.Wager.IsZero() || !match.Escrowed.IsZero()
+}
+
+func (match Match) IsOver() bool {
+	return match.Winner != ""
+}
+
+// GetNextColours returns the black and red players of the next game. PlayerA
+// has black in the first game, and the colours swap at each game.
+func (match Match) GetNextColours() (black string, red string) {
+	if len(match.GameIndices)%2 == 0 {
+		return match.PlayerA, match.PlayerB
+	}
+	return match.PlayerB, match.PlayerA
+}
+
+// AddResult scores a game of the match that ended. A dropped game, which has
+// no winner, scores nothing.
+func (match *Match) AddResult(storedGame StoredGame) {
+	switch storedGame.Winner {
+	case DrawWinner:
+		match.ScoreA++
+		match.ScoreB++
+	case rules.PieceStrings[rules.BLACK_PLAYER]:
+		match.addPoint(storedGame.Black)
+	case rules.PieceStrings[rules.RED_PLAYER]:
+		match.addPoint(storedGame.Red)
+	}
+}
+
+func (match *Match) addPoint(winner string) {
+	if winner == match.PlayerA {
+		match.ScoreA += 2
+	} else {
+		match.ScoreB += 2
+	}
+}
+
+// GetResult tells whether the match is decided, and its winner, which is
+// DrawWinner when all games are played and nobody has more than half of the
+// points.
+func (match Match) GetResult() (winner string, decided bool) {
+	// Scores are in half points, so more than half of the points is more
+	// than the number of games.
+	if match.NumberOfGames < match.ScoreA {
+		return match.PlayerA, true
+	}
+	if match.NumberOfGames < match.ScoreB {
+		return match.PlayerB, true
+	}
+	if match.NumberOfGames <= uint64(len(match.GameIndices)) {
+		return DrawWinner, true
+	}
+	return "", false
+}
+
+// GetLoser returns the player who lost a match that was won.
+func (match Match) GetLoser() string {
+	if match.Winner == match.PlayerA {
+		return match.PlayerB
+	}
+	return match.PlayerA
+}
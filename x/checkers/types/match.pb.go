@@ -0,0 +1,883 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: checkers/match.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Match is a series of games between the same two players. Colours alternate,
+// playerA having black in the first game, and each game is created when the
+// previous one ends. A won game scores a point for its winner and a drawn game
+// half a point for each player. The first player to score more than half of
+// the games wins the match. When all games are played without that, the match
+// is drawn.
+type Match struct {
+	Index         string `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+	Creator       string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	PlayerA       string `protobuf:"bytes,3,opt,name=playerA,proto3" json:"playerA,omitempty"`
+	PlayerB       string `protobuf:"bytes,4,opt,name=playerB,proto3" json:"playerB,omitempty"`
+	NumberOfGames uint64 `protobuf:"varint,5,opt,name=numberOfGames,proto3" json:"numberOfGames,omitempty"`
+	// The stake of each player for the whole match.
+	Wager github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=wager,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"wager"`
+	// Both stakes, escrowed once when both players have accepted.
+	Escrowed github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=escrowed,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"escrowed"`
+	// The player who accepted the wager, while waiting for the other.
+	AcceptedBy string `protobuf:"bytes,8,opt,name=acceptedBy,proto3" json:"acceptedBy,omitempty"`
+	// The games of the match so far, the last one being the current one.
+	GameIndices []string `protobuf:"bytes,9,rep,name=gameIndices,proto3" json:"gameIndices,omitempty"`
+	// The scores, in half points.
+	ScoreA uint64 `protobuf:"varint,10,opt,name=scoreA,proto3" json:"scoreA,omitempty"`
+	ScoreB uint64 `protobuf:"varint,11,opt,name=scoreB,proto3" json:"scoreB,omitempty"`
+	// The address of the winner, "=" when drawn, or empty while the match runs.
+	Winner string `protobuf:"bytes,12,opt,name=winner,proto3" json:"winner,omitempty"`
+}
+
+func (m *Match) Reset()         { *m = Match{} }
+func (m *Match) String() string { return proto.CompactTextString(m) }
+func (*Match) ProtoMessage()    {}
+func (*Match) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2ff336f35f212a2f, []int{0}
+}
+func (m *Match) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Match) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Match.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Match) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Match.Merge(m, src)
+}
+func (m *Match) XXX_Size() int {
+	return m.Size()
+}
+func (m *Match) XXX_DiscardUnknown() {
+	xxx_messageInfo_Match.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Match proto.InternalMessageInfo
+
+func (m *Match) GetIndex() string {
+	if m != nil {
+		return m.Index
+	}
+	return ""
+}
+
+func (m *Match) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *Match) GetPlayerA() string {
+	if m != nil {
+		return m.PlayerA
+	}
+	return ""
+}
+
+func (m *Match) GetPlayerB() string {
+	if m != nil {
+		return m.PlayerB
+	}
+	return ""
+}
+
+func (m *Match) GetNumberOfGames() uint64 {
+	if m != nil {
+		return m.NumberOfGames
+	}
+	return 0
+}
+
+func (m *Match) GetWager() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Wager
+	}
+	return nil
+}
+
+func (m *Match) GetEscrowed() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Escrowed
+	}
+	return nil
+}
+
+func (m *Match) GetAcceptedBy() string {
+	if m != nil {
+		return m.AcceptedBy
+	}
+	return ""
+}
+
+func (m *Match) GetGameIndices() []string {
+	if m != nil {
+		return m.GameIndices
+	}
+	return nil
+}
+
+func (m *Match) GetScoreA() uint64 {
+	if m != nil {
+		return m.ScoreA
+	}
+	return 0
+}
+
+func (m *Match) GetScoreB() uint64 {
+	if m != nil {
+		return m.ScoreB
+	}
+	return 0
+}
+
+func (m *Match) GetWinner() string {
+	if m != nil {
+		return m.Winner
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*Match)(nil), "satya.checkers.checkers.Match")
+}
+
+func init() { proto.RegisterFile("checkers/match.proto", fileDescriptor_2ff336f35f212a2f) }
+
+var fileDescriptor_2ff336f35f212a2f = []byte{
+	// 387 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x92, 0xb1, 0xae, 0xd3, 0x30,
+	0x14, 0x86, 0x13, 0x72, 0xd3, 0x7b, 0xeb, 0xc2, 0x62, 0x55, 0x60, 0xee, 0xe0, 0x1b, 0x21, 0x86,
+	0x08, 0x89, 0x98, 0xc2, 0x13, 0x34, 0x20, 0x21, 0x06, 0x84, 0x94, 0x91, 0xcd, 0x71, 0x0e, 0x69,
+	0x54, 0x12, 0x47, 0xb6, 0x4b, 0x9b, 0xb7, 0xe0, 0x39, 0x78, 0x92, 0x8e, 0x1d, 0x99, 0x00, 0xb5,
+	0x03, 0xaf, 0x81, 0xe2, 0xa4, 0x69, 0xd8, 0xef, 0x94, 0xf3, 0x7f, 0xbf, 0x73, 0x8e, 0xfe, 0xa3,
+	0x83, 0xe6, 0x62, 0x05, 0x62, 0x0d, 0x4a, 0xb3, 0x92, 0x1b, 0xb1, 0x8a, 0x6a, 0x25, 0x8d, 0xc4,
+	0x4f, 0x34, 0x37, 0x0d, 0x8f, 0xce, 0xde, 0x50, 0xdc, 0xce, 0x73, 0x99, 0x4b, 0xfb, 0x86, 0xb5,
+	0x55, 0xf7, 0xfc, 0x96, 0x0a, 0xa9, 0x4b, 0xa9, 0x59, 0xca, 0x35, 0xb0, 0x6f, 0x8b, 0x14, 0x0c,
+	0x5f, 0x30, 0x21, 0x8b, 0xaa, 0xf3, 0x9f, 0xfd, 0xf5, 0x90, 0xff, 0xb1, 0x6d, 0x8f, 0xe7, 0xc8,
+	0x2f, 0xaa, 0x0c, 0x76, 0xc4, 0x0d, 0xdc, 0x70, 0x9a, 0x74, 0x02, 0x13, 0x74, 0x2d, 0x14, 0x70,
+	0x23, 0x15, 0x79, 0x60, 0xf9, 0x59, 0xb6, 0x4e, 0xfd, 0x95, 0x37, 0xa0, 0x96, 0xc4, 0xeb, 0x9c,
+	0x5e, 0x5e, 0x9c, 0x98, 0x5c, 0x8d, 0x9d, 0x18, 0x3f, 0x47, 0x8f, 0xaa, 0x4d, 0x99, 0x82, 0xfa,
+	0xf4, 0xe5, 0x3d, 0x2f, 0x41, 0x13, 0x3f, 0x70, 0xc3, 0xab, 0xe4, 0x7f, 0x88, 0x39, 0xf2, 0xb7,
+	0x3c, 0x07, 0x45, 0x26, 0x81, 0x17, 0xce, 0x5e, 0x3f, 0x8d, 0xba, 0x0c, 0x51, 0x9b, 0x21, 0xea,
+	0x33, 0x44, 0x6f, 0x65, 0x51, 0xc5, 0xaf, 0xf6, 0xbf, 0xee, 0x9c, 0x1f, 0xbf, 0xef, 0xc2, 0xbc,
+	0x30, 0xab, 0x4d, 0x1a, 0x09, 0x59, 0xb2, 0x3e, 0x70, 0xf7, 0x79, 0xa9, 0xb3, 0x35, 0x33, 0x4d,
+	0x0d, 0xda, 0xfe, 0xa0, 0x93, 0xae, 0x33, 0xce, 0xd1, 0x0d, 0x68, 0xa1, 0xe4, 0x16, 0x32, 0x72,
+	0x7d, 0xff, 0x53, 0x86, 0xe6, 0x98, 0x22, 0xc4, 0x85, 0x80, 0xda, 0x40, 0x16, 0x37, 0xe4, 0xc6,
+	0xae, 0x63, 0x44, 0x70, 0x80, 0x66, 0x39, 0x2f, 0xe1, 0x43, 0x95, 0x15, 0x02, 0x34, 0x99, 0x06,
+	0x5e, 0x38, 0x4d, 0xc6, 0x08, 0x3f, 0x46, 0x13, 0x2d, 0xa4, 0x82, 0x25, 0x41, 0x76, 0x59, 0xbd,
+	0x1a, 0x78, 0x4c, 0x66, 0x23, 0x1e, 0xb7, 0x7c, 0x5b, 0x54, 0x15, 0x28, 0xf2, 0xd0, 0x4e, 0xeb,
+	0x55, 0xfc, 0x6e, 0x7f, 0xa4, 0xee, 0xe1, 0x48, 0xdd, 0x3f, 0x47, 0xea, 0x7e, 0x3f, 0x51, 0xe7,
+	0x70, 0xa2, 0xce, 0xcf, 0x13, 0x75, 0x3e, 0xbf, 0x18, 0xe5, 0xb2, 0xd7, 0xc5, 0x86, 0xcb, 0xdb,
+	0x5d, 0x4a, 0x9b, 0x2f, 0x9d, 0xd8, 0xb3, 0x79, 0xf3, 0x6f, 0x00, 0x47, 0xac, 0x3a, 0x3c, 0x9d,
+	0x02, 0x00, 0x00,
+}
+
+func (m *Match) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Match) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Match) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Winner) > 0 {
+		i -= len(m.Winner)
+		copy(dAtA[i:], m.Winner)
+		i = encodeVarintMatch(dAtA, i, uint64(len(m.Winner)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.ScoreB != 0 {
+		i = encodeVarintMatch(dAtA, i, uint64(m.ScoreB))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.ScoreA != 0 {
+		i = encodeVarintMatch(dAtA, i, uint64(m.ScoreA))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.GameIndices) > 0 {
+		for iNdEx := len(m.GameIndices) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.GameIndices[iNdEx])
+			copy(dAtA[i:], m.GameIndices[iNdEx])
+			i = encodeVarintMatch(dAtA, i, uint64(len(m.GameIndices[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.AcceptedBy) > 0 {
+		i -= len(m.AcceptedBy)
+		copy(dAtA[i:], m.AcceptedBy)
+		i = encodeVarintMatch(dAtA, i, uint64(len(m.AcceptedBy)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Escrowed) > 0 {
+		for iNdEx := len(m.Escrowed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Escrowed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMatch(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Wager) > 0 {
+		for iNdEx := len(m.Wager) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Wager[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMatch(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.NumberOfGames != 0 {
+		i = encodeVarintMatch(dAtA, i, uint64(m.NumberOfGames))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.PlayerB) > 0 {
+		i -= len(m.PlayerB)
+		copy(dAtA[i:], m.PlayerB)
+		i = encodeVarintMatch(dAtA, i, uint64(len(m.PlayerB)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PlayerA) > 0 {
+		i -= len(m.PlayerA)
+		copy(dAtA[i:], m.PlayerA)
+		i = encodeVarintMatch(dAtA, i, uint64(len(m.PlayerA)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintMatch(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintMatch(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMatch(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMatch(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Match) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovMatch(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovMatch(uint64(l))
+	}
+	l = len(m.PlayerA)
+	if l > 0 {
+		n += 1 + l + sovMatch(uint64(l))
+	}
+	l = len(m.PlayerB)
+	if l > 0 {
+		n += 1 + l + sovMatch(uint64(l))
+	}
+	if m.NumberOfGames != 0 {
+		n += 1 + sovMatch(uint64(m.NumberOfGames))
+	}
+	if len(m.Wager) > 0 {
+		for _, e := range m.Wager {
+			l = e.Size()
+			n += 1 + l + sovMatch(uint64(l))
+		}
+	}
+	if len(m.Escrowed) > 0 {
+		for _, e := range m.Escrowed {
+			l = e.Size()
+			n += 1 + l + sovMatch(uint64(l))
+		}
+	}
+	l = len(m.AcceptedBy)
+	if l > 0 {
+		n += 1 + l + sovMatch(uint64(l))
+	}
+	if len(m.GameIndices) > 0 {
+		for _, s := range m.GameIndices {
+			l = len(s)
+			n += 1 + l + sovMatch(uint64(l))
+		}
+	}
+	if m.ScoreA != 0 {
+		n += 1 + sovMatch(uint64(m.ScoreA))
+	}
+	if m.ScoreB != 0 {
+		n += 1 + sovMatch(uint64(m.ScoreB))
+	}
+	l = len(m.Winner)
+	if l > 0 {
+		n += 1 + l + sovMatch(uint64(l))
+	}
+	return n
+}
+
+func sovMatch(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMatch(x uint64) (n int) {
+	return sovMatch(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Match) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMatch
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Match: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Match: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMatch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMatch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlayerA", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMatch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlayerA = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlayerB", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMatch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlayerB = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumberOfGames", wireType)
+			}
+			m.NumberOfGames = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumberOfGames |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Wager", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMatch
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Wager = append(m.Wager, types.Coin{})
+			if err := m.Wager[len(m.Wager)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Escrowed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMatch
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Escrowed = append(m.Escrowed, types.Coin{})
+			if err := m.Escrowed[len(m.Escrowed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcceptedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMatch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AcceptedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GameIndices", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMatch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GameIndices = append(m.GameIndices, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScoreA", wireType)
+			}
+			m.ScoreA = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScoreA |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScoreB", wireType)
+			}
+			m.ScoreB = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScoreB |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Winner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMatch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Winner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMatch(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMatch
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipMatch(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowMatch
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMatch
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMatch
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthMatch
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupMatch
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthMatch
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthMatch        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowMatch          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupMatch = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,72 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/satya/checkers/x/checkers/types"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	matchA = "cosmos1jmjfq0tplp9tmx4v9uemw72y4d2wa5nr3xn9d3"
+	matchB = "cosmos1xyxs3skf3f4jfqeuv89yyaqvjc6lffavxqhc8g"
+)
+
+func TestMatchColoursAlternate(t *testing.T) {
+	match := types.NewMatch("1", matchA, matchA, matchB, 3, sdk.NewCoins())
+	black, red := match.GetNextColours()
+	require.Equal(t, matchA, black)
+	require.Equal(t, matchB, red)
+	match.GameIndices = append(match.GameIndices, "1")
+	black, red = match.GetNextColours()
+	require.Equal(t, matchB, black)
+	require.Equal(t, matchA, red)
+	match.GameIndices = append(match.GameIndices, "2")
+	black, _ = match.GetNextColours()
+	require.Equal(t, matchA, black)
+}
+
+func TestMatchAddResult(t *testing.T) {
+	match := types.NewMatch("1", matchA, matchA, matchB, 3, sdk.NewCoins())
+	match.AddResult(types.StoredGame{Black: matchB, Red: matchA, Winner: "r"})
+	require.EqualValues(t, 2, match.ScoreA)
+	require.EqualValues(t, 0, match.ScoreB)
+	match.AddResult(types.StoredGame{Black: matchB, Red: matchA, Winner: "b"})
+	require.EqualValues(t, 2, match.ScoreB)
+	match.AddResult(types.StoredGame{Black: matchA, Red: matchB, Winner: types.DrawWinner})
+	require.EqualValues(t, 3, match.ScoreA)
+	require.EqualValues(t, 3, match.ScoreB)
+	match.AddResult(types.StoredGame{Black: matchA, Red: matchB, Winner: "*"})
+	require.EqualValues(t, 3, match.ScoreA)
+	require.EqualValues(t, 3, match.ScoreB)
+}
+
+func TestMatchGetResult(t *testing.T) {
+	for _, tc := range []struct {
+		desc    string
+		scoreA  uint64
+		scoreB  uint64
+		played  int
+		winner  string
+		decided bool
+	}{
+		{desc: "running", scoreA: 2, scoreB: 0, played: 1},
+		{desc: "a has the majority early", scoreA: 4, scoreB: 0, played: 2, winner: matchA, decided: true},
+		{desc: "b has the majority", scoreA: 1, scoreB: 5, played: 3, winner: matchB, decided: true},
+		{desc: "half is not a majority", scoreA: 3, scoreB: 1, played: 2},
+		{desc: "all played without majority", scoreA: 3, scoreB: 3, played: 3, winner: types.DrawWinner, decided: true},
+		{desc: "dropped games count", scoreA: 2, scoreB: 2, played: 3, winner: types.DrawWinner, decided: true},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			match := types.NewMatch("1", matchA, matchA, matchB, 3, sdk.NewCoins())
+			match.ScoreA, match.ScoreB = tc.scoreA, tc.scoreB
+			for i := 0; i < tc.played; i++ {
+				match.GameIndices = append(match.GameIndices, "x")
+			}
+			winner, decided := match.GetResult()
+			require.Equal(t, tc.winner, winner)
+			require.Equal(t, tc.decided, decided)
+		})
+	}
+}
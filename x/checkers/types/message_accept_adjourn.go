@@ -0,0 +1,55 @@
+package types
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgAcceptAdjourn = "accept_adjourn"
+
+var _ sdk.Msg = &MsgAcceptAdjourn{}
+
+func NewMsgAcceptAdjourn(creator string, gameIndex string) *MsgAcceptAdjourn {
+	return &MsgAcceptAdjourn{
+		Creator:   creator,
+		GameIndex: gameIndex,
+	}
+}
+
+func (msg *MsgAcceptAdjourn) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgAcceptAdjourn) Type() string {
+	return TypeMsgAcceptAdjourn
+}
+
+func (msg *MsgAcceptAdjourn) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgAcceptAdjourn) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgAcceptAdjourn) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	gameIndex, err := strconv.ParseInt(msg.GameIndex, 10, 64)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidGameIndex, "not parseable (%s)", err)
+	}
+	if uint64(gameIndex) < DefaultIndex {
+		return sdkerrors.Wrapf(ErrInvalidGameIndex, "number too low (%d)", gameIndex)
+	}
+	return nil
+}
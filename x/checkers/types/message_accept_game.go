@@ -0,0 +1,55 @@
+package types
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgAcceptGame = "accept_game"
+
+var _ sdk.Msg = &MsgAcceptGame{}
+
+func NewMsgAcceptGame(creator string, gameIndex string) *MsgAcceptGame {
+	return &MsgAcceptGame{
+		Creator:   creator,
+		GameIndex: gameIndex,
+	}
+}
+
+func (msg *MsgAcceptGame) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgAcceptGame) Type() string {
+	return TypeMsgAcceptGame
+}
+
+func (msg *MsgAcceptGame) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgAcceptGame) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgAcceptGame) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	gameIndex, err := strconv.ParseInt(msg.GameIndex, 10, 64)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidGameIndex, "not parseable (%s)", err)
+	}
+	if uint64(gameIndex) < DefaultIndex {
+		return sdkerrors.Wrapf(ErrInvalidGameIndex, "number too low (%d)", gameIndex)
+	}
+	return nil
+}
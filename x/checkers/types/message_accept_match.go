@@ -0,0 +1,55 @@
+package types
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgAcceptMatch = "accept_match"
+
+var _ sdk.Msg = &MsgAcceptMatch{}
+
+func NewMsgAcceptMatch(creator string, matchIndex string) *MsgAcceptMatch {
+	return &MsgAcceptMatch{
+		Creator:    creator,
+		MatchIndex: matchIndex,
+	}
+}
+
+func (msg *MsgAcceptMatch) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgAcceptMatch) Type() string {
+	return TypeMsgAcceptMatch
+}
+
+func (msg *MsgAcceptMatch) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgAcceptMatch) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgAcceptMatch) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	matchIndex, err := strconv.ParseInt(msg.MatchIndex, 10, 64)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidMatchIndex, "not parseable (%s)", err)
+	}
+	if uint64(matchIndex) < DefaultIndex {
+		return sdkerrors.Wrapf(ErrInvalidMatchIndex, "number too low (%d)", matchIndex)
+	}
+	return nil
+}
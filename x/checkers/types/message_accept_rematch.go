@@ -0,0 +1,55 @@
+package types
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgAcceptRematch = "accept_rematch"
+
+var _ sdk.Msg = &MsgAcceptRematch{}
+
+func NewMsgAcceptRematch(creator string, gameIndex string) *MsgAcceptRematch {
+	return &MsgAcceptRematch{
+		Creator:   creator,
+		GameIndex: gameIndex,
+	}
+}
+
+func (msg *MsgAcceptRematch) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgAcceptRematch) Type() string {
+	return TypeMsgAcceptRematch
+}
+
+func (msg *MsgAcceptRematch) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgAcceptRematch) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgAcceptRematch) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	gameIndex, err := strconv.ParseInt(msg.GameIndex, 10, 64)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidGameIndex, "not parseable (%s)", err)
+	}
+	if uint64(gameIndex) < DefaultIndex {
+		return sdkerrors.Wrapf(ErrInvalidGameIndex, "number too low (%d)", gameIndex)
+	}
+	return nil
+}
@@ -0,0 +1,61 @@
+package types
+
+import (
+	"encoding/hex"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgCommitColour = "commit_colour"
+
+var _ sdk.Msg = &MsgCommitColour{}
+
+func NewMsgCommitColour(creator string, gameIndex string, commitment string) *MsgCommitColour {
+	return &MsgCommitColour{
+		Creator:    creator,
+		GameIndex:  gameIndex,
+		Commitment: commitment,
+	}
+}
+
+func (msg *MsgCommitColour) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCommitColour) Type() string {
+	return TypeMsgCommitColour
+}
+
+func (msg *MsgCommitColour) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgCommitColour) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgCommitColour) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	gameIndex, err := strconv.ParseInt(msg.GameIndex, 10, 64)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidGameIndex, "not parseable (%s)", err)
+	}
+	if uint64(gameIndex) < DefaultIndex {
+		return sdkerrors.Wrapf(ErrInvalidGameIndex, "number too low (%d)", gameIndex)
+	}
+	commitment, err := hex.DecodeString(msg.Commitment)
+	if err != nil || len(commitment) != CommitmentLength {
+		return sdkerrors.Wrapf(ErrInvalidCommitment, "%s", msg.Commitment)
+	}
+	return nil
+}
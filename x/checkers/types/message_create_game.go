@@ -3,19 +3,20 @@ package types
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/satya/checkers/x/checkers/rules"
 )
 
 const TypeMsgCreateGame = "create_game"
 
 var _ sdk.Msg = &MsgCreateGame{}
 
-func NewMsgCreateGame(creator string, black string, red string, wager uint64, denom string) *MsgCreateGame {
+func NewMsgCreateGame(creator string, black string, red string, wager sdk.Coins, house string) *MsgCreateGame {
 	return &MsgCreateGame{
 		Creator: creator,
 		Black:   black,
 		Red:     red,
 		Wager:   wager,
-		Denom:   denom,
+		House:   house,
 	}
 }
 
@@ -46,15 +47,59 @@ func (msg *MsgCreateGame) ValidateBasic() error {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
 	}
 
-	_, err = sdk.AccAddressFromBech32(msg.Black)
+	if !IsValidHouse(msg.House) {
+		return sdkerrors.Wrapf(ErrInvalidHouse, "%s", msg.House)
+	}
+
+	if msg.House != rules.PieceStrings[rules.BLACK_PLAYER] {
+		_, err = sdk.AccAddressFromBech32(msg.Black)
+		if err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid black address (%s)", err)
+		}
+	}
+
+	if msg.House != rules.PieceStrings[rules.RED_PLAYER] {
+		_, err = sdk.AccAddressFromBech32(msg.Red)
+		if err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid red address (%s)", err)
+		}
+	}
+
+	err = msg.Wager.Validate()
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid wager (%s)", err)
+	}
+
+	err = msg.Prize.Validate()
 	if err != nil {
-		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid black address (%s)", err)
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid prize (%s)", err)
 	}
 
-	_, err = sdk.AccAddressFromBech32(msg.Red)
+	if msg.ColourDraw && msg.House != "" {
+		return sdkerrors.Wrapf(ErrInvalidColourDraw, "%s", "the house cannot draw colours")
+	}
+
+	if msg.ColourDraw && msg.Black == msg.Red {
+		return sdkerrors.Wrapf(ErrInvalidColourDraw, "%s", "players must differ")
+	}
+
+	if msg.Ballot && (msg.Board != "" || msg.Turn != "") {
+		return sdkerrors.Wrapf(ErrInvalidStartPosition, "%s", "a ballot cannot have a board")
+	}
+
+	_, err = msg.GetStartGame()
 	if err != nil {
-		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid red address (%s)", err)
+		return err
 	}
 
 	return nil
 }
+
+// GetStartGame returns the starting position, which is the standard one
+// unless a board is given.
+func (msg *MsgCreateGame) GetStartGame() (*rules.Game, error) {
+	if msg.Board == "" && msg.Turn == "" {
+		return rules.New(), nil
+	}
+	return ParseStartPosition(msg.Board, msg.Turn)
+}
@@ -3,6 +3,7 @@ package types_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/satya/checkers/testutil/sample"
 	"github.com/satya/checkers/x/checkers/types"
@@ -42,6 +43,104 @@ func TestMsgCreateGame_ValidateBasic(t *testing.T) {
 			},
 			err: sdkerrors.ErrInvalidAddress,
 		},
+		{
+			name: "invalid start board",
+			msg: types.MsgCreateGame{
+				Creator: sample.AccAddress(),
+				Black:   sample.AccAddress(),
+				Red:     sample.AccAddress(),
+				Board:   "********|********|********|********|********|********|********|b*r*****",
+				Turn:    "b",
+			},
+			err: types.ErrInvalidStartPosition,
+		},
+		{
+			name: "start board without turn",
+			msg: types.MsgCreateGame{
+				Creator: sample.AccAddress(),
+				Black:   sample.AccAddress(),
+				Red:     sample.AccAddress(),
+				Board:   "*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
+			},
+			err: types.ErrInvalidStartPosition,
+		},
+		{
+			name: "ballot with a start board",
+			msg: types.MsgCreateGame{
+				Creator: sample.AccAddress(),
+				Black:   sample.AccAddress(),
+				Red:     sample.AccAddress(),
+				Board:   "*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
+				Turn:    "b",
+				Ballot:  true,
+			},
+			err: types.ErrInvalidStartPosition,
+		},
+		{
+			name: "colour draw with the house",
+			msg: types.MsgCreateGame{
+				Creator:    sample.AccAddress(),
+				Red:        sample.AccAddress(),
+				House:      "b",
+				ColourDraw: true,
+			},
+			err: types.ErrInvalidColourDraw,
+		},
+		{
+			name: "invalid wager",
+			msg: types.MsgCreateGame{
+				Creator: sample.AccAddress(),
+				Black:   sample.AccAddress(),
+				Red:     sample.AccAddress(),
+				Wager:   sdk.Coins{sdk.NewInt64Coin("token", 100), sdk.NewInt64Coin("stake", 5)},
+			},
+			err: sdkerrors.ErrInvalidCoins,
+		},
+		{
+			name: "invalid prize",
+			msg: types.MsgCreateGame{
+				Creator: sample.AccAddress(),
+				Black:   sample.AccAddress(),
+				Red:     sample.AccAddress(),
+				Prize:   sdk.Coins{sdk.NewInt64Coin("token", 0)},
+			},
+			err: sdkerrors.ErrInvalidCoins,
+		},
+		{
+			name: "valid wager in several denoms",
+			msg: types.MsgCreateGame{
+				Creator: sample.AccAddress(),
+				Black:   sample.AccAddress(),
+				Red:     sample.AccAddress(),
+				Wager:   sdk.NewCoins(sdk.NewInt64Coin("token", 100), sdk.NewInt64Coin("stake", 5)),
+			},
+		},
+		{
+			name: "invalid house",
+			msg: types.MsgCreateGame{
+				Creator: sample.AccAddress(),
+				Black:   sample.AccAddress(),
+				Red:     sample.AccAddress(),
+				House:   "x",
+			},
+			err: types.ErrInvalidHouse,
+		},
+		{
+			name: "house plays black without address",
+			msg: types.MsgCreateGame{
+				Creator: sample.AccAddress(),
+				Red:     sample.AccAddress(),
+				House:   "b",
+			},
+		},
+		{
+			name: "house plays red, black still needed",
+			msg: types.MsgCreateGame{
+				Creator: sample.AccAddress(),
+				House:   "r",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
 
 		{
 			name: "valid addresses",
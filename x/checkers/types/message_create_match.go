@@ -0,0 +1,67 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgCreateMatch = "create_match"
+
+var _ sdk.Msg = &MsgCreateMatch{}
+
+func NewMsgCreateMatch(creator string, playerA string, playerB string, numberOfGames uint64, wager sdk.Coins) *MsgCreateMatch {
+	return &MsgCreateMatch{
+		Creator:       creator,
+		PlayerA:       playerA,
+		PlayerB:       playerB,
+		NumberOfGames: numberOfGames,
+		Wager:         wager,
+	}
+}
+
+func (msg *MsgCreateMatch) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCreateMatch) Type() string {
+	return TypeMsgCreateMatch
+}
+
+func (msg *MsgCreateMatch) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgCreateMatch) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgCreateMatch) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	_, err = sdk.AccAddressFromBech32(msg.PlayerA)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid player a address (%s)", err)
+	}
+	_, err = sdk.AccAddressFromBech32(msg.PlayerB)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid player b address (%s)", err)
+	}
+	if msg.PlayerA == msg.PlayerB {
+		return sdkerrors.Wrapf(ErrMatchAgainstSelf, "%s", msg.PlayerA)
+	}
+	if msg.NumberOfGames < 1 || MaxGamesPerMatch < msg.NumberOfGames {
+		return sdkerrors.Wrapf(ErrInvalidNumberOfGames, "%d", msg.NumberOfGames)
+	}
+	err = msg.Wager.Validate()
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid wager (%s)", err)
+	}
+	return nil
+}
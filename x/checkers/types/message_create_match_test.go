@@ -0,0 +1,112 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/satya/checkers/testutil/sample"
+	"github.com/satya/checkers/x/checkers/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgCreateMatch_ValidateBasic(t *testing.T) {
+	player := sample.AccAddress()
+	tests := []struct {
+		name string
+		msg  types.MsgCreateMatch
+		err  error
+	}{
+		{
+			name: "invalid creator address",
+			msg: types.MsgCreateMatch{
+				Creator:       "invalid_address",
+				PlayerA:       sample.AccAddress(),
+				PlayerB:       sample.AccAddress(),
+				NumberOfGames: 3,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid player a address",
+			msg: types.MsgCreateMatch{
+				Creator:       sample.AccAddress(),
+				PlayerA:       "invalid_address",
+				PlayerB:       sample.AccAddress(),
+				NumberOfGames: 3,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid player b address",
+			msg: types.MsgCreateMatch{
+				Creator:       sample.AccAddress(),
+				PlayerA:       sample.AccAddress(),
+				PlayerB:       "invalid_address",
+				NumberOfGames: 3,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "same players",
+			msg: types.MsgCreateMatch{
+				Creator:       player,
+				PlayerA:       player,
+				PlayerB:       player,
+				NumberOfGames: 3,
+			},
+			err: types.ErrMatchAgainstSelf,
+		},
+		{
+			name: "no games",
+			msg: types.MsgCreateMatch{
+				Creator:       sample.AccAddress(),
+				PlayerA:       sample.AccAddress(),
+				PlayerB:       sample.AccAddress(),
+				NumberOfGames: 0,
+			},
+			err: types.ErrInvalidNumberOfGames,
+		},
+		{
+			name: "too many games",
+			msg: types.MsgCreateMatch{
+				Creator:       sample.AccAddress(),
+				PlayerA:       sample.AccAddress(),
+				PlayerB:       sample.AccAddress(),
+				NumberOfGames: types.MaxGamesPerMatch + 1,
+			},
+			err: types.ErrInvalidNumberOfGames,
+		},
+		{
+			name: "invalid wager",
+			msg: types.MsgCreateMatch{
+				Creator:       sample.AccAddress(),
+				PlayerA:       sample.AccAddress(),
+				PlayerB:       sample.AccAddress(),
+				NumberOfGames: 3,
+				Wager:         sdk.Coins{sdk.Coin{Denom: "stake", Amount: sdk.NewInt(-1)}},
+			},
+			err: sdkerrors.ErrInvalidCoins,
+		},
+		{
+			name: "valid",
+			msg: types.MsgCreateMatch{
+				Creator:       sample.AccAddress(),
+				PlayerA:       sample.AccAddress(),
+				PlayerB:       sample.AccAddress(),
+				NumberOfGames: types.MaxGamesPerMatch,
+				Wager:         sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgDeposit = "deposit"
+
+var _ sdk.Msg = &MsgDeposit{}
+
+func NewMsgDeposit(creator string, amount sdk.Coins) *MsgDeposit {
+	return &MsgDeposit{
+		Creator: creator,
+		Amount:  amount,
+	}
+}
+
+func (msg *MsgDeposit) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgDeposit) Type() string {
+	return TypeMsgDeposit
+}
+
+func (msg *MsgDeposit) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgDeposit) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgDeposit) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	err = msg.Amount.Validate()
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid deposit (%s)", err)
+	}
+	if msg.Amount.IsZero() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid deposit (%s)", "empty")
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgEndVacation = "end_vacation"
+
+var _ sdk.Msg = &MsgEndVacation{}
+
+func NewMsgEndVacation(creator string) *MsgEndVacation {
+	return &MsgEndVacation{
+		Creator: creator,
+	}
+}
+
+func (msg *MsgEndVacation) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgEndVacation) Type() string {
+	return TypeMsgEndVacation
+}
+
+func (msg *MsgEndVacation) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgEndVacation) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgEndVacation) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgFundHouseBankroll = "fund_house_bankroll"
+
+var _ sdk.Msg = &MsgFundHouseBankroll{}
+
+func NewMsgFundHouseBankroll(creator string, amount sdk.Coins) *MsgFundHouseBankroll {
+	return &MsgFundHouseBankroll{
+		Creator: creator,
+		Amount:  amount,
+	}
+}
+
+func (msg *MsgFundHouseBankroll) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgFundHouseBankroll) Type() string {
+	return TypeMsgFundHouseBankroll
+}
+
+func (msg *MsgFundHouseBankroll) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgFundHouseBankroll) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgFundHouseBankroll) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	err = msg.Amount.Validate()
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid funding (%s)", err)
+	}
+	if msg.Amount.IsZero() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid funding (%s)", "empty")
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+package types
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgPlaceBet = "place_bet"
+
+var _ sdk.Msg = &MsgPlaceBet{}
+
+func NewMsgPlaceBet(creator string, gameIndex string, side string, amount sdk.Coins) *MsgPlaceBet {
+	return &MsgPlaceBet{
+		Creator:   creator,
+		GameIndex: gameIndex,
+		Side:      side,
+		Amount:    amount,
+	}
+}
+
+func (msg *MsgPlaceBet) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgPlaceBet) Type() string {
+	return TypeMsgPlaceBet
+}
+
+func (msg *MsgPlaceBet) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgPlaceBet) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgPlaceBet) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	gameIndex, err := strconv.ParseInt(msg.GameIndex, 10, 64)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidGameIndex, "not parseable (%s)", err)
+	}
+	if uint64(gameIndex) < DefaultIndex {
+		return sdkerrors.Wrapf(ErrInvalidGameIndex, "number too low (%d)", gameIndex)
+	}
+	if !IsValidSide(msg.Side) {
+		return sdkerrors.Wrapf(ErrInvalidSide, "%s", msg.Side)
+	}
+	err = msg.Amount.Validate()
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid bet (%s)", err)
+	}
+	if msg.Amount.IsZero() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid bet (%s)", "empty")
+	}
+	for _, coin := range msg.Amount {
+		if coin.Amount.LT(sdk.NewInt(MinBetAmount)) {
+			return sdkerrors.Wrapf(ErrBetTooSmall, "%s", coin)
+		}
+	}
+	return nil
+}
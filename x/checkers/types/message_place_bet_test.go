@@ -0,0 +1,57 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/satya/checkers/testutil/sample"
+	"github.com/satya/checkers/x/checkers/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgPlaceBet_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  types.MsgPlaceBet
+		err  error
+	}{
+		{
+			name: "invalid creator address",
+			msg:  *types.NewMsgPlaceBet("invalid_address", "1", "b", sdk.NewCoins(sdk.NewInt64Coin("stake", 100))),
+			err:  sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid side",
+			msg:  *types.NewMsgPlaceBet(sample.AccAddress(), "1", "*", sdk.NewCoins(sdk.NewInt64Coin("stake", 100))),
+			err:  types.ErrInvalidSide,
+		},
+		{
+			name: "empty bet",
+			msg:  *types.NewMsgPlaceBet(sample.AccAddress(), "1", "b", sdk.NewCoins()),
+			err:  sdkerrors.ErrInvalidCoins,
+		},
+		{
+			name: "bet too small in one denom",
+			msg: *types.NewMsgPlaceBet(sample.AccAddress(), "1", "b", sdk.NewCoins(
+				sdk.NewInt64Coin("stake", 100),
+				sdk.NewInt64Coin("token", types.MinBetAmount-1),
+			)),
+			err: types.ErrBetTooSmall,
+		},
+		{
+			name: "valid",
+			msg:  *types.NewMsgPlaceBet(sample.AccAddress(), "1", "r", sdk.NewCoins(sdk.NewInt64Coin("stake", types.MinBetAmount))),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -23,6 +23,36 @@ func NewMsgPlayMove(creator string, gameIndex string, fromX uint64, fromY uint64
 	}
 }
 
+// NewMsgPlayMovesFromNotation turns a move in numbered squares, such as 11-15
+// or 11x18x25, into one MsgPlayMove per step.
+func NewMsgPlayMovesFromNotation(creator string, gameIndex string, notation string) ([]*MsgPlayMove, error) {
+	path, _, err := rules.ParsePath(notation)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(ErrInvalidMoveNotation, "%s", err)
+	}
+	msgs := make([]*MsgPlayMove, 0, len(path)-1)
+	for i := 1; i < len(path); i++ {
+		msgs = append(msgs, NewMsgPlayMove(
+			creator,
+			gameIndex,
+			uint64(path[i-1].X),
+			uint64(path[i-1].Y),
+			uint64(path[i].X),
+			uint64(path[i].Y),
+		))
+	}
+	return msgs, nil
+}
+
+// SetExpectedMoveCounts guards the steps of one move, sent in a single
+// transaction, with the move count the game has before each of them.
+func SetExpectedMoveCounts(msgs []*MsgPlayMove, moveCount uint64) {
+	for i, msg := range msgs {
+		expected := moveCount + uint64(i)
+		msg.ExpectedMoveCount = &expected
+	}
+}
+
 func (msg *MsgPlayMove) Route() string {
 	return RouterKey
 }
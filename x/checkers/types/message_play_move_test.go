@@ -124,3 +124,33 @@ func TestMsgPlayMove_ValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestNewMsgPlayMovesFromNotation(t *testing.T) {
+	creator := sample.AccAddress()
+	msgs, err := types.NewMsgPlayMovesFromNotation(creator, "5", "11-15")
+	require.NoError(t, err)
+	require.Equal(t, []*types.MsgPlayMove{
+		types.NewMsgPlayMove(creator, "5", 5, 2, 4, 3),
+	}, msgs)
+
+	msgs, err = types.NewMsgPlayMovesFromNotation(creator, "5", "11x18x25")
+	require.NoError(t, err)
+	require.Equal(t, []*types.MsgPlayMove{
+		types.NewMsgPlayMove(creator, "5", 5, 2, 3, 4),
+		types.NewMsgPlayMove(creator, "5", 3, 4, 1, 6),
+	}, msgs)
+}
+
+func TestNewMsgPlayMovesFromNotationInvalid(t *testing.T) {
+	_, err := types.NewMsgPlayMovesFromNotation(sample.AccAddress(), "5", "11-33")
+	require.ErrorIs(t, err, types.ErrInvalidMoveNotation)
+	require.EqualError(t, err, "square out of range: 33: move notation is invalid")
+}
+
+func TestSetExpectedMoveCounts(t *testing.T) {
+	msgs, err := types.NewMsgPlayMovesFromNotation(sample.AccAddress(), "5", "11x18x25")
+	require.NoError(t, err)
+	types.SetExpectedMoveCounts(msgs, 7)
+	require.EqualValues(t, 7, *msgs[0].ExpectedMoveCount)
+	require.EqualValues(t, 8, *msgs[1].ExpectedMoveCount)
+}
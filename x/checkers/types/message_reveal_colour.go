@@ -0,0 +1,56 @@
+package types
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgRevealColour = "reveal_colour"
+
+var _ sdk.Msg = &MsgRevealColour{}
+
+func NewMsgRevealColour(creator string, gameIndex string, secret string) *MsgRevealColour {
+	return &MsgRevealColour{
+		Creator:   creator,
+		GameIndex: gameIndex,
+		Secret:    secret,
+	}
+}
+
+func (msg *MsgRevealColour) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRevealColour) Type() string {
+	return TypeMsgRevealColour
+}
+
+func (msg *MsgRevealColour) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgRevealColour) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgRevealColour) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	gameIndex, err := strconv.ParseInt(msg.GameIndex, 10, 64)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidGameIndex, "not parseable (%s)", err)
+	}
+	if uint64(gameIndex) < DefaultIndex {
+		return sdkerrors.Wrapf(ErrInvalidGameIndex, "number too low (%d)", gameIndex)
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+package types
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/satya/checkers/x/checkers/rules"
+)
+
+const TypeMsgSetPremove = "set_premove"
+
+var _ sdk.Msg = &MsgSetPremove{}
+
+func NewMsgSetPremove(creator string, gameIndex string, ifMove string, reply string, deposit uint64) *MsgSetPremove {
+	return &MsgSetPremove{
+		Creator:   creator,
+		GameIndex: gameIndex,
+		IfMove:    ifMove,
+		Reply:     reply,
+		Deposit:   deposit,
+	}
+}
+
+func (msg *MsgSetPremove) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetPremove) Type() string {
+	return TypeMsgSetPremove
+}
+
+func (msg *MsgSetPremove) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgSetPremove) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// IsClear tells whether the message only clears the creator's premove.
+func (msg *MsgSetPremove) IsClear() bool {
+	return msg.IfMove == "" && msg.Reply == ""
+}
+
+func (msg *MsgSetPremove) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	gameIndex, err := strconv.ParseInt(msg.GameIndex, 10, 64)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidGameIndex, "not parseable (%s)", err)
+	}
+	if uint64(gameIndex) < DefaultIndex {
+		return sdkerrors.Wrapf(ErrInvalidGameIndex, "number too low (%d)", gameIndex)
+	}
+	if msg.IsClear() {
+		if msg.Deposit != 0 {
+			return sdkerrors.Wrapf(ErrInvalidPremove, "no deposit when clearing (%d)", msg.Deposit)
+		}
+		return nil
+	}
+	for _, move := range []string{msg.IfMove, msg.Reply} {
+		if _, _, err := rules.ParsePath(move); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidMoveNotation, "%s", err)
+		}
+	}
+	if msg.Deposit == 0 {
+		return sdkerrors.Wrapf(ErrInvalidPremove, "%s", "a deposit is needed")
+	}
+	return nil
+}
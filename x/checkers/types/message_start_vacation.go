@@ -0,0 +1,45 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgStartVacation = "start_vacation"
+
+var _ sdk.Msg = &MsgStartVacation{}
+
+func NewMsgStartVacation(creator string) *MsgStartVacation {
+	return &MsgStartVacation{
+		Creator: creator,
+	}
+}
+
+func (msg *MsgStartVacation) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgStartVacation) Type() string {
+	return TypeMsgStartVacation
+}
+
+func (msg *MsgStartVacation) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgStartVacation) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgStartVacation) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	return nil
+}
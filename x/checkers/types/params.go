@@ -1,35 +1,105 @@
 package types
 
 import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
 	"gopkg.in/yaml.v2"
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
 
+// IbcDenomPrefix starts the denom of IBC vouchers, which goes on with the hash
+// of their trace.
+const IbcDenomPrefix = transfertypes.DenomPrefix + "/"
+
+const (
+	// BpsDenominator is how many basis points make the whole pot.
+	BpsDenominator = 10_000
+	// MaxPlatformRakeBps caps the platform rake at 10% of the pot.
+	MaxPlatformRakeBps = 1_000
+	// MaxOrganizerFeeBps caps the organizer fee at 10% of the pot.
+	MaxOrganizerFeeBps = 1_000
+	// RakeToFeeCollector sends the platform rake to the fee collector, to be
+	// distributed like transaction fees.
+	RakeToFeeCollector = "fee_collector"
+	// RakeToCommunityPool sends the platform rake to the community pool.
+	RakeToCommunityPool = "community_pool"
+)
+
+var (
+	KeyAllowedDenoms                = []byte("AllowedDenoms")
+	DefaultAllowedDenoms   []string = []string{sdk.DefaultBondDenom}
+	KeyAllowedVouchers              = []byte("AllowedVouchers")
+	DefaultAllowedVouchers []AllowedVoucher
+	KeyPlatformRakeBps            = []byte("PlatformRakeBps")
+	DefaultPlatformRakeBps uint64 = 0
+	KeyRakeDestination            = []byte("RakeDestination")
+	DefaultRakeDestination string = RakeToFeeCollector
+	KeyOrganizerFeeBps            = []byte("OrganizerFeeBps")
+	DefaultOrganizerFeeBps uint64 = 0
+	KeyBetCutoffMove              = []byte("BetCutoffMove")
+	DefaultBetCutoffMove   uint64 = 10
+)
+
 // ParamKeyTable the param key table for launch module
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
 // NewParams creates a new Params instance
-func NewParams() Params {
-	return Params{}
+func NewParams(allowedDenoms []string, allowedVouchers []AllowedVoucher,
+	platformRakeBps uint64, rakeDestination string, organizerFeeBps uint64, betCutoffMove uint64) Params {
+	return Params{
+		AllowedDenoms:   allowedDenoms,
+		AllowedVouchers: allowedVouchers,
+		PlatformRakeBps: platformRakeBps,
+		RakeDestination: rakeDestination,
+		OrganizerFeeBps: organizerFeeBps,
+		BetCutoffMove:   betCutoffMove,
+	}
 }
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return NewParams()
+	return NewParams(DefaultAllowedDenoms, DefaultAllowedVouchers,
+		DefaultPlatformRakeBps, DefaultRakeDestination, DefaultOrganizerFeeBps, DefaultBetCutoffMove)
 }
 
 // ParamSetPairs get the params.ParamSet
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
-	return paramtypes.ParamSetPairs{}
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyAllowedDenoms, &p.AllowedDenoms, validateAllowedDenoms),
+		paramtypes.NewParamSetPair(KeyAllowedVouchers, &p.AllowedVouchers, validateAllowedVouchers),
+		paramtypes.NewParamSetPair(KeyPlatformRakeBps, &p.PlatformRakeBps, validatePlatformRakeBps),
+		paramtypes.NewParamSetPair(KeyRakeDestination, &p.RakeDestination, validateRakeDestination),
+		paramtypes.NewParamSetPair(KeyOrganizerFeeBps, &p.OrganizerFeeBps, validateOrganizerFeeBps),
+		paramtypes.NewParamSetPair(KeyBetCutoffMove, &p.BetCutoffMove, validateBetCutoffMove),
+	}
 }
 
 // Validate validates the set of params
 func (p Params) Validate() error {
-	return nil
+	if err := validateAllowedDenoms(p.AllowedDenoms); err != nil {
+		return err
+	}
+	if err := validateAllowedVouchers(p.AllowedVouchers); err != nil {
+		return err
+	}
+	if err := validatePlatformRakeBps(p.PlatformRakeBps); err != nil {
+		return err
+	}
+	if err := validateRakeDestination(p.RakeDestination); err != nil {
+		return err
+	}
+	if err := validateOrganizerFeeBps(p.OrganizerFeeBps); err != nil {
+		return err
+	}
+	return validateBetCutoffMove(p.BetCutoffMove)
 }
 
 // String implements the Stringer interface.
@@ -37,3 +107,129 @@ func (p Params) String() string {
 	out, _ := yaml.Marshal(p)
 	return string(out)
 }
+
+// IsAllowedTrace tells whether games can be wagered in the denom with this
+// trace. A native denom has an empty path. A voucher must have come straight
+// from its source chain through an allowed channel.
+func (p Params) IsAllowedTrace(trace transfertypes.DenomTrace) bool {
+	if trace.Path == "" {
+		for _, denom := range p.AllowedDenoms {
+			if trace.BaseDenom == denom {
+				return true
+			}
+		}
+		return false
+	}
+	for _, voucher := range p.AllowedVouchers {
+		if trace.BaseDenom == voucher.BaseDenom && trace.Path == voucher.GetPath() {
+			return true
+		}
+	}
+	return false
+}
+
+// GetPath returns the trace path of the vouchers received on the channel.
+func (voucher AllowedVoucher) GetPath() string {
+	return transfertypes.PortID + "/" + voucher.Channel
+}
+
+// validateAllowedDenoms validates the AllowedDenoms param
+func validateAllowedDenoms(v interface{}) error {
+	allowedDenoms, ok := v.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	seen := make(map[string]bool, len(allowedDenoms))
+	for _, denom := range allowedDenoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return err
+		}
+		if strings.HasPrefix(denom, IbcDenomPrefix) {
+			return fmt.Errorf("vouchers are allowed by base denom and channel: %s", denom)
+		}
+		if seen[denom] {
+			return fmt.Errorf("duplicate denom: %s", denom)
+		}
+		seen[denom] = true
+	}
+	return nil
+}
+
+// validateAllowedVouchers validates the AllowedVouchers param
+func validateAllowedVouchers(v interface{}) error {
+	allowedVouchers, ok := v.([]AllowedVoucher)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	seen := make(map[AllowedVoucher]bool, len(allowedVouchers))
+	for _, voucher := range allowedVouchers {
+		trace := transfertypes.DenomTrace{BaseDenom: voucher.BaseDenom, Path: voucher.GetPath()}
+		if err := trace.Validate(); err != nil {
+			return err
+		}
+		if err := host.ChannelIdentifierValidator(voucher.Channel); err != nil {
+			return err
+		}
+		if seen[voucher] {
+			return fmt.Errorf("duplicate voucher: %s", trace.GetFullDenomPath())
+		}
+		seen[voucher] = true
+	}
+	return nil
+}
+
+// validatePlatformRakeBps validates the PlatformRakeBps param
+func validatePlatformRakeBps(v interface{}) error {
+	platformRakeBps, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	if MaxPlatformRakeBps < platformRakeBps {
+		return fmt.Errorf("platform rake is above %d bps: %d", MaxPlatformRakeBps, platformRakeBps)
+	}
+	return nil
+}
+
+// validateRakeDestination validates the RakeDestination param
+func validateRakeDestination(v interface{}) error {
+	rakeDestination, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	if rakeDestination != RakeToFeeCollector && rakeDestination != RakeToCommunityPool {
+		return fmt.Errorf("rake destination is neither %s nor %s: %s", RakeToFeeCollector, RakeToCommunityPool, rakeDestination)
+	}
+	return nil
+}
+
+// validateOrganizerFeeBps validates the OrganizerFeeBps param
+func validateOrganizerFeeBps(v interface{}) error {
+	organizerFeeBps, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	if MaxOrganizerFeeBps < organizerFeeBps {
+		return fmt.Errorf("organizer fee is above %d bps: %d", MaxOrganizerFeeBps, organizerFeeBps)
+	}
+	return nil
+}
+
+// validateBetCutoffMove validates the BetCutoffMove param
+func validateBetCutoffMove(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	return nil
+}
+
+// GetShareOf returns the share of the pot worth bps basis points, rounded
+// down in each denom.
+func GetShareOf(pot sdk.Coins, bps uint64) sdk.Coins {
+	share := sdk.NewCoins()
+	for _, coin := range pot {
+		amount := coin.Amount.Mul(sdk.NewIntFromUint64(bps)).QuoRaw(BpsDenominator)
+		share = share.Add(sdk.NewCoin(coin.Denom, amount))
+	}
+	return share
+}
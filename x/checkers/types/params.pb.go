@@ -25,6 +25,20 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for the module.
 type Params struct {
+	// The native denoms in which games can be wagered.
+	AllowedDenoms []string `protobuf:"bytes,1,rep,name=allowedDenoms,proto3" json:"allowedDenoms,omitempty" yaml:"allowed_denoms"`
+	// The IBC vouchers in which games can be wagered.
+	AllowedVouchers []AllowedVoucher `protobuf:"bytes,2,rep,name=allowedVouchers,proto3" json:"allowedVouchers" yaml:"allowed_vouchers"`
+	// The share of the pot of a won game taken by the platform, in basis points.
+	PlatformRakeBps uint64 `protobuf:"varint,3,opt,name=platformRakeBps,proto3" json:"platformRakeBps,omitempty" yaml:"platform_rake_bps"`
+	// Where the platform rake goes, the fee collector or the community pool.
+	RakeDestination string `protobuf:"bytes,4,opt,name=rakeDestination,proto3" json:"rakeDestination,omitempty" yaml:"rake_destination"`
+	// The share of the pot of a won game given to the creator of the game when
+	// they are not a player, in basis points.
+	OrganizerFeeBps uint64 `protobuf:"varint,5,opt,name=organizerFeeBps,proto3" json:"organizerFeeBps,omitempty" yaml:"organizer_fee_bps"`
+	// Spectators can bet on a game until it reaches this many moves. Zero
+	// closes bets.
+	BetCutoffMove uint64 `protobuf:"varint,6,opt,name=betCutoffMove,proto3" json:"betCutoffMove,omitempty" yaml:"bet_cutoff_move"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -59,23 +73,139 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetAllowedDenoms() []string {
+	if m != nil {
+		return m.AllowedDenoms
+	}
+	return nil
+}
+
+func (m *Params) GetAllowedVouchers() []AllowedVoucher {
+	if m != nil {
+		return m.AllowedVouchers
+	}
+	return nil
+}
+
+func (m *Params) GetPlatformRakeBps() uint64 {
+	if m != nil {
+		return m.PlatformRakeBps
+	}
+	return 0
+}
+
+func (m *Params) GetRakeDestination() string {
+	if m != nil {
+		return m.RakeDestination
+	}
+	return ""
+}
+
+func (m *Params) GetOrganizerFeeBps() uint64 {
+	if m != nil {
+		return m.OrganizerFeeBps
+	}
+	return 0
+}
+
+func (m *Params) GetBetCutoffMove() uint64 {
+	if m != nil {
+		return m.BetCutoffMove
+	}
+	return 0
+}
+
+// AllowedVoucher lets games be wagered in the vouchers of a base denom that
+// came straight from its source chain through a transfer channel.
+type AllowedVoucher struct {
+	BaseDenom string `protobuf:"bytes,1,opt,name=baseDenom,proto3" json:"baseDenom,omitempty" yaml:"base_denom"`
+	Channel   string `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
+}
+
+func (m *AllowedVoucher) Reset()         { *m = AllowedVoucher{} }
+func (m *AllowedVoucher) String() string { return proto.CompactTextString(m) }
+func (*AllowedVoucher) ProtoMessage()    {}
+func (*AllowedVoucher) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ec14988318ba9aaa, []int{1}
+}
+func (m *AllowedVoucher) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AllowedVoucher) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AllowedVoucher.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AllowedVoucher) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AllowedVoucher.Merge(m, src)
+}
+func (m *AllowedVoucher) XXX_Size() int {
+	return m.Size()
+}
+func (m *AllowedVoucher) XXX_DiscardUnknown() {
+	xxx_messageInfo_AllowedVoucher.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AllowedVoucher proto.InternalMessageInfo
+
+func (m *AllowedVoucher) GetBaseDenom() string {
+	if m != nil {
+		return m.BaseDenom
+	}
+	return ""
+}
+
+func (m *AllowedVoucher) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "satya.checkers.checkers.Params")
+	proto.RegisterType((*AllowedVoucher)(nil), "satya.checkers.checkers.AllowedVoucher")
 }
 
 func init() { proto.RegisterFile("checkers/params.proto", fileDescriptor_ec14988318ba9aaa) }
 
 var fileDescriptor_ec14988318ba9aaa = []byte{
-	// 143 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x4d, 0xce, 0x48, 0x4d,
-	0xce, 0x4e, 0x2d, 0x2a, 0xd6, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f,
-	0xc9, 0x17, 0x12, 0x2f, 0x4e, 0x2c, 0xa9, 0x4c, 0xd4, 0x83, 0x49, 0xc2, 0x19, 0x52, 0x22, 0xe9,
-	0xf9, 0xe9, 0xf9, 0x60, 0x35, 0xfa, 0x20, 0x16, 0x44, 0xb9, 0x12, 0x1f, 0x17, 0x5b, 0x00, 0x58,
-	0xbb, 0x15, 0xcb, 0x8c, 0x05, 0xf2, 0x0c, 0x4e, 0x2e, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24,
-	0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78,
-	0x2c, 0xc7, 0x10, 0xa5, 0x95, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0x0f,
-	0xb6, 0x43, 0x1f, 0xee, 0x80, 0x0a, 0x04, 0xb3, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d, 0x6c,
-	0xb8, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0x2f, 0x51, 0x6e, 0x34, 0xa4, 0x00, 0x00, 0x00,
+	// 433 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xbd, 0x8e, 0xd3, 0x40,
+	0x14, 0x85, 0xed, 0x4d, 0x08, 0xca, 0xa0, 0x25, 0x62, 0x44, 0xd8, 0x61, 0x41, 0x9e, 0x68, 0x1a,
+	0x22, 0x0a, 0x47, 0x62, 0xbb, 0x6d, 0x00, 0x13, 0xb6, 0x43, 0x42, 0x2e, 0x28, 0x68, 0xac, 0xb1,
+	0x73, 0xf3, 0xa3, 0xd8, 0x1e, 0x6b, 0x66, 0x12, 0x08, 0x4f, 0x41, 0x49, 0xc9, 0xe3, 0x6c, 0xb9,
+	0x25, 0x05, 0xb2, 0x50, 0xf2, 0x06, 0x7e, 0x02, 0xe4, 0xc9, 0x8f, 0x65, 0x4b, 0xdb, 0x5d, 0xfb,
+	0x7c, 0xe7, 0xcc, 0xb1, 0xef, 0xa0, 0x7e, 0x34, 0x87, 0x68, 0x09, 0x52, 0x8d, 0x32, 0x2e, 0x79,
+	0xa2, 0xdc, 0x4c, 0x0a, 0x2d, 0xf0, 0x85, 0xe2, 0x7a, 0xc3, 0xdd, 0xa3, 0x78, 0x1a, 0x2e, 0x9f,
+	0xce, 0xc4, 0x4c, 0x18, 0x66, 0x54, 0x4e, 0x7b, 0x9c, 0xfd, 0x6d, 0xa1, 0xce, 0x67, 0xe3, 0xc7,
+	0x6f, 0xd1, 0x39, 0x8f, 0x63, 0xf1, 0x0d, 0x26, 0x63, 0x48, 0x45, 0xa2, 0x88, 0x3d, 0x68, 0x0d,
+	0xbb, 0xde, 0xf3, 0x22, 0xa7, 0xfd, 0x0d, 0x4f, 0xe2, 0x6b, 0x76, 0x90, 0x83, 0x89, 0xd1, 0x99,
+	0x5f, 0xe7, 0xb1, 0x44, 0xbd, 0xc3, 0x8b, 0x2f, 0x62, 0x15, 0xcd, 0x41, 0x2a, 0x72, 0x36, 0x68,
+	0x0d, 0x1f, 0xbd, 0x79, 0xe5, 0xde, 0x53, 0xca, 0x7d, 0x5f, 0xe3, 0x3d, 0x7a, 0x9b, 0x53, 0xab,
+	0xc8, 0xe9, 0x45, 0xfd, 0xbc, 0xf5, 0x21, 0x8e, 0xf9, 0xcd, 0x03, 0xf0, 0x0d, 0xea, 0x65, 0x31,
+	0xd7, 0x53, 0x21, 0x13, 0x9f, 0x2f, 0xc1, 0xcb, 0x14, 0x69, 0x0d, 0xec, 0x61, 0xdb, 0x7b, 0x59,
+	0xe4, 0x94, 0xec, 0x63, 0x8e, 0x40, 0x20, 0xf9, 0x12, 0x82, 0x30, 0x2b, 0x73, 0x1a, 0x26, 0xfc,
+	0x11, 0xf5, 0x4a, 0x75, 0x0c, 0x4a, 0x2f, 0x52, 0xae, 0x17, 0x22, 0x25, 0xed, 0x81, 0x3d, 0xec,
+	0x7a, 0x2f, 0xaa, 0x3a, 0xc6, 0x3e, 0xa9, 0x08, 0xe6, 0x37, 0x3d, 0x65, 0x1d, 0x21, 0x67, 0x3c,
+	0x5d, 0xfc, 0x00, 0x79, 0x03, 0xa6, 0xce, 0x83, 0x66, 0x9d, 0x13, 0x10, 0x4c, 0xe1, 0x58, 0xa7,
+	0x61, 0xc2, 0xef, 0xd0, 0x79, 0x08, 0xfa, 0xc3, 0x4a, 0x8b, 0xe9, 0xf4, 0x93, 0x58, 0x03, 0xe9,
+	0x98, 0x94, 0xcb, 0x22, 0xa7, 0xcf, 0xf6, 0x29, 0x21, 0xe8, 0x20, 0x32, 0x7a, 0x90, 0x88, 0x35,
+	0x30, 0xbf, 0x6e, 0xb8, 0x6e, 0xff, 0xfa, 0x4d, 0x2d, 0x16, 0xa0, 0xc7, 0xf5, 0x5f, 0x8c, 0xaf,
+	0x50, 0x37, 0xe4, 0x0a, 0xcc, 0xca, 0x88, 0x6d, 0x3e, 0xb1, 0x5f, 0xe4, 0xf4, 0xc9, 0x21, 0x95,
+	0x2b, 0xd8, 0xaf, 0x97, 0xf9, 0x15, 0x87, 0x09, 0x7a, 0x18, 0xcd, 0x79, 0x9a, 0x42, 0x4c, 0xce,
+	0x4a, 0x8b, 0x7f, 0x7c, 0xf4, 0xc6, 0xb7, 0x5b, 0xc7, 0xbe, 0xdb, 0x3a, 0xf6, 0xbf, 0xad, 0x63,
+	0xff, 0xdc, 0x39, 0xd6, 0xdd, 0xce, 0xb1, 0xfe, 0xec, 0x1c, 0xeb, 0xeb, 0xeb, 0xd9, 0x42, 0xcf,
+	0x57, 0xa1, 0x1b, 0x89, 0x64, 0x64, 0xd6, 0x3f, 0x3a, 0x5d, 0xd8, 0xef, 0xd5, 0xa8, 0x37, 0x19,
+	0xa8, 0xb0, 0x63, 0x2e, 0xe3, 0xd5, 0xff, 0x01, 0x00, 0x29, 0x4e, 0x11, 0xfd, 0xd4, 0x02, 0x00,
+	0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -98,6 +228,88 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.BetCutoffMove != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.BetCutoffMove))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.OrganizerFeeBps != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.OrganizerFeeBps))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.RakeDestination) > 0 {
+		i -= len(m.RakeDestination)
+		copy(dAtA[i:], m.RakeDestination)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.RakeDestination)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.PlatformRakeBps != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.PlatformRakeBps))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.AllowedVouchers) > 0 {
+		for iNdEx := len(m.AllowedVouchers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AllowedVouchers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.AllowedDenoms) > 0 {
+		for iNdEx := len(m.AllowedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedDenoms[iNdEx])
+			copy(dAtA[i:], m.AllowedDenoms[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.AllowedDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AllowedVoucher) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AllowedVoucher) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AllowedVoucher) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -118,6 +330,48 @@ func (m *Params) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if len(m.AllowedDenoms) > 0 {
+		for _, s := range m.AllowedDenoms {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.AllowedVouchers) > 0 {
+		for _, e := range m.AllowedVouchers {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.PlatformRakeBps != 0 {
+		n += 1 + sovParams(uint64(m.PlatformRakeBps))
+	}
+	l = len(m.RakeDestination)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.OrganizerFeeBps != 0 {
+		n += 1 + sovParams(uint64(m.OrganizerFeeBps))
+	}
+	if m.BetCutoffMove != 0 {
+		n += 1 + sovParams(uint64(m.BetCutoffMove))
+	}
+	return n
+}
+
+func (m *AllowedVoucher) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
 	return n
 }
 
@@ -156,6 +410,275 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedDenoms = append(m.AllowedDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedVouchers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedVouchers = append(m.AllowedVouchers, AllowedVoucher{})
+			if err := m.AllowedVouchers[len(m.AllowedVouchers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlatformRakeBps", wireType)
+			}
+			m.PlatformRakeBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PlatformRakeBps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RakeDestination", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RakeDestination = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrganizerFeeBps", wireType)
+			}
+			m.OrganizerFeeBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrganizerFeeBps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BetCutoffMove", wireType)
+			}
+			m.BetCutoffMove = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BetCutoffMove |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AllowedVoucher) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllowedVoucher: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllowedVoucher: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
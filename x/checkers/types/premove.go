@@ -0,0 +1,93 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/satya/checkers/x/checkers/rules"
+)
+
+func NewPremove(index string, player string, moveCount uint64, ifMove string, reply string, deposit uint64) Premove {
+	return Premove{
+		Index:     index,
+		Player:    player,
+		MoveCount: moveCount,
+		IfMove:    ifMove,
+		Reply:     reply,
+		Deposit:   deposit,
+	}
+}
+
+// FormatSteps writes the steps of one move as a single path, such as
+// "11x18x25".
+func FormatSteps(steps []rules.Step) (string, error) {
+	path := make([]rules.Pos, 0, len(steps)+1)
+	for i, step := range steps {
+		if i == 0 {
+			path = append(path, step.Src)
+		}
+		path = append(path, step.Dst)
+	}
+	return rules.FormatPath(path)
+}
+
+// GetIfSteps returns the opponent's expected move as the single steps that
+// the move history records.
+func (premove Premove) GetIfSteps() ([]string, error) {
+	path, _, err := rules.ParsePath(premove.IfMove)
+	if err != nil {
+		return nil, err
+	}
+	steps := make([]string, 0, len(path)-1)
+	for i := 1; i < len(path); i++ {
+		step, err := rules.FormatStep(rules.Step{Src: path[i-1], Dst: path[i]})
+		if err != nil {
+			return nil, err
+		}
+		steps = append(steps, step)
+	}
+	return steps, nil
+}
+
+// Matches tells whether the steps played since the premove was set are the
+// start of the expected move, and whether they are all of it.
+func (premove Premove) Matches(played []string) (prefix bool, whole bool, err error) {
+	expected, err := premove.GetIfSteps()
+	if err != nil {
+		return false, false, err
+	}
+	if len(expected) < len(played) {
+		return false, false, nil
+	}
+	for i, step := range played {
+		if expected[i] != step {
+			return false, false, nil
+		}
+	}
+	return true, len(played) == len(expected), nil
+}
+
+// Premove deposits pay for gas, so they are in the staking denom, whatever the
+// wager of the game.
+const PremoveDenom = sdk.DefaultBondDenom
+
+// PremoveCoins is an amount of deposit in the premove denom.
+func PremoveCoins(amount uint64) sdk.Coins {
+	return sdk.NewCoins(sdk.NewCoin(PremoveDenom, sdk.NewIntFromUint64(amount)))
+}
+
+// GetFee converts the gas used by the reply into the part of the deposit it
+// costs, rounded up.
+func (premove Premove) GetFee(gasUsed sdk.Gas) uint64 {
+	fee := gasUsed / PremoveGasPerToken
+	if gasUsed%PremoveGasPerToken != 0 {
+		fee++
+	}
+	if premove.Deposit < fee {
+		return premove.Deposit
+	}
+	return fee
+}
+
+// GetGasLimit is the gas the deposit can pay for.
+func (premove Premove) GetGasLimit() sdk.Gas {
+	return premove.Deposit * PremoveGasPerToken
+}
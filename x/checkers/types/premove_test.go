@@ -0,0 +1,42 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/satya/checkers/x/checkers/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPremoveMatches(t *testing.T) {
+	premove := types.NewPremove("1", "", 4, "22x15x6", "1x10", 10)
+	steps, err := premove.GetIfSteps()
+	require.Nil(t, err)
+	require.EqualValues(t, []string{"22x15", "15x6"}, steps)
+
+	for _, situation := range []struct {
+		played []string
+		prefix bool
+		whole  bool
+	}{
+		{played: []string{}, prefix: true, whole: false},
+		{played: []string{"22x15"}, prefix: true, whole: false},
+		{played: []string{"22x15", "15x6"}, prefix: true, whole: true},
+		{played: []string{"22x15", "15x8"}, prefix: false, whole: false},
+		{played: []string{"22-18"}, prefix: false, whole: false},
+		{played: []string{"22x15", "15x6", "6x13"}, prefix: false, whole: false},
+	} {
+		prefix, whole, err := premove.Matches(situation.played)
+		require.Nil(t, err)
+		require.EqualValues(t, situation.prefix, prefix, situation.played)
+		require.EqualValues(t, situation.whole, whole, situation.played)
+	}
+}
+
+func TestPremoveFeeIsRoundedUpAndCapped(t *testing.T) {
+	premove := types.NewPremove("1", "", 0, "11-15", "22-18", 10)
+	require.EqualValues(t, 10*types.PremoveGasPerToken, premove.GetGasLimit())
+	require.EqualValues(t, 0, premove.GetFee(0))
+	require.EqualValues(t, 1, premove.GetFee(1))
+	require.EqualValues(t, 3, premove.GetFee(2*types.PremoveGasPerToken+1))
+	require.EqualValues(t, 10, premove.GetFee(20*types.PremoveGasPerToken))
+}
@@ -5,6 +5,9 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
 	math "math"
@@ -23,18 +26,63 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type StoredGame struct {
-	Index       string `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
-	Board       string `protobuf:"bytes,2,opt,name=board,proto3" json:"board,omitempty"`
-	Turn        string `protobuf:"bytes,3,opt,name=turn,proto3" json:"turn,omitempty"`
-	Black       string `protobuf:"bytes,4,opt,name=black,proto3" json:"black,omitempty"`
-	Red         string `protobuf:"bytes,5,opt,name=red,proto3" json:"red,omitempty"`
-	Winner      string `protobuf:"bytes,6,opt,name=winner,proto3" json:"winner,omitempty"`
-	Deadline    string `protobuf:"bytes,7,opt,name=deadline,proto3" json:"deadline,omitempty"`
-	MoveCount   uint64 `protobuf:"varint,8,opt,name=moveCount,proto3" json:"moveCount,omitempty"`
-	BeforeIndex string `protobuf:"bytes,9,opt,name=beforeIndex,proto3" json:"beforeIndex,omitempty"`
-	AfterIndex  string `protobuf:"bytes,10,opt,name=afterIndex,proto3" json:"afterIndex,omitempty"`
-	Wager       uint64 `protobuf:"varint,11,opt,name=wager,proto3" json:"wager,omitempty"`
-	Denom       string `protobuf:"bytes,12,opt,name=denom,proto3" json:"denom,omitempty"`
+	Index       string   `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+	Board       string   `protobuf:"bytes,2,opt,name=board,proto3" json:"board,omitempty"`
+	Turn        string   `protobuf:"bytes,3,opt,name=turn,proto3" json:"turn,omitempty"`
+	Black       string   `protobuf:"bytes,4,opt,name=black,proto3" json:"black,omitempty"`
+	Red         string   `protobuf:"bytes,5,opt,name=red,proto3" json:"red,omitempty"`
+	Winner      string   `protobuf:"bytes,6,opt,name=winner,proto3" json:"winner,omitempty"`
+	Deadline    string   `protobuf:"bytes,7,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	MoveCount   uint64   `protobuf:"varint,8,opt,name=moveCount,proto3" json:"moveCount,omitempty"`
+	BeforeIndex string   `protobuf:"bytes,9,opt,name=beforeIndex,proto3" json:"beforeIndex,omitempty"`
+	AfterIndex  string   `protobuf:"bytes,10,opt,name=afterIndex,proto3" json:"afterIndex,omitempty"`
+	MoveHistory []string `protobuf:"bytes,13,rep,name=moveHistory,proto3" json:"moveHistory,omitempty"`
+	House       string   `protobuf:"bytes,14,opt,name=house,proto3" json:"house,omitempty"`
+	// Empty when the game starts from the standard position.
+	StartBoard string `protobuf:"bytes,15,opt,name=startBoard,proto3" json:"startBoard,omitempty"`
+	StartTurn  string `protobuf:"bytes,16,opt,name=startTurn,proto3" json:"startTurn,omitempty"`
+	// Unrated games are left out of the leaderboard. The games of a match are
+	// unrated, as the match itself is rated.
+	Unrated bool `protobuf:"varint,17,opt,name=unrated,proto3" json:"unrated,omitempty"`
+	// The ballot opening that was played before the start position, if any.
+	Ballot string `protobuf:"bytes,18,opt,name=ballot,proto3" json:"ballot,omitempty"`
+	// The player who asked to take back their last move, until the opponent
+	// accepts or a move is played.
+	TakebackRequester string `protobuf:"bytes,19,opt,name=takebackRequester,proto3" json:"takebackRequester,omitempty"`
+	// The time left to the deadline, as a duration, while the game is frozen
+	// because the player to move is on vacation. Empty otherwise.
+	FrozenRemaining string `protobuf:"bytes,20,opt,name=frozenRemaining,proto3" json:"frozenRemaining,omitempty"`
+	// The player who proposed to adjourn the game, until the opponent accepts
+	// or a move is played.
+	AdjournProposer string `protobuf:"bytes,21,opt,name=adjournProposer,proto3" json:"adjournProposer,omitempty"`
+	// While the game is adjourned, when the adjournment runs out. Empty
+	// otherwise.
+	AdjournedUntil string `protobuf:"bytes,22,opt,name=adjournedUntil,proto3" json:"adjournedUntil,omitempty"`
+	// The player who accepted the wager first, until the other one accepts.
+	AcceptedBy string `protobuf:"bytes,24,opt,name=acceptedBy,proto3" json:"acceptedBy,omitempty"`
+	// The stake of each player, possibly in several denoms.
+	Wager github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,25,rep,name=wager,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"wager"`
+	// The stakes of both players held in escrow for the game. Play starts once
+	// they are in, and they are paid out when the game ends.
+	Escrowed github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,26,rep,name=escrowed,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"escrowed"`
+	// The creator of a wagered game when they are not a player. They take the
+	// organizer fee from the pot when the game is won.
+	Organizer string `protobuf:"bytes,27,opt,name=organizer,proto3" json:"organizer,omitempty"`
+	// The creator of the game when they put up a prize.
+	Sponsor string `protobuf:"bytes,28,opt,name=sponsor,proto3" json:"sponsor,omitempty"`
+	// The prize put up by the sponsor, held in escrow from creation until the
+	// game ends. It goes to the winner, is split on a draw, and returns to the
+	// sponsor when the game is dropped.
+	Prize github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,29,rep,name=prize,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"prize"`
+	// The match the game is part of, if any.
+	MatchIndex string `protobuf:"bytes,30,opt,name=matchIndex,proto3" json:"matchIndex,omitempty"`
+	// The player who offered a rematch once the game ended, until the opponent
+	// accepts.
+	RematchProposer string `protobuf:"bytes,31,opt,name=rematchProposer,proto3" json:"rematchProposer,omitempty"`
+	// The game this one is a rematch of, if any.
+	PreviousGameIndex string `protobuf:"bytes,32,opt,name=previousGameIndex,proto3" json:"previousGameIndex,omitempty"`
+	// The rematch of this game, once accepted.
+	RematchIndex string `protobuf:"bytes,33,opt,name=rematchIndex,proto3" json:"rematchIndex,omitempty"`
 }
 
 func (m *StoredGame) Reset()         { *m = StoredGame{} }
@@ -140,16 +188,142 @@ func (m *StoredGame) GetAfterIndex() string {
 	return ""
 }
 
-func (m *StoredGame) GetWager() uint64 {
+func (m *StoredGame) GetMoveHistory() []string {
+	if m != nil {
+		return m.MoveHistory
+	}
+	return nil
+}
+
+func (m *StoredGame) GetHouse() string {
+	if m != nil {
+		return m.House
+	}
+	return ""
+}
+
+func (m *StoredGame) GetStartBoard() string {
+	if m != nil {
+		return m.StartBoard
+	}
+	return ""
+}
+
+func (m *StoredGame) GetStartTurn() string {
+	if m != nil {
+		return m.StartTurn
+	}
+	return ""
+}
+
+func (m *StoredGame) GetUnrated() bool {
+	if m != nil {
+		return m.Unrated
+	}
+	return false
+}
+
+func (m *StoredGame) GetBallot() string {
+	if m != nil {
+		return m.Ballot
+	}
+	return ""
+}
+
+func (m *StoredGame) GetTakebackRequester() string {
+	if m != nil {
+		return m.TakebackRequester
+	}
+	return ""
+}
+
+func (m *StoredGame) GetFrozenRemaining() string {
+	if m != nil {
+		return m.FrozenRemaining
+	}
+	return ""
+}
+
+func (m *StoredGame) GetAdjournProposer() string {
+	if m != nil {
+		return m.AdjournProposer
+	}
+	return ""
+}
+
+func (m *StoredGame) GetAdjournedUntil() string {
+	if m != nil {
+		return m.AdjournedUntil
+	}
+	return ""
+}
+
+func (m *StoredGame) GetAcceptedBy() string {
+	if m != nil {
+		return m.AcceptedBy
+	}
+	return ""
+}
+
+func (m *StoredGame) GetWager() github_com_cosmos_cosmos_sdk_types.Coins {
 	if m != nil {
 		return m.Wager
 	}
-	return 0
+	return nil
+}
+
+func (m *StoredGame) GetEscrowed() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Escrowed
+	}
+	return nil
+}
+
+func (m *StoredGame) GetOrganizer() string {
+	if m != nil {
+		return m.Organizer
+	}
+	return ""
+}
+
+func (m *StoredGame) GetSponsor() string {
+	if m != nil {
+		return m.Sponsor
+	}
+	return ""
+}
+
+func (m *StoredGame) GetPrize() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Prize
+	}
+	return nil
+}
+
+func (m *StoredGame) GetMatchIndex() string {
+	if m != nil {
+		return m.MatchIndex
+	}
+	return ""
+}
+
+func (m *StoredGame) GetRematchProposer() string {
+	if m != nil {
+		return m.RematchProposer
+	}
+	return ""
+}
+
+func (m *StoredGame) GetPreviousGameIndex() string {
+	if m != nil {
+		return m.PreviousGameIndex
+	}
+	return ""
 }
 
-func (m *StoredGame) GetDenom() string {
+func (m *StoredGame) GetRematchIndex() string {
 	if m != nil {
-		return m.Denom
+		return m.RematchIndex
 	}
 	return ""
 }
@@ -161,26 +335,49 @@ func init() {
 func init() { proto.RegisterFile("checkers/stored_game.proto", fileDescriptor_8439c9c90688ff75) }
 
 var fileDescriptor_8439c9c90688ff75 = []byte{
-	// 303 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x91, 0x3f, 0x4e, 0xc3, 0x30,
-	0x14, 0xc6, 0xeb, 0xfe, 0xa3, 0x7d, 0x65, 0x40, 0x16, 0x02, 0xab, 0x42, 0x56, 0xc5, 0x54, 0x31,
-	0xb4, 0x03, 0x37, 0x00, 0x24, 0xc4, 0x5a, 0x36, 0x16, 0xe4, 0xc4, 0xaf, 0x69, 0xd4, 0xc6, 0xae,
-	0x1c, 0x87, 0xb6, 0xb7, 0xe0, 0x30, 0x1c, 0x82, 0xb1, 0x23, 0x23, 0x4a, 0x2e, 0x82, 0xf2, 0x52,
-	0xd2, 0x6e, 0xdf, 0xef, 0x97, 0x2f, 0xd6, 0xb3, 0x1f, 0x0c, 0xc3, 0x05, 0x86, 0x4b, 0x74, 0xe9,
-	0x34, 0xf5, 0xd6, 0xa1, 0x7e, 0x8f, 0x54, 0x82, 0x93, 0xb5, 0xb3, 0xde, 0xf2, 0xeb, 0x54, 0xf9,
-	0x9d, 0x9a, 0xfc, 0x37, 0xea, 0x70, 0xfb, 0xd5, 0x04, 0x78, 0xa5, 0xfa, 0xb3, 0x4a, 0x90, 0x5f,
-	0x42, 0x27, 0x36, 0x1a, 0xb7, 0x82, 0x8d, 0xd8, 0xb8, 0x3f, 0xab, 0xa0, 0xb4, 0x81, 0x55, 0x4e,
-	0x8b, 0x66, 0x65, 0x09, 0x38, 0x87, 0xb6, 0xcf, 0x9c, 0x11, 0x2d, 0x92, 0x94, 0xa9, 0xb9, 0x52,
-	0xe1, 0x52, 0xb4, 0x0f, 0xcd, 0x12, 0xf8, 0x05, 0xb4, 0x1c, 0x6a, 0xd1, 0x21, 0x57, 0x46, 0x7e,
-	0x05, 0xdd, 0x4d, 0x6c, 0x0c, 0x3a, 0xd1, 0x25, 0x79, 0x20, 0x3e, 0x84, 0x9e, 0x46, 0xa5, 0x57,
-	0xb1, 0x41, 0x71, 0x46, 0x5f, 0x6a, 0xe6, 0x37, 0xd0, 0x4f, 0xec, 0x07, 0x3e, 0xda, 0xcc, 0x78,
-	0xd1, 0x1b, 0xb1, 0x71, 0x7b, 0x76, 0x14, 0x7c, 0x04, 0x83, 0x00, 0xe7, 0xd6, 0xe1, 0x0b, 0xcd,
-	0xdf, 0xa7, 0x9f, 0x4f, 0x15, 0x97, 0x00, 0x6a, 0xee, 0xd1, 0x55, 0x05, 0xa0, 0xc2, 0x89, 0x29,
-	0x67, 0xdf, 0xa8, 0x08, 0x9d, 0x18, 0xd0, 0xd9, 0x15, 0x94, 0x56, 0xa3, 0xb1, 0x89, 0x38, 0xaf,
-	0x6e, 0x44, 0xf0, 0xf0, 0xf4, 0x9d, 0x4b, 0xb6, 0xcf, 0x25, 0xfb, 0xcd, 0x25, 0xfb, 0x2c, 0x64,
-	0x63, 0x5f, 0xc8, 0xc6, 0x4f, 0x21, 0x1b, 0x6f, 0x77, 0x51, 0xec, 0x17, 0x59, 0x30, 0x09, 0x6d,
-	0x32, 0xa5, 0x47, 0x9f, 0xd6, 0x6b, 0xd9, 0x1e, 0xa3, 0xdf, 0xad, 0x31, 0x0d, 0xba, 0xb4, 0x9c,
-	0xfb, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x8e, 0x02, 0x63, 0xa4, 0xba, 0x01, 0x00, 0x00,
+	// 668 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x94, 0xcf, 0x72, 0xd3, 0x3a,
+	0x14, 0xc6, 0x93, 0x9b, 0xa4, 0x4d, 0xd4, 0x7f, 0xa9, 0x6e, 0x6f, 0xab, 0xe6, 0x16, 0x37, 0x74,
+	0xc1, 0x64, 0x18, 0x88, 0x29, 0xbc, 0x41, 0xca, 0x0c, 0xd0, 0x15, 0x13, 0x60, 0xc3, 0x86, 0x91,
+	0xed, 0x53, 0xc7, 0x24, 0x96, 0x8c, 0x24, 0xb7, 0x4d, 0x9f, 0x82, 0x15, 0x0f, 0xc1, 0x93, 0x74,
+	0xd9, 0x25, 0x2b, 0x60, 0xda, 0x17, 0x61, 0x74, 0xe4, 0x3a, 0x21, 0xdd, 0xc2, 0xca, 0xe7, 0xfb,
+	0x9d, 0xcf, 0xc7, 0xd6, 0x39, 0x67, 0x44, 0x3a, 0xe1, 0x08, 0xc2, 0x31, 0x28, 0xed, 0x6b, 0x23,
+	0x15, 0x44, 0x1f, 0x62, 0x9e, 0x42, 0x3f, 0x53, 0xd2, 0x48, 0xba, 0xa3, 0xb9, 0x99, 0xf2, 0xfe,
+	0xad, 0xa3, 0x0c, 0x3a, 0x5b, 0xb1, 0x8c, 0x25, 0x7a, 0x7c, 0x1b, 0x39, 0x7b, 0xc7, 0x0b, 0xa5,
+	0x4e, 0xa5, 0xf6, 0x03, 0xae, 0xc1, 0x3f, 0x3d, 0x0c, 0xc0, 0xf0, 0x43, 0x3f, 0x94, 0x89, 0x70,
+	0xf9, 0x83, 0x2f, 0x2d, 0x42, 0xde, 0xe0, 0x47, 0x5e, 0xf0, 0x14, 0xe8, 0x16, 0x69, 0x24, 0x22,
+	0x82, 0x73, 0x56, 0xed, 0x56, 0x7b, 0xad, 0xa1, 0x13, 0x96, 0x06, 0x92, 0xab, 0x88, 0xfd, 0xe3,
+	0x28, 0x0a, 0x4a, 0x49, 0xdd, 0xe4, 0x4a, 0xb0, 0x1a, 0x42, 0x8c, 0xd1, 0x39, 0xe1, 0xe1, 0x98,
+	0xd5, 0x0b, 0xa7, 0x15, 0xb4, 0x4d, 0x6a, 0x0a, 0x22, 0xd6, 0x40, 0x66, 0x43, 0xba, 0x4d, 0x96,
+	0xce, 0x12, 0x21, 0x40, 0xb1, 0x25, 0x84, 0x85, 0xa2, 0x1d, 0xd2, 0x8c, 0x80, 0x47, 0x93, 0x44,
+	0x00, 0x5b, 0xc6, 0x4c, 0xa9, 0xe9, 0x1e, 0x69, 0xa5, 0xf2, 0x14, 0x8e, 0x64, 0x2e, 0x0c, 0x6b,
+	0x76, 0xab, 0xbd, 0xfa, 0x70, 0x06, 0x68, 0x97, 0xac, 0x04, 0x70, 0x22, 0x15, 0xbc, 0xc2, 0xff,
+	0x6f, 0xe1, 0xcb, 0xf3, 0x88, 0x7a, 0x84, 0xf0, 0x13, 0x03, 0xca, 0x19, 0x08, 0x1a, 0xe6, 0x88,
+	0xad, 0x60, 0xcb, 0xbd, 0x4c, 0x6c, 0xd3, 0xa7, 0x6c, 0xad, 0x5b, 0xb3, 0x15, 0xe6, 0x90, 0x3d,
+	0xdd, 0x48, 0xe6, 0x1a, 0xd8, 0xba, 0x3b, 0x1d, 0x0a, 0x5b, 0x57, 0x1b, 0xae, 0xcc, 0x00, 0x5b,
+	0xb4, 0xe1, 0xea, 0xce, 0x88, 0xfd, 0x6f, 0x54, 0x6f, 0x6d, 0xb3, 0xda, 0x98, 0x9e, 0x01, 0xca,
+	0xc8, 0x72, 0x2e, 0x14, 0x37, 0x10, 0xb1, 0xcd, 0x6e, 0xb5, 0xd7, 0x1c, 0xde, 0x4a, 0xdb, 0xa3,
+	0x80, 0x4f, 0x26, 0xd2, 0x30, 0xea, 0x7a, 0xe4, 0x14, 0x7d, 0x44, 0x36, 0x0d, 0x1f, 0x43, 0xc0,
+	0xc3, 0xf1, 0x10, 0x3e, 0xe5, 0xa0, 0x0d, 0x28, 0xf6, 0x2f, 0x5a, 0xee, 0x26, 0x68, 0x8f, 0x6c,
+	0x9c, 0x28, 0x79, 0x01, 0x62, 0x08, 0x29, 0x4f, 0x44, 0x22, 0x62, 0xb6, 0x85, 0xde, 0x45, 0x6c,
+	0x9d, 0x3c, 0xfa, 0x28, 0x73, 0x25, 0x5e, 0x2b, 0x99, 0x49, 0x0d, 0x8a, 0xfd, 0xe7, 0x9c, 0x0b,
+	0x98, 0x3e, 0x20, 0xeb, 0x05, 0x82, 0xe8, 0x9d, 0x30, 0xc9, 0x84, 0x6d, 0xa3, 0x71, 0x81, 0x62,
+	0xc7, 0xc3, 0x10, 0x32, 0x03, 0xd1, 0x60, 0xca, 0x58, 0xd1, 0xf1, 0x92, 0x50, 0x4e, 0x1a, 0x67,
+	0x3c, 0x06, 0xc5, 0x76, 0xbb, 0xb5, 0xde, 0xca, 0xd3, 0xdd, 0xbe, 0x5b, 0xd6, 0xbe, 0x5d, 0xd6,
+	0x7e, 0xb1, 0xac, 0xfd, 0x23, 0x99, 0x88, 0xc1, 0x93, 0xcb, 0xef, 0xfb, 0x95, 0xaf, 0x3f, 0xf6,
+	0x7b, 0x71, 0x62, 0x46, 0x79, 0xd0, 0x0f, 0x65, 0xea, 0x17, 0x9b, 0xed, 0x1e, 0x8f, 0x75, 0x34,
+	0xf6, 0xcd, 0x34, 0x03, 0x8d, 0x2f, 0xe8, 0xa1, 0xab, 0x4c, 0x63, 0xd2, 0x04, 0x1d, 0x2a, 0x79,
+	0x06, 0x11, 0xeb, 0xfc, 0xf9, 0xaf, 0x94, 0xc5, 0xed, 0x94, 0xa5, 0x8a, 0xb9, 0x48, 0x2e, 0x40,
+	0xb1, 0xff, 0xdd, 0x94, 0x4b, 0x60, 0xa7, 0xac, 0x33, 0x29, 0xb4, 0x54, 0x6c, 0x0f, 0x73, 0xb7,
+	0xd2, 0xf6, 0x20, 0x53, 0xc9, 0x05, 0xb0, 0x7b, 0x7f, 0xa1, 0x07, 0x58, 0xd9, 0x8e, 0x21, 0xe5,
+	0x26, 0x1c, 0xb9, 0xc5, 0xf7, 0xdc, 0x18, 0x66, 0xc4, 0x0e, 0x5e, 0x01, 0xea, 0x72, 0xf0, 0xfb,
+	0x6e, 0xf0, 0x0b, 0xd8, 0xae, 0x5e, 0xa6, 0xe0, 0x34, 0x91, 0xb9, 0xb6, 0xd7, 0x85, 0x2b, 0xd8,
+	0x75, 0xab, 0x77, 0x27, 0x41, 0x0f, 0xc8, 0x6a, 0x51, 0xc0, 0x19, 0xef, 0xa3, 0xf1, 0x37, 0x76,
+	0x5c, 0x6f, 0xae, 0xb4, 0x57, 0x8f, 0xeb, 0xcd, 0xd5, 0xf6, 0xda, 0x71, 0xbd, 0xb9, 0xd3, 0x66,
+	0xc3, 0x46, 0x04, 0x42, 0xa6, 0x83, 0xe7, 0x97, 0xd7, 0x5e, 0xf5, 0xea, 0xda, 0xab, 0xfe, 0xbc,
+	0xf6, 0xaa, 0x9f, 0x6f, 0xbc, 0xca, 0xd5, 0x8d, 0x57, 0xf9, 0x76, 0xe3, 0x55, 0xde, 0x3f, 0x9c,
+	0x3b, 0x3f, 0x5e, 0x86, 0x7e, 0x79, 0x5d, 0x9e, 0xcf, 0x42, 0xec, 0x43, 0xb0, 0x84, 0xb7, 0xdc,
+	0xb3, 0x5f, 0x03, 0x00, 0xa1, 0xd0, 0x5e, 0xea, 0x52, 0x05, 0x00, 0x00,
 }
 
 func (m *StoredGame) Marshal() (dAtA []byte, err error) {
@@ -203,17 +400,205 @@ func (m *StoredGame) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintStoredGame(dAtA, i, uint64(len(m.Denom)))
+	if len(m.RematchIndex) > 0 {
+		i -= len(m.RematchIndex)
+		copy(dAtA[i:], m.RematchIndex)
+		i = encodeVarintStoredGame(dAtA, i, uint64(len(m.RematchIndex)))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x8a
+	}
+	if len(m.PreviousGameIndex) > 0 {
+		i -= len(m.PreviousGameIndex)
+		copy(dAtA[i:], m.PreviousGameIndex)
+		i = encodeVarintStoredGame(dAtA, i, uint64(len(m.PreviousGameIndex)))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.RematchProposer) > 0 {
+		i -= len(m.RematchProposer)
+		copy(dAtA[i:], m.RematchProposer)
+		i = encodeVarintStoredGame(dAtA, i, uint64(len(m.RematchProposer)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xfa
+	}
+	if len(m.MatchIndex) > 0 {
+		i -= len(m.MatchIndex)
+		copy(dAtA[i:], m.MatchIndex)
+		i = encodeVarintStoredGame(dAtA, i, uint64(len(m.MatchIndex)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf2
+	}
+	if len(m.Prize) > 0 {
+		for iNdEx := len(m.Prize) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Prize[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStoredGame(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xea
+		}
+	}
+	if len(m.Sponsor) > 0 {
+		i -= len(m.Sponsor)
+		copy(dAtA[i:], m.Sponsor)
+		i = encodeVarintStoredGame(dAtA, i, uint64(len(m.Sponsor)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe2
+	}
+	if len(m.Organizer) > 0 {
+		i -= len(m.Organizer)
+		copy(dAtA[i:], m.Organizer)
+		i = encodeVarintStoredGame(dAtA, i, uint64(len(m.Organizer)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xda
+	}
+	if len(m.Escrowed) > 0 {
+		for iNdEx := len(m.Escrowed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Escrowed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStoredGame(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xd2
+		}
+	}
+	if len(m.Wager) > 0 {
+		for iNdEx := len(m.Wager) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Wager[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStoredGame(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xca
+		}
+	}
+	if len(m.AcceptedBy) > 0 {
+		i -= len(m.AcceptedBy)
+		copy(dAtA[i:], m.AcceptedBy)
+		i = encodeVarintStoredGame(dAtA, i, uint64(len(m.AcceptedBy)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc2
+	}
+	if len(m.AdjournedUntil) > 0 {
+		i -= len(m.AdjournedUntil)
+		copy(dAtA[i:], m.AdjournedUntil)
+		i = encodeVarintStoredGame(dAtA, i, uint64(len(m.AdjournedUntil)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
+	}
+	if len(m.AdjournProposer) > 0 {
+		i -= len(m.AdjournProposer)
+		copy(dAtA[i:], m.AdjournProposer)
+		i = encodeVarintStoredGame(dAtA, i, uint64(len(m.AdjournProposer)))
+		i--
+		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0x62
+		dAtA[i] = 0xaa
 	}
-	if m.Wager != 0 {
-		i = encodeVarintStoredGame(dAtA, i, uint64(m.Wager))
+	if len(m.FrozenRemaining) > 0 {
+		i -= len(m.FrozenRemaining)
+		copy(dAtA[i:], m.FrozenRemaining)
+		i = encodeVarintStoredGame(dAtA, i, uint64(len(m.FrozenRemaining)))
 		i--
-		dAtA[i] = 0x58
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	if len(m.TakebackRequester) > 0 {
+		i -= len(m.TakebackRequester)
+		copy(dAtA[i:], m.TakebackRequester)
+		i = encodeVarintStoredGame(dAtA, i, uint64(len(m.TakebackRequester)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.Ballot) > 0 {
+		i -= len(m.Ballot)
+		copy(dAtA[i:], m.Ballot)
+		i = encodeVarintStoredGame(dAtA, i, uint64(len(m.Ballot)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if m.Unrated {
+		i--
+		if m.Unrated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if len(m.StartTurn) > 0 {
+		i -= len(m.StartTurn)
+		copy(dAtA[i:], m.StartTurn)
+		i = encodeVarintStoredGame(dAtA, i, uint64(len(m.StartTurn)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.StartBoard) > 0 {
+		i -= len(m.StartBoard)
+		copy(dAtA[i:], m.StartBoard)
+		i = encodeVarintStoredGame(dAtA, i, uint64(len(m.StartBoard)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.House) > 0 {
+		i -= len(m.House)
+		copy(dAtA[i:], m.House)
+		i = encodeVarintStoredGame(dAtA, i, uint64(len(m.House)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.MoveHistory) > 0 {
+		for iNdEx := len(m.MoveHistory) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MoveHistory[iNdEx])
+			copy(dAtA[i:], m.MoveHistory[iNdEx])
+			i = encodeVarintStoredGame(dAtA, i, uint64(len(m.MoveHistory[iNdEx])))
+			i--
+			dAtA[i] = 0x6a
+		}
 	}
 	if len(m.AfterIndex) > 0 {
 		i -= len(m.AfterIndex)
@@ -342,13 +727,93 @@ func (m *StoredGame) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovStoredGame(uint64(l))
 	}
-	if m.Wager != 0 {
-		n += 1 + sovStoredGame(uint64(m.Wager))
+	if len(m.MoveHistory) > 0 {
+		for _, s := range m.MoveHistory {
+			l = len(s)
+			n += 1 + l + sovStoredGame(uint64(l))
+		}
+	}
+	l = len(m.House)
+	if l > 0 {
+		n += 1 + l + sovStoredGame(uint64(l))
 	}
-	l = len(m.Denom)
+	l = len(m.StartBoard)
 	if l > 0 {
 		n += 1 + l + sovStoredGame(uint64(l))
 	}
+	l = len(m.StartTurn)
+	if l > 0 {
+		n += 2 + l + sovStoredGame(uint64(l))
+	}
+	if m.Unrated {
+		n += 3
+	}
+	l = len(m.Ballot)
+	if l > 0 {
+		n += 2 + l + sovStoredGame(uint64(l))
+	}
+	l = len(m.TakebackRequester)
+	if l > 0 {
+		n += 2 + l + sovStoredGame(uint64(l))
+	}
+	l = len(m.FrozenRemaining)
+	if l > 0 {
+		n += 2 + l + sovStoredGame(uint64(l))
+	}
+	l = len(m.AdjournProposer)
+	if l > 0 {
+		n += 2 + l + sovStoredGame(uint64(l))
+	}
+	l = len(m.AdjournedUntil)
+	if l > 0 {
+		n += 2 + l + sovStoredGame(uint64(l))
+	}
+	l = len(m.AcceptedBy)
+	if l > 0 {
+		n += 2 + l + sovStoredGame(uint64(l))
+	}
+	if len(m.Wager) > 0 {
+		for _, e := range m.Wager {
+			l = e.Size()
+			n += 2 + l + sovStoredGame(uint64(l))
+		}
+	}
+	if len(m.Escrowed) > 0 {
+		for _, e := range m.Escrowed {
+			l = e.Size()
+			n += 2 + l + sovStoredGame(uint64(l))
+		}
+	}
+	l = len(m.Organizer)
+	if l > 0 {
+		n += 2 + l + sovStoredGame(uint64(l))
+	}
+	l = len(m.Sponsor)
+	if l > 0 {
+		n += 2 + l + sovStoredGame(uint64(l))
+	}
+	if len(m.Prize) > 0 {
+		for _, e := range m.Prize {
+			l = e.Size()
+			n += 2 + l + sovStoredGame(uint64(l))
+		}
+	}
+	l = len(m.MatchIndex)
+	if l > 0 {
+		n += 2 + l + sovStoredGame(uint64(l))
+	}
+	l = len(m.RematchProposer)
+	if l > 0 {
+		n += 2 + l + sovStoredGame(uint64(l))
+	}
+	l = len(m.PreviousGameIndex)
+	if l > 0 {
+		n += 2 + l + sovStoredGame(uint64(l))
+	}
+	l = len(m.RematchIndex)
+	if l > 0 {
+		n += 2 + l + sovStoredGame(uint64(l))
+	}
 	return n
 }
 
@@ -694,11 +1159,75 @@ func (m *StoredGame) Unmarshal(dAtA []byte) error {
 			}
 			m.AfterIndex = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Wager", wireType)
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MoveHistory", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MoveHistory = append(m.MoveHistory, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field House", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.Wager = 0
+			m.House = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartBoard", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStoredGame
@@ -708,14 +1237,533 @@ func (m *StoredGame) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Wager |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 12:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StartBoard = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTurn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StartTurn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unrated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Unrated = bool(v != 0)
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ballot", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ballot = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakebackRequester", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TakebackRequester = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrozenRemaining", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FrozenRemaining = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdjournProposer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AdjournProposer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdjournedUntil", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AdjournedUntil = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcceptedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AcceptedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Wager", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Wager = append(m.Wager, types.Coin{})
+			if err := m.Wager[len(m.Wager)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Escrowed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Escrowed = append(m.Escrowed, types.Coin{})
+			if err := m.Escrowed[len(m.Escrowed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Organizer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Organizer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sponsor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prize", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prize = append(m.Prize, types.Coin{})
+			if err := m.Prize[len(m.Prize)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MatchIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MatchIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 31:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RematchProposer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RematchProposer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 32:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousGameIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousGameIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 33:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RematchIndex", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -743,7 +1791,7 @@ func (m *StoredGame) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.RematchIndex = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
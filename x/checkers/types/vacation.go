@@ -0,0 +1,84 @@
+package types
+
+import (
+	"time"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func NewVacation(player string) Vacation {
+	return Vacation{
+		Index: player,
+	}
+}
+
+func (vacation Vacation) IsOnVacation() bool {
+	return vacation.Start != ""
+}
+
+func (vacation Vacation) GetStartAsTime() (start time.Time, err error) {
+	start, errStart := time.Parse(DeadlineLayout, vacation.Start)
+	return start, sdkerrors.Wrapf(errStart, ErrInvalidDeadline.Error(), vacation.Start)
+}
+
+// ForYear moves the budget to year, with nothing used yet, unless a vacation
+// is running. A vacation counts in the year it started.
+func (vacation *Vacation) ForYear(year uint64) {
+	if vacation.Year != year && !vacation.IsOnVacation() {
+		vacation.Year = year
+		vacation.UsedSeconds = 0
+	}
+}
+
+// GetUsed returns the vacation taken in the year at now, counting the current
+// vacation, at most the budget.
+func (vacation Vacation) GetUsed(now time.Time) time.Duration {
+	used := time.Duration(vacation.UsedSeconds) * time.Second
+	if vacation.IsOnVacation() {
+		start, err := vacation.GetStartAsTime()
+		if err != nil {
+			panic(err.Error())
+		}
+		used += now.Sub(start)
+	}
+	if VacationBudget < used {
+		return VacationBudget
+	}
+	return used
+}
+
+func (vacation Vacation) GetRemaining(now time.Time) time.Duration {
+	return VacationBudget - vacation.GetUsed(now)
+}
+
+// GetEnd returns when the current vacation runs out of budget.
+func (vacation Vacation) GetEnd() time.Time {
+	start, err := vacation.GetStartAsTime()
+	if err != nil {
+		panic(err.Error())
+	}
+	return start.Add(VacationBudget - time.Duration(vacation.UsedSeconds)*time.Second)
+}
+
+// Stop ends the current vacation at now and adds it to the year's usage,
+// counting started seconds.
+func (vacation *Vacation) Stop(now time.Time) {
+	used := vacation.GetUsed(now)
+	vacation.UsedSeconds = uint64((used + time.Second - 1) / time.Second)
+	vacation.Start = ""
+}
+
+func (vacation *Vacation) AddFrozenGame(gameIndex string) {
+	vacation.FrozenGames = append(vacation.FrozenGames, gameIndex)
+}
+
+// RemoveFrozenGame tells whether the game was frozen for the player.
+func (vacation *Vacation) RemoveFrozenGame(gameIndex string) bool {
+	for i, frozen := range vacation.FrozenGames {
+		if frozen == gameIndex {
+			vacation.FrozenGames = append(vacation.FrozenGames[:i], vacation.FrozenGames[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
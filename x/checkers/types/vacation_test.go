@@ -0,0 +1,54 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/satya/checkers/x/checkers/testutil"
+	"github.com/satya/checkers/x/checkers/types"
+	"github.com/stretchr/testify/require"
+)
+
+var vacationStart = time.Date(2026, time.June, 1, 12, 0, 0, 0, time.UTC)
+
+func TestVacationStopRoundsUp(t *testing.T) {
+	vacation := types.NewVacation(testutil.Bob)
+	vacation.UsedSeconds = 60
+	vacation.Start = types.FormatDeadline(vacationStart)
+	require.EqualValues(t, time.Minute+1500*time.Millisecond, vacation.GetUsed(vacationStart.Add(1500*time.Millisecond)))
+	require.EqualValues(t, vacationStart.Add(types.VacationBudget-time.Minute), vacation.GetEnd())
+
+	vacation.Stop(vacationStart.Add(1500 * time.Millisecond))
+	require.False(t, vacation.IsOnVacation())
+	require.EqualValues(t, 62, vacation.UsedSeconds)
+}
+
+func TestVacationUsedCappedAtBudget(t *testing.T) {
+	vacation := types.NewVacation(testutil.Bob)
+	vacation.Start = types.FormatDeadline(vacationStart)
+	require.EqualValues(t, types.VacationBudget, vacation.GetUsed(vacationStart.Add(2*types.VacationBudget)))
+	require.EqualValues(t, 0, vacation.GetRemaining(vacationStart.Add(2*types.VacationBudget)))
+}
+
+func TestVacationForYear(t *testing.T) {
+	vacation := types.Vacation{Index: testutil.Bob, Year: 2026, UsedSeconds: 100}
+	vacation.ForYear(2026)
+	require.EqualValues(t, 100, vacation.UsedSeconds)
+	vacation.ForYear(2027)
+	require.EqualValues(t, types.Vacation{Index: testutil.Bob, Year: 2027}, vacation)
+
+	vacation.UsedSeconds = 100
+	vacation.Start = types.FormatDeadline(vacationStart)
+	vacation.ForYear(2028)
+	require.EqualValues(t, 2027, vacation.Year)
+	require.EqualValues(t, 100, vacation.UsedSeconds)
+}
+
+func TestVacationRemoveFrozenGame(t *testing.T) {
+	vacation := types.NewVacation(testutil.Bob)
+	vacation.AddFrozenGame("1")
+	vacation.AddFrozenGame("3")
+	require.False(t, vacation.RemoveFrozenGame("2"))
+	require.True(t, vacation.RemoveFrozenGame("1"))
+	require.EqualValues(t, []string{"3"}, vacation.FrozenGames)
+}
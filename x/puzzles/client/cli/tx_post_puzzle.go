@@ -0,0 +1,62 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/satya/checkers/x/puzzles/types"
+	"github.com/spf13/cobra"
+)
+
+var _ = strconv.Itoa(0)
+
+func CmdPostPuzzle() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "post-puzzle [board] [turn] [reward]",
+		Short: "Broadcast message postPuzzle",
+		Long: `Broadcast message postPuzzle.
+
+The board uses the checkers stored game layout, such as
+"********|********|*b******|********|***b****|****r***|********|B*******",
+and the turn is the colour, b or r, that moves first and must win. The reward,
+such as 100stake, is held until the first valid solution or the expiry.`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argBoard := args[0]
+			argTurn := args[1]
+			argReward, err := sdk.ParseCoinNormalized(args[2])
+			if err != nil {
+				return err
+			}
+			argMaxMoves, err := cmd.Flags().GetUint64(flagMaxMoves)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgPostPuzzle(
+				clientCtx.GetFromAddress().String(),
+				argBoard,
+				argTurn,
+				argReward,
+				argMaxMoves,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Uint64(flagMaxMoves, 0, "most moves the solver may play, 0 for the module maximum")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,49 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/satya/checkers/x/puzzles/types"
+	"github.com/spf13/cobra"
+)
+
+var _ = strconv.Itoa(0)
+
+func CmdSolvePuzzle() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "solve-puzzle [puzzle-index] [move]...",
+		Short: "Broadcast message solvePuzzle",
+		Long: `Broadcast message solvePuzzle.
+
+The moves are the whole line, the solver's and the defence's in turn, in
+numbered-square notation such as "11-15" or "9x18x27". Each defence must be
+the engine's reply, and the line must end in a win.`,
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argPuzzleIndex := args[0]
+			argMoves := args[1:]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSolvePuzzle(
+				clientCtx.GetFromAddress().String(),
+				argPuzzleIndex,
+				argMoves,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,34 @@
+package puzzles
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/satya/checkers/x/puzzles/keeper"
+	"github.com/satya/checkers/x/puzzles/types"
+)
+
+// InitGenesis initializes the capability module's state from a provided genesis
+// state.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
+	k.SetSystemInfo(ctx, genState.SystemInfo)
+	// Set all the puzzle
+	for _, elem := range genState.PuzzleList {
+		k.SetPuzzle(ctx, elem)
+	}
+	// this line is used by starport scaffolding # genesis/module/init
+	k.SetParams(ctx, genState.Params)
+}
+
+// ExportGenesis returns the capability module's exported genesis.
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	genesis := types.DefaultGenesis()
+	genesis.Params = k.GetParams(ctx)
+
+	systemInfo, found := k.GetSystemInfo(ctx)
+	if found {
+		genesis.SystemInfo = systemInfo
+	}
+	genesis.PuzzleList = k.GetAllPuzzle(ctx)
+	// this line is used by starport scaffolding # genesis/module/export
+
+	return genesis
+}
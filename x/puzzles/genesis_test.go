@@ -0,0 +1,43 @@
+package puzzles_test
+
+import (
+	"testing"
+
+	keepertest "github.com/satya/checkers/testutil/keeper"
+	"github.com/satya/checkers/testutil/nullify"
+	"github.com/satya/checkers/x/puzzles"
+	"github.com/satya/checkers/x/puzzles/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenesis(t *testing.T) {
+	genesisState := types.GenesisState{
+		Params: types.DefaultParams(),
+
+		SystemInfo: types.SystemInfo{
+			NextId:      3,
+			FirstOpenId: 1,
+		},
+		PuzzleList: []types.Puzzle{
+			{
+				Index: "1",
+			},
+			{
+				Index: "2",
+			},
+		},
+		// this line is used by starport scaffolding # genesis/test/state
+	}
+
+	k, ctx := keepertest.PuzzlesKeeper(t)
+	puzzles.InitGenesis(ctx, *k, genesisState)
+	got := puzzles.ExportGenesis(ctx, *k)
+	require.NotNil(t, got)
+
+	nullify.Fill(&genesisState)
+	nullify.Fill(got)
+
+	require.Equal(t, genesisState.SystemInfo, got.SystemInfo)
+	require.ElementsMatch(t, genesisState.PuzzleList, got.PuzzleList)
+	// this line is used by starport scaffolding # genesis/test/assert
+}
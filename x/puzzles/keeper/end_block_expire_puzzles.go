@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/satya/checkers/x/puzzles/types"
+)
+
+// ExpirePuzzles refunds the posters of open puzzles past their deadline.
+// Puzzles all last as long, so they are visited in the order they were posted.
+func (k Keeper) ExpirePuzzles(goCtx context.Context) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	systemInfo, found := k.GetSystemInfo(ctx)
+	if !found {
+		panic("SystemInfo not found")
+	}
+
+	for ; systemInfo.FirstOpenId < systemInfo.NextId; systemInfo.FirstOpenId++ {
+		index := strconv.FormatUint(systemInfo.FirstOpenId, 10)
+		puzzle, found := k.GetPuzzle(ctx, index)
+		if !found || puzzle.Status != types.StatusOpen {
+			continue
+		}
+		deadline, err := puzzle.GetDeadlineAsTime()
+		if err != nil {
+			panic(err)
+		}
+		if ctx.BlockTime().Before(deadline) {
+			break
+		}
+		puzzle.Status = types.StatusExpired
+		k.MustRefundReward(ctx, &puzzle)
+		k.SetPuzzle(ctx, puzzle)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(types.PuzzleExpiredEventType,
+				sdk.NewAttribute(types.PuzzleExpiredEventIndex, index),
+				sdk.NewAttribute(types.PuzzleExpiredEventPoster, puzzle.Poster),
+			),
+		)
+	}
+	k.SetSystemInfo(ctx, systemInfo)
+}
@@ -0,0 +1,59 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/satya/checkers/x/puzzles/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpirePuzzlesRefundsPoster(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithMock(t)
+	defer ctrl.Finish()
+	ctx := sdk.UnwrapSDKContext(context)
+	later := ctx.WithBlockTime(ctx.BlockTime().Add(types.PuzzleDuration)).WithEventManager(sdk.NewEventManager())
+	pay := escrow.ExpectPay(context, alice, 100).Times(1)
+	escrow.ExpectRefund(sdk.WrapSDKContext(later), alice, 100).Times(1).After(pay)
+	postTwoMovePuzzle(t, msgServer, context)
+
+	keeper.ExpirePuzzles(context)
+	puzzle, _ := keeper.GetPuzzle(ctx, "1")
+	require.Equal(t, types.StatusOpen, puzzle.Status)
+
+	keeper.ExpirePuzzles(sdk.WrapSDKContext(later))
+
+	puzzle, _ = keeper.GetPuzzle(later, "1")
+	require.Equal(t, types.StatusExpired, puzzle.Status)
+	systemInfo, _ := keeper.GetSystemInfo(later)
+	require.EqualValues(t, types.SystemInfo{NextId: 2, FirstOpenId: 2}, systemInfo)
+	events := sdk.StringifyEvents(later.EventManager().ABCIEvents())
+	require.Len(t, events, 1)
+	require.EqualValues(t, sdk.StringEvent{
+		Type: "puzzle-expired",
+		Attributes: []sdk.Attribute{
+			{Key: "puzzle-index", Value: "1"},
+			{Key: "poster", Value: alice},
+		},
+	}, events[0])
+}
+
+func TestExpirePuzzlesSkipsSolved(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithMock(t)
+	defer ctrl.Finish()
+	escrow.ExpectAny(context)
+	postTwoMovePuzzle(t, msgServer, context)
+	_, err := msgServer.SolvePuzzle(context, &types.MsgSolvePuzzle{
+		Creator:     bob,
+		PuzzleIndex: "1",
+		Moves:       []string{"23x14", "5-9", "14x5"},
+	})
+	require.Nil(t, err)
+	ctx := sdk.UnwrapSDKContext(context)
+
+	later := ctx.WithBlockTime(ctx.BlockTime().Add(types.PuzzleDuration))
+	keeper.ExpirePuzzles(sdk.WrapSDKContext(later))
+
+	puzzle, _ := keeper.GetPuzzle(later, "1")
+	require.Equal(t, types.StatusSolved, puzzle.Status)
+}
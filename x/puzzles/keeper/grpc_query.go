@@ -0,0 +1,7 @@
+package keeper
+
+import (
+	"github.com/satya/checkers/x/puzzles/types"
+)
+
+var _ types.QueryServer = Keeper{}
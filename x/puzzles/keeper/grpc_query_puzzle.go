@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/satya/checkers/x/puzzles/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) PuzzleAll(c context.Context, req *types.QueryAllPuzzleRequest) (*types.QueryAllPuzzleResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var puzzles []types.Puzzle
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	puzzleStore := prefix.NewStore(store, types.KeyPrefix(types.PuzzleKeyPrefix))
+
+	pageRes, err := query.Paginate(puzzleStore, req.Pagination, func(key []byte, value []byte) error {
+		var puzzle types.Puzzle
+		if err := k.cdc.Unmarshal(value, &puzzle); err != nil {
+			return err
+		}
+
+		puzzles = append(puzzles, puzzle)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllPuzzleResponse{Puzzle: puzzles, Pagination: pageRes}, nil
+}
+
+func (k Keeper) Puzzle(c context.Context, req *types.QueryGetPuzzleRequest) (*types.QueryGetPuzzleResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	val, found := k.GetPuzzle(
+		ctx,
+		req.Index,
+	)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetPuzzleResponse{Puzzle: val}, nil
+}
@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/satya/checkers/x/puzzles/types"
+)
+
+func (k msgServer) PostPuzzle(goCtx context.Context, msg *types.MsgPostPuzzle) (*types.MsgPostPuzzleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	systemInfo, found := k.Keeper.GetSystemInfo(ctx)
+	if !found {
+		panic("SystemInfo not found")
+	}
+	newIndex := strconv.FormatUint(systemInfo.NextId, 10)
+
+	puzzle := types.Puzzle{
+		Index:    newIndex,
+		Poster:   msg.Creator,
+		Board:    msg.Board,
+		Turn:     msg.Turn,
+		Reward:   msg.Reward,
+		MaxMoves: msg.MaxMoves,
+		Deadline: types.FormatDeadline(types.GetNextDeadline(ctx)),
+		Status:   types.StatusOpen,
+	}
+	_, err := puzzle.ParseStart()
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.Keeper.CollectReward(ctx, &puzzle)
+	if err != nil {
+		return nil, err
+	}
+
+	k.Keeper.SetPuzzle(ctx, puzzle)
+	systemInfo.NextId++
+	k.Keeper.SetSystemInfo(ctx, systemInfo)
+
+	ctx.GasMeter().ConsumeGas(types.PostPuzzleGas, "Post puzzle")
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.PuzzlePostedEventType,
+			sdk.NewAttribute(types.PuzzlePostedEventPoster, msg.Creator),
+			sdk.NewAttribute(types.PuzzlePostedEventIndex, newIndex),
+			sdk.NewAttribute(types.PuzzlePostedEventBoard, msg.Board),
+			sdk.NewAttribute(types.PuzzlePostedEventTurn, msg.Turn),
+			sdk.NewAttribute(types.PuzzlePostedEventReward, msg.Reward.String()),
+			sdk.NewAttribute(types.PuzzlePostedEventMaxMove, strconv.FormatUint(puzzle.GetMoveLimit(), 10)),
+		),
+	)
+
+	return &types.MsgPostPuzzleResponse{
+		PuzzleIndex: newIndex,
+	}, nil
+}
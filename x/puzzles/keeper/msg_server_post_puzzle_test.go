@@ -0,0 +1,91 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+	"github.com/satya/checkers/x/puzzles/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostPuzzleEscrowsReward(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithMock(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	escrow.ExpectPay(context, alice, 100).Times(1)
+
+	response, err := msgServer.PostPuzzle(context, &types.MsgPostPuzzle{
+		Creator:  alice,
+		Board:    twoMoveBoard,
+		Turn:     "r",
+		Reward:   sdk.NewInt64Coin(sdk.DefaultBondDenom, 100),
+		MaxMoves: 2,
+	})
+	require.Nil(t, err)
+	require.EqualValues(t, types.MsgPostPuzzleResponse{PuzzleIndex: "1"}, *response)
+
+	systemInfo, found := keeper.GetSystemInfo(ctx)
+	require.True(t, found)
+	require.EqualValues(t, types.SystemInfo{NextId: 2, FirstOpenId: 1}, systemInfo)
+	puzzle, found := keeper.GetPuzzle(ctx, "1")
+	require.True(t, found)
+	require.EqualValues(t, types.Puzzle{
+		Index:    "1",
+		Poster:   alice,
+		Board:    twoMoveBoard,
+		Turn:     "r",
+		Reward:   sdk.NewInt64Coin(sdk.DefaultBondDenom, 100),
+		MaxMoves: 2,
+		Deadline: types.FormatDeadline(types.GetNextDeadline(ctx)),
+		Status:   types.StatusOpen,
+	}, puzzle)
+
+	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
+	require.Len(t, events, 1)
+	require.EqualValues(t, sdk.StringEvent{
+		Type: "puzzle-posted",
+		Attributes: []sdk.Attribute{
+			{Key: "poster", Value: alice},
+			{Key: "puzzle-index", Value: "1"},
+			{Key: "board", Value: twoMoveBoard},
+			{Key: "turn", Value: "r"},
+			{Key: "reward", Value: "100stake"},
+			{Key: "max-moves", Value: "2"},
+		},
+	}, events[0])
+}
+
+func TestPostPuzzleInvalidPosition(t *testing.T) {
+	msgServer, keeper, context, ctrl, _ := setupMsgServerWithMock(t)
+	defer ctrl.Finish()
+
+	_, err := msgServer.PostPuzzle(context, &types.MsgPostPuzzle{
+		Creator: alice,
+		Board:   "********|********|********|********|***b****|********|********|********",
+		Turn:    "b",
+		Reward:  sdk.NewInt64Coin(sdk.DefaultBondDenom, 100),
+	})
+	require.ErrorIs(t, err, types.ErrInvalidPosition)
+	_, found := keeper.GetPuzzle(sdk.UnwrapSDKContext(context), "1")
+	require.False(t, found)
+}
+
+func TestPostPuzzleCannotPay(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithMock(t)
+	defer ctrl.Finish()
+	escrow.EXPECT().
+		SendCoinsFromAccountToModule(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(errors.New("oops"))
+
+	_, err := msgServer.PostPuzzle(context, &types.MsgPostPuzzle{
+		Creator: alice,
+		Board:   twoMoveBoard,
+		Turn:    "r",
+		Reward:  sdk.NewInt64Coin(sdk.DefaultBondDenom, 100),
+	})
+	require.EqualError(t, err, "poster cannot pay the reward: oops")
+	_, found := keeper.GetPuzzle(sdk.UnwrapSDKContext(context), "1")
+	require.False(t, found)
+}
@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/satya/checkers/x/puzzles/types"
+)
+
+func (k msgServer) SolvePuzzle(goCtx context.Context, msg *types.MsgSolvePuzzle) (*types.MsgSolvePuzzleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	puzzle, found := k.Keeper.GetPuzzle(ctx, msg.PuzzleIndex)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrPuzzleNotFound, "%s", msg.PuzzleIndex)
+	}
+	if puzzle.Status != types.StatusOpen {
+		return nil, sdkerrors.Wrapf(types.ErrPuzzleNotOpen, "%s", puzzle.Status)
+	}
+	deadline, err := puzzle.GetDeadlineAsTime()
+	if err != nil {
+		panic(err.Error())
+	}
+	if !ctx.BlockTime().Before(deadline) {
+		return nil, sdkerrors.Wrapf(types.ErrPuzzleNotOpen, "%s", types.StatusExpired)
+	}
+
+	// Every other move is a defence that the engine searches, so charge for
+	// them before the search runs.
+	searches := uint64(len(msg.Moves) / 2)
+	ctx.GasMeter().ConsumeGas(searches*types.DefenceSearchGas, "Defence search")
+	_, err = puzzle.CheckSolution(msg.Moves)
+	if err != nil {
+		return nil, err
+	}
+
+	puzzle.Status = types.StatusSolved
+	puzzle.Solver = msg.Creator
+	puzzle.Solution = msg.Moves
+	k.Keeper.MustPayReward(ctx, &puzzle)
+	k.Keeper.SetPuzzle(ctx, puzzle)
+
+	ctx.GasMeter().ConsumeGas(types.SolvePuzzleGas, "Solve puzzle")
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.PuzzleSolvedEventType,
+			sdk.NewAttribute(types.PuzzleSolvedEventSolver, msg.Creator),
+			sdk.NewAttribute(types.PuzzleSolvedEventIndex, msg.PuzzleIndex),
+			sdk.NewAttribute(types.PuzzleSolvedEventSolution, strings.Join(msg.Moves, " ")),
+		),
+	)
+
+	return &types.MsgSolvePuzzleResponse{}, nil
+}
@@ -0,0 +1,103 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/satya/checkers/x/puzzles/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSolvePuzzlePaysSolver(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithMock(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	pay := escrow.ExpectPay(context, alice, 100).Times(1)
+	escrow.ExpectRefund(context, bob, 100).Times(1).After(pay)
+	postTwoMovePuzzle(t, msgServer, context)
+
+	_, err := msgServer.SolvePuzzle(context, &types.MsgSolvePuzzle{
+		Creator:     bob,
+		PuzzleIndex: "1",
+		Moves:       []string{"23x14", "5-9", "14x5"},
+	})
+	require.Nil(t, err)
+
+	puzzle, found := keeper.GetPuzzle(ctx, "1")
+	require.True(t, found)
+	require.Equal(t, types.StatusSolved, puzzle.Status)
+	require.Equal(t, bob, puzzle.Solver)
+	require.Equal(t, []string{"23x14", "5-9", "14x5"}, puzzle.Solution)
+
+	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
+	require.Len(t, events, 2)
+	require.EqualValues(t, sdk.StringEvent{
+		Type: "puzzle-solved",
+		Attributes: []sdk.Attribute{
+			{Key: "solver", Value: bob},
+			{Key: "puzzle-index", Value: "1"},
+			{Key: "solution", Value: "23x14 5-9 14x5"},
+		},
+	}, events[1])
+}
+
+func TestSolvePuzzleNotAWin(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithMock(t)
+	defer ctrl.Finish()
+	escrow.ExpectPay(context, alice, 100).Times(1)
+	postTwoMovePuzzle(t, msgServer, context)
+
+	_, err := msgServer.SolvePuzzle(context, &types.MsgSolvePuzzle{
+		Creator:     bob,
+		PuzzleIndex: "1",
+		Moves:       []string{"23x14"},
+	})
+	require.ErrorIs(t, err, types.ErrNotAWin)
+	puzzle, _ := keeper.GetPuzzle(sdk.UnwrapSDKContext(context), "1")
+	require.Equal(t, types.StatusOpen, puzzle.Status)
+}
+
+func TestSolvePuzzleNotFound(t *testing.T) {
+	msgServer, _, context, ctrl, _ := setupMsgServerWithMock(t)
+	defer ctrl.Finish()
+
+	_, err := msgServer.SolvePuzzle(context, &types.MsgSolvePuzzle{
+		Creator:     bob,
+		PuzzleIndex: "1",
+		Moves:       []string{"23x14"},
+	})
+	require.EqualError(t, err, "1: puzzle by id not found")
+}
+
+func TestSolvePuzzleAfterDeadline(t *testing.T) {
+	msgServer, _, context, ctrl, escrow := setupMsgServerWithMock(t)
+	defer ctrl.Finish()
+	escrow.ExpectPay(context, alice, 100).Times(1)
+	postTwoMovePuzzle(t, msgServer, context)
+	ctx := sdk.UnwrapSDKContext(context)
+	later := sdk.WrapSDKContext(ctx.WithBlockTime(ctx.BlockTime().Add(types.PuzzleDuration)))
+
+	_, err := msgServer.SolvePuzzle(later, &types.MsgSolvePuzzle{
+		Creator:     bob,
+		PuzzleIndex: "1",
+		Moves:       []string{"23x14", "5-9", "14x5"},
+	})
+	require.EqualError(t, err, "expired: puzzle is not open")
+}
+
+func TestSolvePuzzleChargesDefenceBeforeSearch(t *testing.T) {
+	msgServer, _, context, ctrl, escrow := setupMsgServerWithMock(t)
+	defer ctrl.Finish()
+	escrow.ExpectPay(context, alice, 100).Times(1)
+	postTwoMovePuzzle(t, msgServer, context)
+	ctx := sdk.UnwrapSDKContext(context)
+	short := sdk.WrapSDKContext(ctx.WithGasMeter(sdk.NewGasMeter(types.DefenceSearchGas - 1)))
+
+	require.PanicsWithValue(t, sdk.ErrorOutOfGas{Descriptor: "Defence search"}, func() {
+		msgServer.SolvePuzzle(short, &types.MsgSolvePuzzle{
+			Creator:     bob,
+			PuzzleIndex: "1",
+			Moves:       []string{"23x14", "6-10"},
+		})
+	})
+}
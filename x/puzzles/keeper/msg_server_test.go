@@ -0,0 +1,43 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+	keepertest "github.com/satya/checkers/testutil/keeper"
+	"github.com/satya/checkers/x/puzzles"
+	"github.com/satya/checkers/x/puzzles/keeper"
+	"github.com/satya/checkers/x/puzzles/testutil"
+	"github.com/satya/checkers/x/puzzles/types"
+)
+
+const (
+	alice = testutil.Alice
+	bob   = testutil.Bob
+	// Red takes on 14, black's only reply is 5-9, and red takes the last piece.
+	twoMoveBoard = "********|b*******|********|********|***b****|****r***|********|********"
+)
+
+func setupMsgServerWithMock(t testing.TB) (types.MsgServer, keeper.Keeper, context.Context,
+	*gomock.Controller, *testutil.MockBankEscrowKeeper) {
+	ctrl := gomock.NewController(t)
+	bankMock := testutil.NewMockBankEscrowKeeper(ctrl)
+	k, ctx := keepertest.PuzzlesKeeperWithMocks(t, bankMock)
+	puzzles.InitGenesis(ctx, *k, *types.DefaultGenesis())
+	return keeper.NewMsgServerImpl(*k), *k, sdk.WrapSDKContext(ctx), ctrl, bankMock
+}
+
+func postTwoMovePuzzle(t *testing.T, msgServer types.MsgServer, context context.Context) {
+	_, err := msgServer.PostPuzzle(context, &types.MsgPostPuzzle{
+		Creator:  alice,
+		Board:    twoMoveBoard,
+		Turn:     "r",
+		Reward:   sdk.NewInt64Coin(sdk.DefaultBondDenom, 100),
+		MaxMoves: 2,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/satya/checkers/x/puzzles/types"
+)
+
+// SetPuzzle set a specific puzzle in the store from its index
+func (k Keeper) SetPuzzle(ctx sdk.Context, puzzle types.Puzzle) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PuzzleKeyPrefix))
+	b := k.cdc.MustMarshal(&puzzle)
+	store.Set(types.PuzzleKey(
+		puzzle.Index,
+	), b)
+}
+
+// GetPuzzle returns a puzzle from its index
+func (k Keeper) GetPuzzle(
+	ctx sdk.Context,
+	index string,
+
+) (val types.Puzzle, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PuzzleKeyPrefix))
+
+	b := store.Get(types.PuzzleKey(
+		index,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemovePuzzle removes a puzzle from the store
+func (k Keeper) RemovePuzzle(
+	ctx sdk.Context,
+	index string,
+
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PuzzleKeyPrefix))
+	store.Delete(types.PuzzleKey(
+		index,
+	))
+}
+
+// GetAllPuzzle returns all puzzle
+func (k Keeper) GetAllPuzzle(ctx sdk.Context) (list []types.Puzzle) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PuzzleKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Puzzle
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/satya/checkers/x/puzzles/types"
+)
+
+func (k *Keeper) CollectReward(ctx sdk.Context, puzzle *types.Puzzle) error {
+	poster, err := puzzle.GetPosterAddress()
+	if err != nil {
+		panic(err.Error())
+	}
+	err = k.bank.SendCoinsFromAccountToModule(ctx, poster, types.ModuleName, sdk.NewCoins(puzzle.Reward))
+	if err != nil {
+		return sdkerrors.Wrapf(err, types.ErrCannotEscrowReward.Error())
+	}
+	return nil
+}
+
+func (k *Keeper) MustPayReward(ctx sdk.Context, puzzle *types.Puzzle) {
+	solver, err := sdk.AccAddressFromBech32(puzzle.Solver)
+	if err != nil {
+		panic(err.Error())
+	}
+	err = k.bank.SendCoinsFromModuleToAccount(ctx, types.ModuleName, solver, sdk.NewCoins(puzzle.Reward))
+	if err != nil {
+		panic(fmt.Sprintf(types.ErrCannotPayReward.Error(), err.Error()))
+	}
+}
+
+func (k *Keeper) MustRefundReward(ctx sdk.Context, puzzle *types.Puzzle) {
+	poster, err := puzzle.GetPosterAddress()
+	if err != nil {
+		panic(err.Error())
+	}
+	err = k.bank.SendCoinsFromModuleToAccount(ctx, types.ModuleName, poster, sdk.NewCoins(puzzle.Reward))
+	if err != nil {
+		panic(fmt.Sprintf(types.ErrCannotRefundReward.Error(), err.Error()))
+	}
+}
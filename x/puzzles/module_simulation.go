@@ -0,0 +1,94 @@
+package puzzles
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	"github.com/satya/checkers/testutil/sample"
+	puzzlessimulation "github.com/satya/checkers/x/puzzles/simulation"
+	"github.com/satya/checkers/x/puzzles/types"
+)
+
+// avoid unused import issue
+var (
+	_ = sample.AccAddress
+	_ = puzzlessimulation.FindAccount
+	_ = simappparams.StakePerAccount
+	_ = simulation.MsgEntryKind
+	_ = baseapp.Paramspace
+)
+
+const (
+	opWeightMsgPostPuzzle = "op_weight_msg_post_puzzle"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgPostPuzzle int = 100
+
+	opWeightMsgSolvePuzzle = "op_weight_msg_solve_puzzle"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgSolvePuzzle int = 100
+
+	// this line is used by starport scaffolding # simapp/module/const
+)
+
+// GenerateGenesisState creates a randomized GenState of the module
+func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
+	accs := make([]string, len(simState.Accounts))
+	for i, acc := range simState.Accounts {
+		accs[i] = acc.Address.String()
+	}
+	puzzlesGenesis := types.GenesisState{
+		Params: types.DefaultParams(),
+		// this line is used by starport scaffolding # simapp/module/genesisState
+	}
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&puzzlesGenesis)
+}
+
+// ProposalContents doesn't return any content functions for governance proposals
+func (AppModule) ProposalContents(_ module.SimulationState) []simtypes.WeightedProposalContent {
+	return nil
+}
+
+// RandomizedParams creates randomized  param changes for the simulator
+func (am AppModule) RandomizedParams(_ *rand.Rand) []simtypes.ParamChange {
+
+	return []simtypes.ParamChange{}
+}
+
+// RegisterStoreDecoder registers a decoder
+func (am AppModule) RegisterStoreDecoder(_ sdk.StoreDecoderRegistry) {}
+
+// WeightedOperations returns the all the gov module operations with their respective weights.
+func (am AppModule) WeightedOperations(simState module.SimulationState) []simtypes.WeightedOperation {
+	operations := make([]simtypes.WeightedOperation, 0)
+
+	var weightMsgPostPuzzle int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgPostPuzzle, &weightMsgPostPuzzle, nil,
+		func(_ *rand.Rand) {
+			weightMsgPostPuzzle = defaultWeightMsgPostPuzzle
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgPostPuzzle,
+		puzzlessimulation.SimulateMsgPostPuzzle(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgSolvePuzzle int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgSolvePuzzle, &weightMsgSolvePuzzle, nil,
+		func(_ *rand.Rand) {
+			weightMsgSolvePuzzle = defaultWeightMsgSolvePuzzle
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgSolvePuzzle,
+		puzzlessimulation.SimulateMsgSolvePuzzle(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	// this line is used by starport scaffolding # simapp/module/operation
+
+	return operations
+}
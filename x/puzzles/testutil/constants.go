@@ -0,0 +1,7 @@
+package testutil
+
+const (
+	Alice = "cosmos1n67mm98uyz2qnzu7hrlgk68w7uvdyw62kzgtgc"
+	Bob   = "cosmos10rsrdjqap9ynuhy5y05mzxffkh52gaqnsz7yx0"
+	Carol = "cosmos1e0w5t53nrq7p66fye6c8p0ynyhf6y24l4yuxd7"
+)
@@ -0,0 +1,25 @@
+package types
+
+// DONTCOVER
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/puzzles module sentinel errors
+var (
+	ErrInvalidPoster      = sdkerrors.Register(ModuleName, 1100, "poster address is invalid: %s")
+	ErrInvalidPosition    = sdkerrors.Register(ModuleName, 1101, "puzzle position is invalid: %s")
+	ErrInvalidReward      = sdkerrors.Register(ModuleName, 1102, "puzzle reward is invalid")
+	ErrTooManyMoves       = sdkerrors.Register(ModuleName, 1103, "too many moves, maximum: %d")
+	ErrPuzzleNotFound     = sdkerrors.Register(ModuleName, 1104, "puzzle by id not found")
+	ErrPuzzleNotOpen      = sdkerrors.Register(ModuleName, 1105, "puzzle is not open")
+	ErrInvalidDeadline    = sdkerrors.Register(ModuleName, 1106, "deadline cannot be parsed: %s")
+	ErrWrongMove          = sdkerrors.Register(ModuleName, 1107, "wrong move")
+	ErrNotEngineDefence   = sdkerrors.Register(ModuleName, 1108, "defence is not the engine's reply")
+	ErrNotAWin            = sdkerrors.Register(ModuleName, 1109, "line does not end in a win")
+	ErrCannotEscrowReward = sdkerrors.Register(ModuleName, 1110, "poster cannot pay the reward")
+	ErrCannotPayReward    = sdkerrors.Register(ModuleName, 1111, "cannot pay reward to: %s")
+	ErrCannotRefundReward = sdkerrors.Register(ModuleName, 1112, "cannot refund reward to: %s")
+	ErrEmptySolution      = sdkerrors.Register(ModuleName, 1113, "solution is empty")
+)
@@ -0,0 +1,130 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/satya/checkers/x/checkers/engine"
+	"github.com/satya/checkers/x/checkers/rules"
+)
+
+func (puzzle Puzzle) GetPosterAddress() (poster sdk.AccAddress, err error) {
+	poster, errPoster := sdk.AccAddressFromBech32(puzzle.Poster)
+	return poster, sdkerrors.Wrapf(errPoster, ErrInvalidPoster.Error(), puzzle.Poster)
+}
+
+// ParseStart parses the puzzle position and checks that it could start a game.
+func (puzzle Puzzle) ParseStart() (game *rules.Game, err error) {
+	game, err = rules.Parse(puzzle.Board)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(ErrInvalidPosition, "%s", err.Error())
+	}
+	piece, found := rules.StringPieces[puzzle.Turn]
+	if !found || piece.Player == rules.NO_PLAYER || piece.King {
+		return nil, sdkerrors.Wrapf(ErrInvalidPosition, "turn: %s", puzzle.Turn)
+	}
+	game.Turn = piece.Player
+	err = game.ValidateSetup()
+	if err != nil {
+		return nil, sdkerrors.Wrapf(ErrInvalidPosition, "%s", err.Error())
+	}
+	return game, nil
+}
+
+func (puzzle Puzzle) GetDeadlineAsTime() (deadline time.Time, err error) {
+	deadline, errDeadline := time.Parse(DeadlineLayout, puzzle.Deadline)
+	return deadline, sdkerrors.Wrapf(errDeadline, ErrInvalidDeadline.Error(), puzzle.Deadline)
+}
+
+func FormatDeadline(deadline time.Time) string {
+	return deadline.UTC().Format(DeadlineLayout)
+}
+
+func GetNextDeadline(ctx sdk.Context) time.Time {
+	return ctx.BlockTime().Add(PuzzleDuration)
+}
+
+// GetMoveLimit returns the most moves the solver may play.
+func (puzzle Puzzle) GetMoveLimit() uint64 {
+	if puzzle.MaxMoves == 0 {
+		return MaxSolutionMoves
+	}
+	return puzzle.MaxMoves
+}
+
+// CheckSolution replays a line from the puzzle position. The solver's moves
+// only need to be legal. Each defending move must be the engine's reply at
+// the defence depth, which makes the win forced against that defence. The
+// line must end with the defender out of pieces or out of moves, and a move
+// that stops while its capture can go on is wrong. It returns how many
+// defence searches were run.
+func (puzzle Puzzle) CheckSolution(moves []string) (searches uint64, err error) {
+	game, err := puzzle.ParseStart()
+	if err != nil {
+		return 0, err
+	}
+	solver := game.Turn
+	winner := rules.NO_PLAYER
+	var solverMoves uint64
+	for i, move := range moves {
+		if winner != rules.NO_PLAYER {
+			return searches, sdkerrors.Wrapf(ErrWrongMove, "move %d: %s, game is over", i+1, move)
+		}
+		mover := game.Turn
+		if mover == solver {
+			solverMoves++
+			if puzzle.GetMoveLimit() < solverMoves {
+				return searches, sdkerrors.Wrapf(ErrTooManyMoves, "%d", puzzle.GetMoveLimit())
+			}
+		} else {
+			searches++
+			result := engine.Search(game, engine.Limits{
+				MaxDepth:   DefenceSearchDepth,
+				NodeBudget: DefenceNodeBudget,
+			})
+			reply, err := formatSteps(result.BestMove(game))
+			if err != nil {
+				return searches, err
+			}
+			if reply != move {
+				return searches, sdkerrors.Wrapf(ErrNotEngineDefence, "move %d: %s, expected %s", i+1, move, reply)
+			}
+		}
+		_, err = game.PlayNotation(move)
+		if err != nil {
+			return searches, sdkerrors.Wrapf(ErrWrongMove, "move %d: %s: %s", i+1, move, err.Error())
+		}
+		if hasLost(game, rules.Opponents[mover]) {
+			winner = mover
+		} else if game.Turn == mover {
+			return searches, sdkerrors.Wrapf(ErrWrongMove, "move %d: %s, capture is incomplete", i+1, move)
+		}
+	}
+	if winner != solver {
+		return searches, ErrNotAWin
+	}
+	return searches, nil
+}
+
+// hasLost tells whether the player is out of pieces or out of moves.
+func hasLost(game *rules.Game, player rules.Player) bool {
+	if game.Winner() == rules.Opponents[player] {
+		return true
+	}
+	defence := game.Copy()
+	defence.Turn = player
+	return len(defence.LegalSteps()) == 0
+}
+
+func formatSteps(steps []rules.Step) (string, error) {
+	if len(steps) == 0 {
+		return "", fmt.Errorf("no move")
+	}
+	path := []rules.Pos{steps[0].Src}
+	for _, step := range steps {
+		path = append(path, step.Dst)
+	}
+	return rules.FormatPath(path)
+}
@@ -0,0 +1,78 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/satya/checkers/x/puzzles/testutil"
+	"github.com/satya/checkers/x/puzzles/types"
+	"github.com/stretchr/testify/require"
+)
+
+// Red takes on 14, black's only reply is 5-9, and red takes the last piece.
+const twoMoveBoard = "********|b*******|********|********|***b****|****r***|********|********"
+
+func GetTwoMovePuzzle() types.Puzzle {
+	return types.Puzzle{
+		Index:    "1",
+		Poster:   testutil.Alice,
+		Board:    twoMoveBoard,
+		Turn:     "r",
+		Reward:   sdk.NewInt64Coin(sdk.DefaultBondDenom, 100),
+		MaxMoves: 2,
+		Deadline: types.DeadlineLayout,
+		Status:   types.StatusOpen,
+	}
+}
+
+func TestParseStartBadTurn(t *testing.T) {
+	puzzle := GetTwoMovePuzzle()
+	puzzle.Turn = "R"
+	_, err := puzzle.ParseStart()
+	require.EqualError(t, err, "turn: R: puzzle position is invalid: %s")
+}
+
+func TestCheckSolutionWins(t *testing.T) {
+	searches, err := GetTwoMovePuzzle().CheckSolution([]string{"23x14", "5-9", "14x5"})
+	require.Nil(t, err)
+	require.EqualValues(t, 1, searches)
+}
+
+func TestCheckSolutionNotEngineDefence(t *testing.T) {
+	puzzle := GetTwoMovePuzzle()
+	puzzle.Board = "********|b*b*****|********|********|***b****|****r***|********|********"
+	searches, err := puzzle.CheckSolution([]string{"23x14", "6-10"})
+	require.EqualValues(t, 1, searches)
+	require.ErrorIs(t, err, types.ErrNotEngineDefence)
+}
+
+func TestCheckSolutionNotAWin(t *testing.T) {
+	_, err := GetTwoMovePuzzle().CheckSolution([]string{"23x14"})
+	require.ErrorIs(t, err, types.ErrNotAWin)
+}
+
+func TestCheckSolutionTooManyMoves(t *testing.T) {
+	puzzle := GetTwoMovePuzzle()
+	puzzle.MaxMoves = 1
+	_, err := puzzle.CheckSolution([]string{"23x14", "5-9", "14x5"})
+	require.ErrorIs(t, err, types.ErrTooManyMoves)
+}
+
+func TestCheckSolutionMoveAfterWin(t *testing.T) {
+	_, err := GetTwoMovePuzzle().CheckSolution([]string{"23x14", "5-9", "14x5", "5-1"})
+	require.ErrorIs(t, err, types.ErrWrongMove)
+}
+
+// Black's 9 can take 14 and then 23, which leaves red with 30 and 31.
+const partialCaptureBoard = "********|********|*b******|**r*****|********|****r***|********|**r*r***"
+
+func TestCheckSolutionPartialCapture(t *testing.T) {
+	puzzle := GetTwoMovePuzzle()
+	puzzle.Board = partialCaptureBoard
+	puzzle.Turn = "b"
+	for _, move := range []string{"9-18", "9x18"} {
+		searches, err := puzzle.CheckSolution([]string{move})
+		require.EqualValues(t, 0, searches)
+		require.ErrorIs(t, err, types.ErrWrongMove, move)
+	}
+}
@@ -0,0 +1,39 @@
+package types
+
+import (
+	"fmt"
+)
+
+// DefaultIndex is the default capability global index
+const DefaultIndex uint64 = 1
+
+// DefaultGenesis returns the default Capability genesis state
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		SystemInfo: SystemInfo{
+			NextId:      uint64(DefaultIndex),
+			FirstOpenId: uint64(DefaultIndex),
+		},
+		PuzzleList: []Puzzle{},
+		// this line is used by starport scaffolding # genesis/types/default
+		Params: DefaultParams(),
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	// Check for duplicated index in puzzle
+	puzzleIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.PuzzleList {
+		index := string(PuzzleKey(elem.Index))
+		if _, ok := puzzleIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for puzzle")
+		}
+		puzzleIndexMap[index] = struct{}{}
+	}
+	// this line is used by starport scaffolding # genesis/types/validate
+
+	return gs.Params.Validate()
+}
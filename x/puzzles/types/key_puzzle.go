@@ -0,0 +1,23 @@
+package types
+
+import "encoding/binary"
+
+var _ binary.ByteOrder
+
+const (
+	// PuzzleKeyPrefix is the prefix to retrieve all Puzzle
+	PuzzleKeyPrefix = "Puzzle/value/"
+)
+
+// PuzzleKey returns the store key to retrieve a Puzzle from the index fields
+func PuzzleKey(
+	index string,
+) []byte {
+	var key []byte
+
+	indexBytes := []byte(index)
+	key = append(key, indexBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
@@ -0,0 +1,77 @@
+package types
+
+import "time"
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "puzzles"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for slashing
+	RouterKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
+
+	// MemStoreKey defines the in-memory store key
+	MemStoreKey = "mem_puzzles"
+)
+
+func KeyPrefix(p string) []byte {
+	return []byte(p)
+}
+
+const (
+	SystemInfoKey = "SystemInfo-value-"
+)
+
+const (
+	StatusOpen    = "open"
+	StatusSolved  = "solved"
+	StatusExpired = "expired"
+)
+
+const (
+	// All puzzles last as long, so they expire in the order they were posted.
+	PuzzleDuration = time.Duration(7 * 24 * 3600 * 1000_000_000)
+	DeadlineLayout = "2006-01-02 15:04:05.999999999 +0000 UTC"
+)
+
+const (
+	// The defence is searched once per defending move, so solutions are kept
+	// short and searches bounded.
+	MaxSolutionMoves   = uint64(20)
+	DefenceSearchDepth = uint64(4)
+	DefenceNodeBudget  = uint64(20_000)
+)
+
+const (
+	PuzzlePostedEventType    = "puzzle-posted"
+	PuzzlePostedEventPoster  = "poster"
+	PuzzlePostedEventIndex   = "puzzle-index"
+	PuzzlePostedEventBoard   = "board"
+	PuzzlePostedEventTurn    = "turn"
+	PuzzlePostedEventReward  = "reward"
+	PuzzlePostedEventMaxMove = "max-moves"
+)
+
+const (
+	PuzzleSolvedEventType     = "puzzle-solved"
+	PuzzleSolvedEventSolver   = "solver"
+	PuzzleSolvedEventIndex    = "puzzle-index"
+	PuzzleSolvedEventSolution = "solution"
+)
+
+const (
+	PuzzleExpiredEventType   = "puzzle-expired"
+	PuzzleExpiredEventIndex  = "puzzle-index"
+	PuzzleExpiredEventPoster = "poster"
+)
+
+const (
+	PostPuzzleGas    = 15000
+	SolvePuzzleGas   = 5000
+	DefenceSearchGas = 20000
+)
@@ -0,0 +1,59 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgPostPuzzle = "post_puzzle"
+
+var _ sdk.Msg = &MsgPostPuzzle{}
+
+func NewMsgPostPuzzle(creator string, board string, turn string, reward sdk.Coin, maxMoves uint64) *MsgPostPuzzle {
+	return &MsgPostPuzzle{
+		Creator:  creator,
+		Board:    board,
+		Turn:     turn,
+		Reward:   reward,
+		MaxMoves: maxMoves,
+	}
+}
+
+func (msg *MsgPostPuzzle) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgPostPuzzle) Type() string {
+	return TypeMsgPostPuzzle
+}
+
+func (msg *MsgPostPuzzle) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgPostPuzzle) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgPostPuzzle) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if !msg.Reward.IsValid() || !msg.Reward.IsPositive() {
+		return sdkerrors.Wrapf(ErrInvalidReward, "%s", msg.Reward)
+	}
+
+	if MaxSolutionMoves < msg.MaxMoves {
+		return sdkerrors.Wrapf(ErrTooManyMoves, "%d", MaxSolutionMoves)
+	}
+
+	_, err = Puzzle{Board: msg.Board, Turn: msg.Turn}.ParseStart()
+	return err
+}
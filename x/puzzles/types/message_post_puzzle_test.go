@@ -0,0 +1,134 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/satya/checkers/testutil/sample"
+	"github.com/satya/checkers/x/puzzles/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgPostPuzzle_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  types.MsgPostPuzzle
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: types.MsgPostPuzzle{
+				Creator: "invalid_address",
+				Board:   twoMoveBoard,
+				Turn:    "r",
+				Reward:  sdk.NewInt64Coin(sdk.DefaultBondDenom, 100),
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "zero reward",
+			msg: types.MsgPostPuzzle{
+				Creator: sample.AccAddress(),
+				Board:   twoMoveBoard,
+				Turn:    "r",
+				Reward:  sdk.NewInt64Coin(sdk.DefaultBondDenom, 0),
+			},
+			err: types.ErrInvalidReward,
+		},
+		{
+			name: "too many moves",
+			msg: types.MsgPostPuzzle{
+				Creator:  sample.AccAddress(),
+				Board:    twoMoveBoard,
+				Turn:     "r",
+				Reward:   sdk.NewInt64Coin(sdk.DefaultBondDenom, 100),
+				MaxMoves: types.MaxSolutionMoves + 1,
+			},
+			err: types.ErrTooManyMoves,
+		},
+		{
+			name: "man on promotion row",
+			msg: types.MsgPostPuzzle{
+				Creator: sample.AccAddress(),
+				Board:   "*r******|b*******|********|********|***b****|****r***|********|********",
+				Turn:    "r",
+				Reward:  sdk.NewInt64Coin(sdk.DefaultBondDenom, 100),
+			},
+			err: types.ErrInvalidPosition,
+		},
+		{
+			name: "valid",
+			msg: types.MsgPostPuzzle{
+				Creator:  sample.AccAddress(),
+				Board:    twoMoveBoard,
+				Turn:     "r",
+				Reward:   sdk.NewInt64Coin(sdk.DefaultBondDenom, 100),
+				MaxMoves: 2,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgSolvePuzzle_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  types.MsgSolvePuzzle
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: types.MsgSolvePuzzle{
+				Creator:     "invalid_address",
+				PuzzleIndex: "1",
+				Moves:       []string{"23x14"},
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "empty solution",
+			msg: types.MsgSolvePuzzle{
+				Creator:     sample.AccAddress(),
+				PuzzleIndex: "1",
+			},
+			err: types.ErrEmptySolution,
+		},
+		{
+			name: "bad notation",
+			msg: types.MsgSolvePuzzle{
+				Creator:     sample.AccAddress(),
+				PuzzleIndex: "1",
+				Moves:       []string{"23x14", "5_9"},
+			},
+			err: types.ErrWrongMove,
+		},
+		{
+			name: "valid",
+			msg: types.MsgSolvePuzzle{
+				Creator:     sample.AccAddress(),
+				PuzzleIndex: "1",
+				Moves:       []string{"23x14", "5-9", "14x5"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
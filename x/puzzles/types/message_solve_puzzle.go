@@ -0,0 +1,64 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/satya/checkers/x/checkers/rules"
+)
+
+const TypeMsgSolvePuzzle = "solve_puzzle"
+
+var _ sdk.Msg = &MsgSolvePuzzle{}
+
+func NewMsgSolvePuzzle(creator string, puzzleIndex string, moves []string) *MsgSolvePuzzle {
+	return &MsgSolvePuzzle{
+		Creator:     creator,
+		PuzzleIndex: puzzleIndex,
+		Moves:       moves,
+	}
+}
+
+func (msg *MsgSolvePuzzle) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSolvePuzzle) Type() string {
+	return TypeMsgSolvePuzzle
+}
+
+func (msg *MsgSolvePuzzle) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgSolvePuzzle) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSolvePuzzle) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if len(msg.Moves) == 0 {
+		return ErrEmptySolution
+	}
+
+	if 2*MaxSolutionMoves < uint64(len(msg.Moves)) {
+		return sdkerrors.Wrapf(ErrTooManyMoves, "%d", MaxSolutionMoves)
+	}
+
+	for _, move := range msg.Moves {
+		_, _, err = rules.ParsePath(move)
+		if err != nil {
+			return sdkerrors.Wrapf(ErrWrongMove, "%s", err.Error())
+		}
+	}
+
+	return nil
+}
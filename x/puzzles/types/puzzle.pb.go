@@ -0,0 +1,779 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: puzzles/puzzle.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type Puzzle struct {
+	Index  string `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+	Poster string `protobuf:"bytes,2,opt,name=poster,proto3" json:"poster,omitempty"`
+	// The starting board, in the same layout as the checkers stored game, and
+	// the colour to move, which is the colour that must win.
+	Board  string     `protobuf:"bytes,3,opt,name=board,proto3" json:"board,omitempty"`
+	Turn   string     `protobuf:"bytes,4,opt,name=turn,proto3" json:"turn,omitempty"`
+	Reward types.Coin `protobuf:"bytes,5,opt,name=reward,proto3" json:"reward"`
+	// The most moves the solver may play, or 0 for the module maximum.
+	MaxMoves uint64   `protobuf:"varint,6,opt,name=maxMoves,proto3" json:"maxMoves,omitempty"`
+	Deadline string   `protobuf:"bytes,7,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	Status   string   `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
+	Solver   string   `protobuf:"bytes,9,opt,name=solver,proto3" json:"solver,omitempty"`
+	Solution []string `protobuf:"bytes,10,rep,name=solution,proto3" json:"solution,omitempty"`
+}
+
+func (m *Puzzle) Reset()         { *m = Puzzle{} }
+func (m *Puzzle) String() string { return proto.CompactTextString(m) }
+func (*Puzzle) ProtoMessage()    {}
+func (*Puzzle) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6cc4f68d0454a1c, []int{0}
+}
+func (m *Puzzle) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Puzzle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Puzzle.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Puzzle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Puzzle.Merge(m, src)
+}
+func (m *Puzzle) XXX_Size() int {
+	return m.Size()
+}
+func (m *Puzzle) XXX_DiscardUnknown() {
+	xxx_messageInfo_Puzzle.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Puzzle proto.InternalMessageInfo
+
+func (m *Puzzle) GetIndex() string {
+	if m != nil {
+		return m.Index
+	}
+	return ""
+}
+
+func (m *Puzzle) GetPoster() string {
+	if m != nil {
+		return m.Poster
+	}
+	return ""
+}
+
+func (m *Puzzle) GetBoard() string {
+	if m != nil {
+		return m.Board
+	}
+	return ""
+}
+
+func (m *Puzzle) GetTurn() string {
+	if m != nil {
+		return m.Turn
+	}
+	return ""
+}
+
+func (m *Puzzle) GetReward() types.Coin {
+	if m != nil {
+		return m.Reward
+	}
+	return types.Coin{}
+}
+
+func (m *Puzzle) GetMaxMoves() uint64 {
+	if m != nil {
+		return m.MaxMoves
+	}
+	return 0
+}
+
+func (m *Puzzle) GetDeadline() string {
+	if m != nil {
+		return m.Deadline
+	}
+	return ""
+}
+
+func (m *Puzzle) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *Puzzle) GetSolver() string {
+	if m != nil {
+		return m.Solver
+	}
+	return ""
+}
+
+func (m *Puzzle) GetSolution() []string {
+	if m != nil {
+		return m.Solution
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Puzzle)(nil), "satya.checkers.puzzles.Puzzle")
+}
+
+func init() { proto.RegisterFile("puzzles/puzzle.proto", fileDescriptor_a6cc4f68d0454a1c) }
+
+var fileDescriptor_a6cc4f68d0454a1c = []byte{
+	// 325 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x3c, 0x91, 0x31, 0x6e, 0xc2, 0x30,
+	0x14, 0x86, 0x13, 0x08, 0x29, 0xb8, 0x9b, 0x85, 0x90, 0xcb, 0xe0, 0x46, 0x9d, 0xe8, 0x62, 0x8b,
+	0x76, 0xe8, 0x0e, 0x73, 0xa5, 0x8a, 0xb1, 0x9b, 0x93, 0x58, 0x10, 0x35, 0xe4, 0x45, 0xb6, 0x43,
+	0x81, 0x53, 0xf4, 0x02, 0xbd, 0x0f, 0x23, 0x63, 0xa7, 0xaa, 0x82, 0x8b, 0x54, 0xb6, 0x03, 0x53,
+	0xfc, 0xfd, 0xfe, 0xf4, 0xf2, 0xcb, 0x0f, 0x0d, 0xeb, 0x66, 0xbf, 0x2f, 0xa5, 0xe6, 0xfe, 0xcb,
+	0x6a, 0x05, 0x06, 0xf0, 0x48, 0x0b, 0xb3, 0x13, 0x2c, 0x5b, 0xc9, 0xec, 0x43, 0x2a, 0xcd, 0x5a,
+	0x69, 0x3c, 0x5c, 0xc2, 0x12, 0x9c, 0xc2, 0xed, 0xc9, 0xdb, 0x63, 0x9a, 0x81, 0x5e, 0x83, 0xe6,
+	0xa9, 0xd0, 0x92, 0x6f, 0xa6, 0xa9, 0x34, 0x62, 0xca, 0x33, 0x28, 0x2a, 0x7f, 0xff, 0xf0, 0xdd,
+	0x41, 0xf1, 0x9b, 0x9b, 0x80, 0x87, 0xa8, 0x57, 0x54, 0xb9, 0xdc, 0x92, 0x30, 0x09, 0x27, 0x83,
+	0x85, 0x07, 0x3c, 0x42, 0x71, 0x0d, 0xda, 0x48, 0x45, 0x3a, 0x2e, 0x6e, 0xc9, 0xda, 0x29, 0x08,
+	0x95, 0x93, 0xae, 0xb7, 0x1d, 0x60, 0x8c, 0x22, 0xd3, 0xa8, 0x8a, 0x44, 0x2e, 0x74, 0x67, 0xfc,
+	0x82, 0x62, 0x25, 0x3f, 0xad, 0xda, 0x4b, 0xc2, 0xc9, 0xed, 0xd3, 0x1d, 0xf3, 0x9d, 0x98, 0xed,
+	0xc4, 0xda, 0x4e, 0x6c, 0x0e, 0x45, 0x35, 0x8b, 0x0e, 0xbf, 0xf7, 0xc1, 0xa2, 0xd5, 0xf1, 0x18,
+	0xf5, 0xd7, 0x62, 0xfb, 0x0a, 0x1b, 0xa9, 0x49, 0x9c, 0x84, 0x93, 0x68, 0x71, 0x65, 0x7b, 0x97,
+	0x4b, 0x91, 0x97, 0x45, 0x25, 0xc9, 0x8d, 0xfb, 0xd9, 0x95, 0x6d, 0x65, 0x6d, 0x84, 0x69, 0x34,
+	0xe9, 0xfb, 0xca, 0x9e, 0x5c, 0x0e, 0xe5, 0x46, 0x2a, 0x32, 0x68, 0x73, 0x47, 0x76, 0x96, 0x86,
+	0xb2, 0x31, 0x05, 0x54, 0x04, 0x25, 0x5d, 0x3b, 0xeb, 0xc2, 0xb3, 0xf9, 0xe1, 0x44, 0xc3, 0xe3,
+	0x89, 0x86, 0x7f, 0x27, 0x1a, 0x7e, 0x9d, 0x69, 0x70, 0x3c, 0xd3, 0xe0, 0xe7, 0x4c, 0x83, 0xf7,
+	0xc7, 0x65, 0x61, 0x56, 0x4d, 0xca, 0x32, 0x58, 0x73, 0xb7, 0x12, 0x7e, 0x59, 0x09, 0xdf, 0xf2,
+	0xcb, 0xe6, 0xcc, 0xae, 0x96, 0x3a, 0x8d, 0xdd, 0x5b, 0x3f, 0xff, 0x0f, 0x00, 0x11, 0x73, 0xb3,
+	0x65, 0xd1, 0x01, 0x00, 0x00,
+}
+
+func (m *Puzzle) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Puzzle) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Puzzle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Solution) > 0 {
+		for iNdEx := len(m.Solution) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Solution[iNdEx])
+			copy(dAtA[i:], m.Solution[iNdEx])
+			i = encodeVarintPuzzle(dAtA, i, uint64(len(m.Solution[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.Solver) > 0 {
+		i -= len(m.Solver)
+		copy(dAtA[i:], m.Solver)
+		i = encodeVarintPuzzle(dAtA, i, uint64(len(m.Solver)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintPuzzle(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Deadline) > 0 {
+		i -= len(m.Deadline)
+		copy(dAtA[i:], m.Deadline)
+		i = encodeVarintPuzzle(dAtA, i, uint64(len(m.Deadline)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.MaxMoves != 0 {
+		i = encodeVarintPuzzle(dAtA, i, uint64(m.MaxMoves))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size, err := m.Reward.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintPuzzle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Turn) > 0 {
+		i -= len(m.Turn)
+		copy(dAtA[i:], m.Turn)
+		i = encodeVarintPuzzle(dAtA, i, uint64(len(m.Turn)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Board) > 0 {
+		i -= len(m.Board)
+		copy(dAtA[i:], m.Board)
+		i = encodeVarintPuzzle(dAtA, i, uint64(len(m.Board)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Poster) > 0 {
+		i -= len(m.Poster)
+		copy(dAtA[i:], m.Poster)
+		i = encodeVarintPuzzle(dAtA, i, uint64(len(m.Poster)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintPuzzle(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPuzzle(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPuzzle(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Puzzle) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovPuzzle(uint64(l))
+	}
+	l = len(m.Poster)
+	if l > 0 {
+		n += 1 + l + sovPuzzle(uint64(l))
+	}
+	l = len(m.Board)
+	if l > 0 {
+		n += 1 + l + sovPuzzle(uint64(l))
+	}
+	l = len(m.Turn)
+	if l > 0 {
+		n += 1 + l + sovPuzzle(uint64(l))
+	}
+	l = m.Reward.Size()
+	n += 1 + l + sovPuzzle(uint64(l))
+	if m.MaxMoves != 0 {
+		n += 1 + sovPuzzle(uint64(m.MaxMoves))
+	}
+	l = len(m.Deadline)
+	if l > 0 {
+		n += 1 + l + sovPuzzle(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovPuzzle(uint64(l))
+	}
+	l = len(m.Solver)
+	if l > 0 {
+		n += 1 + l + sovPuzzle(uint64(l))
+	}
+	if len(m.Solution) > 0 {
+		for _, s := range m.Solution {
+			l = len(s)
+			n += 1 + l + sovPuzzle(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovPuzzle(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPuzzle(x uint64) (n int) {
+	return sovPuzzle(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Puzzle) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPuzzle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Puzzle: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Puzzle: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPuzzle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPuzzle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPuzzle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Poster", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPuzzle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPuzzle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPuzzle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Poster = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Board", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPuzzle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPuzzle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPuzzle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Board = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Turn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPuzzle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPuzzle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPuzzle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Turn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reward", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPuzzle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPuzzle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPuzzle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Reward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMoves", wireType)
+			}
+			m.MaxMoves = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPuzzle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMoves |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPuzzle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPuzzle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPuzzle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deadline = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPuzzle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPuzzle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPuzzle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Solver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPuzzle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPuzzle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPuzzle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Solver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Solution", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPuzzle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPuzzle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPuzzle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Solution = append(m.Solution, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPuzzle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPuzzle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPuzzle(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPuzzle
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPuzzle
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPuzzle
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPuzzle
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPuzzle
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPuzzle
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPuzzle        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPuzzle          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPuzzle = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,1212 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: puzzles/tx.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgPostPuzzle escrows the reward for whoever first shows a forced win for
+// the colour to move.
+type MsgPostPuzzle struct {
+	Creator  string     `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Board    string     `protobuf:"bytes,2,opt,name=board,proto3" json:"board,omitempty"`
+	Turn     string     `protobuf:"bytes,3,opt,name=turn,proto3" json:"turn,omitempty"`
+	Reward   types.Coin `protobuf:"bytes,4,opt,name=reward,proto3" json:"reward"`
+	MaxMoves uint64     `protobuf:"varint,5,opt,name=maxMoves,proto3" json:"maxMoves,omitempty"`
+}
+
+func (m *MsgPostPuzzle) Reset()         { *m = MsgPostPuzzle{} }
+func (m *MsgPostPuzzle) String() string { return proto.CompactTextString(m) }
+func (*MsgPostPuzzle) ProtoMessage()    {}
+func (*MsgPostPuzzle) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ff68a5091defcf8, []int{0}
+}
+func (m *MsgPostPuzzle) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPostPuzzle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPostPuzzle.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPostPuzzle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPostPuzzle.Merge(m, src)
+}
+func (m *MsgPostPuzzle) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPostPuzzle) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPostPuzzle.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPostPuzzle proto.InternalMessageInfo
+
+func (m *MsgPostPuzzle) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgPostPuzzle) GetBoard() string {
+	if m != nil {
+		return m.Board
+	}
+	return ""
+}
+
+func (m *MsgPostPuzzle) GetTurn() string {
+	if m != nil {
+		return m.Turn
+	}
+	return ""
+}
+
+func (m *MsgPostPuzzle) GetReward() types.Coin {
+	if m != nil {
+		return m.Reward
+	}
+	return types.Coin{}
+}
+
+func (m *MsgPostPuzzle) GetMaxMoves() uint64 {
+	if m != nil {
+		return m.MaxMoves
+	}
+	return 0
+}
+
+type MsgPostPuzzleResponse struct {
+	PuzzleIndex string `protobuf:"bytes,1,opt,name=puzzleIndex,proto3" json:"puzzleIndex,omitempty"`
+}
+
+func (m *MsgPostPuzzleResponse) Reset()         { *m = MsgPostPuzzleResponse{} }
+func (m *MsgPostPuzzleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPostPuzzleResponse) ProtoMessage()    {}
+func (*MsgPostPuzzleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ff68a5091defcf8, []int{1}
+}
+func (m *MsgPostPuzzleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPostPuzzleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPostPuzzleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPostPuzzleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPostPuzzleResponse.Merge(m, src)
+}
+func (m *MsgPostPuzzleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPostPuzzleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPostPuzzleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPostPuzzleResponse proto.InternalMessageInfo
+
+func (m *MsgPostPuzzleResponse) GetPuzzleIndex() string {
+	if m != nil {
+		return m.PuzzleIndex
+	}
+	return ""
+}
+
+// MsgSolvePuzzle gives the whole line, the solver's moves and the defence in
+// turn, in numbered-square notation such as "11-15" or "9x18x27". Each
+// defence must be the engine's reply, and the line must end in a win.
+type MsgSolvePuzzle struct {
+	Creator     string   `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	PuzzleIndex string   `protobuf:"bytes,2,opt,name=puzzleIndex,proto3" json:"puzzleIndex,omitempty"`
+	Moves       []string `protobuf:"bytes,3,rep,name=moves,proto3" json:"moves,omitempty"`
+}
+
+func (m *MsgSolvePuzzle) Reset()         { *m = MsgSolvePuzzle{} }
+func (m *MsgSolvePuzzle) String() string { return proto.CompactTextString(m) }
+func (*MsgSolvePuzzle) ProtoMessage()    {}
+func (*MsgSolvePuzzle) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ff68a5091defcf8, []int{2}
+}
+func (m *MsgSolvePuzzle) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSolvePuzzle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSolvePuzzle.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSolvePuzzle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSolvePuzzle.Merge(m, src)
+}
+func (m *MsgSolvePuzzle) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSolvePuzzle) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSolvePuzzle.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSolvePuzzle proto.InternalMessageInfo
+
+func (m *MsgSolvePuzzle) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgSolvePuzzle) GetPuzzleIndex() string {
+	if m != nil {
+		return m.PuzzleIndex
+	}
+	return ""
+}
+
+func (m *MsgSolvePuzzle) GetMoves() []string {
+	if m != nil {
+		return m.Moves
+	}
+	return nil
+}
+
+type MsgSolvePuzzleResponse struct {
+}
+
+func (m *MsgSolvePuzzleResponse) Reset()         { *m = MsgSolvePuzzleResponse{} }
+func (m *MsgSolvePuzzleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSolvePuzzleResponse) ProtoMessage()    {}
+func (*MsgSolvePuzzleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ff68a5091defcf8, []int{3}
+}
+func (m *MsgSolvePuzzleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSolvePuzzleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSolvePuzzleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSolvePuzzleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSolvePuzzleResponse.Merge(m, src)
+}
+func (m *MsgSolvePuzzleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSolvePuzzleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSolvePuzzleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSolvePuzzleResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgPostPuzzle)(nil), "satya.checkers.puzzles.MsgPostPuzzle")
+	proto.RegisterType((*MsgPostPuzzleResponse)(nil), "satya.checkers.puzzles.MsgPostPuzzleResponse")
+	proto.RegisterType((*MsgSolvePuzzle)(nil), "satya.checkers.puzzles.MsgSolvePuzzle")
+	proto.RegisterType((*MsgSolvePuzzleResponse)(nil), "satya.checkers.puzzles.MsgSolvePuzzleResponse")
+}
+
+func init() { proto.RegisterFile("puzzles/tx.proto", fileDescriptor_3ff68a5091defcf8) }
+
+var fileDescriptor_3ff68a5091defcf8 = []byte{
+	// 390 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xc1, 0x6e, 0xa2, 0x40,
+	0x18, 0xc7, 0x19, 0x41, 0x77, 0x77, 0xcc, 0x6e, 0x36, 0x13, 0xd7, 0xb0, 0x1c, 0x58, 0x42, 0xb2,
+	0x1b, 0xf7, 0xd0, 0x99, 0x68, 0x0f, 0x4d, 0xaf, 0x7a, 0xea, 0x81, 0xc4, 0xd0, 0x5b, 0x6f, 0x80,
+	0x13, 0x34, 0x15, 0x86, 0x30, 0xa3, 0x45, 0x9f, 0xa2, 0x8f, 0xd1, 0x47, 0xf1, 0xe8, 0xb1, 0xa7,
+	0xa6, 0xd1, 0x17, 0x69, 0x18, 0xc0, 0x60, 0xdb, 0xb4, 0xde, 0xe6, 0xfb, 0xf8, 0xf1, 0x9f, 0xff,
+	0xf7, 0x9f, 0x0f, 0xfe, 0x4c, 0x16, 0xeb, 0xf5, 0x9c, 0x72, 0x22, 0x32, 0x9c, 0xa4, 0x4c, 0x30,
+	0xd4, 0xe5, 0x9e, 0x58, 0x79, 0x38, 0x98, 0xd2, 0xe0, 0x96, 0xa6, 0x1c, 0x97, 0x80, 0xd1, 0x09,
+	0x59, 0xc8, 0x24, 0x42, 0xf2, 0x53, 0x41, 0x1b, 0x66, 0xc0, 0x78, 0xc4, 0x38, 0xf1, 0x3d, 0x4e,
+	0xc9, 0xb2, 0xef, 0x53, 0xe1, 0xf5, 0x49, 0xc0, 0x66, 0x71, 0xf1, 0xdd, 0x7e, 0x00, 0xf0, 0xbb,
+	0xc3, 0xc3, 0x31, 0xe3, 0x62, 0x2c, 0x85, 0x90, 0x0e, 0xbf, 0x04, 0x29, 0xf5, 0x04, 0x4b, 0x75,
+	0x60, 0x81, 0xde, 0x37, 0xb7, 0x2a, 0x51, 0x07, 0x36, 0x7d, 0xe6, 0xa5, 0x13, 0xbd, 0x21, 0xfb,
+	0x45, 0x81, 0x10, 0xd4, 0xc4, 0x22, 0x8d, 0x75, 0x55, 0x36, 0xe5, 0x19, 0x5d, 0xc0, 0x56, 0x4a,
+	0xef, 0x72, 0x54, 0xb3, 0x40, 0xaf, 0x3d, 0xf8, 0x8d, 0x0b, 0x1b, 0x38, 0xb7, 0x81, 0x4b, 0x1b,
+	0x78, 0xc4, 0x66, 0xf1, 0x50, 0xdb, 0x3c, 0xfd, 0x51, 0xdc, 0x12, 0x47, 0x06, 0xfc, 0x1a, 0x79,
+	0x99, 0xc3, 0x96, 0x94, 0xeb, 0x4d, 0x0b, 0xf4, 0x34, 0xf7, 0x50, 0xdb, 0x97, 0xf0, 0xd7, 0x91,
+	0x53, 0x97, 0xf2, 0x84, 0xc5, 0x9c, 0x22, 0x0b, 0xb6, 0x8b, 0x10, 0xae, 0xe2, 0x09, 0xcd, 0x4a,
+	0xd7, 0xf5, 0x96, 0xed, 0xc3, 0x1f, 0x0e, 0x0f, 0xaf, 0xd9, 0x7c, 0x49, 0x3f, 0x9d, 0xf2, 0x95,
+	0x5a, 0xe3, 0x8d, 0x5a, 0x9e, 0x43, 0x24, 0x1d, 0xaa, 0x96, 0x9a, 0xe7, 0x20, 0x0b, 0x5b, 0x87,
+	0xdd, 0xe3, 0x3b, 0x2a, 0x7f, 0x83, 0x2d, 0x80, 0xaa, 0xc3, 0x43, 0xe4, 0x43, 0x58, 0xcb, 0xf9,
+	0x2f, 0x7e, 0xff, 0x21, 0xf1, 0xd1, 0x90, 0xc6, 0xd9, 0x49, 0xd8, 0x21, 0x0b, 0x0a, 0xdb, 0xf5,
+	0x31, 0xff, 0x7d, 0xf0, 0x77, 0x8d, 0x33, 0xf0, 0x69, 0x5c, 0x75, 0xcd, 0x70, 0xb4, 0xd9, 0x99,
+	0x60, 0xbb, 0x33, 0xc1, 0xf3, 0xce, 0x04, 0xf7, 0x7b, 0x53, 0xd9, 0xee, 0x4d, 0xe5, 0x71, 0x6f,
+	0x2a, 0x37, 0xff, 0xc3, 0x99, 0x98, 0x2e, 0x7c, 0x1c, 0xb0, 0x88, 0x48, 0x4d, 0x52, 0x69, 0x92,
+	0x8c, 0x1c, 0x96, 0x79, 0x95, 0x50, 0xee, 0xb7, 0xe4, 0x0a, 0x9e, 0xbf, 0x0c, 0x00, 0xc2, 0xa1,
+	0x9b, 0x03, 0xe4, 0x02, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	PostPuzzle(ctx context.Context, in *MsgPostPuzzle, opts ...grpc.CallOption) (*MsgPostPuzzleResponse, error)
+	SolvePuzzle(ctx context.Context, in *MsgSolvePuzzle, opts ...grpc.CallOption) (*MsgSolvePuzzleResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) PostPuzzle(ctx context.Context, in *MsgPostPuzzle, opts ...grpc.CallOption) (*MsgPostPuzzleResponse, error) {
+	out := new(MsgPostPuzzleResponse)
+	err := c.cc.Invoke(ctx, "/satya.checkers.puzzles.Msg/PostPuzzle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SolvePuzzle(ctx context.Context, in *MsgSolvePuzzle, opts ...grpc.CallOption) (*MsgSolvePuzzleResponse, error) {
+	out := new(MsgSolvePuzzleResponse)
+	err := c.cc.Invoke(ctx, "/satya.checkers.puzzles.Msg/SolvePuzzle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	PostPuzzle(context.Context, *MsgPostPuzzle) (*MsgPostPuzzleResponse, error)
+	SolvePuzzle(context.Context, *MsgSolvePuzzle) (*MsgSolvePuzzleResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) PostPuzzle(ctx context.Context, req *MsgPostPuzzle) (*MsgPostPuzzleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PostPuzzle not implemented")
+}
+func (*UnimplementedMsgServer) SolvePuzzle(ctx context.Context, req *MsgSolvePuzzle) (*MsgSolvePuzzleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SolvePuzzle not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_PostPuzzle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPostPuzzle)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PostPuzzle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/satya.checkers.puzzles.Msg/PostPuzzle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PostPuzzle(ctx, req.(*MsgPostPuzzle))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SolvePuzzle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSolvePuzzle)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SolvePuzzle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/satya.checkers.puzzles.Msg/SolvePuzzle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SolvePuzzle(ctx, req.(*MsgSolvePuzzle))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "satya.checkers.puzzles.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "PostPuzzle",
+			Handler:    _Msg_PostPuzzle_Handler,
+		},
+		{
+			MethodName: "SolvePuzzle",
+			Handler:    _Msg_SolvePuzzle_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "puzzles/tx.proto",
+}
+
+func (m *MsgPostPuzzle) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPostPuzzle) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPostPuzzle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxMoves != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxMoves))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size, err := m.Reward.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Turn) > 0 {
+		i -= len(m.Turn)
+		copy(dAtA[i:], m.Turn)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Turn)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Board) > 0 {
+		i -= len(m.Board)
+		copy(dAtA[i:], m.Board)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Board)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPostPuzzleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPostPuzzleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPostPuzzleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PuzzleIndex) > 0 {
+		i -= len(m.PuzzleIndex)
+		copy(dAtA[i:], m.PuzzleIndex)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PuzzleIndex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSolvePuzzle) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSolvePuzzle) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSolvePuzzle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Moves) > 0 {
+		for iNdEx := len(m.Moves) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Moves[iNdEx])
+			copy(dAtA[i:], m.Moves[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Moves[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.PuzzleIndex) > 0 {
+		i -= len(m.PuzzleIndex)
+		copy(dAtA[i:], m.PuzzleIndex)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PuzzleIndex)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSolvePuzzleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSolvePuzzleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSolvePuzzleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgPostPuzzle) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Board)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Turn)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Reward.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.MaxMoves != 0 {
+		n += 1 + sovTx(uint64(m.MaxMoves))
+	}
+	return n
+}
+
+func (m *MsgPostPuzzleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PuzzleIndex)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSolvePuzzle) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PuzzleIndex)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Moves) > 0 {
+		for _, s := range m.Moves {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSolvePuzzleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgPostPuzzle) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPostPuzzle: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPostPuzzle: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Board", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Board = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Turn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Turn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reward", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Reward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMoves", wireType)
+			}
+			m.MaxMoves = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMoves |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPostPuzzleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPostPuzzleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPostPuzzleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PuzzleIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PuzzleIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSolvePuzzle) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSolvePuzzle: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSolvePuzzle: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PuzzleIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PuzzleIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Moves", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Moves = append(m.Moves, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSolvePuzzleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSolvePuzzleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSolvePuzzleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)